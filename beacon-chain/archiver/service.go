@@ -3,16 +3,22 @@ package archiver
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
@@ -29,6 +35,8 @@ type Service struct {
 	participationFetcher blockchain.ParticipationFetcher
 	stateNotifier        statefeed.Notifier
 	lastArchivedEpoch    uint64
+	archiveRewards       bool
+	rewardsIndices       []uint64
 }
 
 // Config options for the archiver service.
@@ -37,6 +45,10 @@ type Config struct {
 	HeadFetcher          blockchain.HeadFetcher
 	ParticipationFetcher blockchain.ParticipationFetcher
 	StateNotifier        statefeed.Notifier
+	// ArchiveValidatorRewards enables archiving the breakdown of validator rewards and penalties.
+	ArchiveValidatorRewards bool
+	// ValidatorRewardsIndices are the validators whose rewards are archived, all of them if empty.
+	ValidatorRewardsIndices []uint64
 }
 
 // NewArchiverService initializes the service from configuration options.
@@ -49,6 +61,8 @@ func NewArchiverService(ctx context.Context, cfg *Config) *Service {
 		headFetcher:          cfg.HeadFetcher,
 		participationFetcher: cfg.ParticipationFetcher,
 		stateNotifier:        cfg.StateNotifier,
+		archiveRewards:       cfg.ArchiveValidatorRewards,
+		rewardsIndices:       cfg.ValidatorRewardsIndices,
 	}
 }
 
@@ -138,6 +152,96 @@ func (s *Service) archiveBalances(ctx context.Context, headState *pb.BeaconState
 	return nil
 }
 
+// We archive the breakdown of the rewards and penalties applied to validator balances when
+// processing the end of the epoch.
+func (s *Service) archiveValidatorRewards(ctx context.Context, headState *pb.BeaconState, epoch uint64) error {
+	epochEndState, err := s.epochEndState(ctx, headState, epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not get state at the end of the epoch")
+	}
+
+	// Rewards and penalties are computed on a copy of the state after justification and
+	// finalization, as done during epoch processing.
+	st := proto.Clone(epochEndState).(*pb.BeaconState)
+	vp, bp := precompute.New(ctx, st)
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return errors.Wrap(err, "could not process attestations")
+	}
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+	if err != nil {
+		return errors.Wrap(err, "could not process justification")
+	}
+	rewards, err := precompute.ValidatorRewards(st, bp, vp)
+	if err != nil {
+		return errors.Wrap(err, "could not compute validator rewards")
+	}
+
+	archived := &dbpb.ArchivedValidatorRewards{}
+	if len(s.rewardsIndices) == 0 {
+		for i, r := range rewards {
+			// Skip validators without any reward or penalty, such as the inactive ones.
+			if r.Reward() == 0 && r.Penalty() == 0 {
+				continue
+			}
+			archived.Rewards = append(archived.Rewards, validatorRewards(uint64(i), r))
+		}
+	} else {
+		indices := make([]uint64, len(s.rewardsIndices))
+		copy(indices, s.rewardsIndices)
+		sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+		for i, index := range indices {
+			if index >= uint64(len(rewards)) || (i > 0 && index == indices[i-1]) {
+				continue
+			}
+			archived.Rewards = append(archived.Rewards, validatorRewards(index, rewards[index]))
+		}
+	}
+	if err := s.beaconDB.SaveArchivedValidatorRewards(ctx, epoch, archived); err != nil {
+		return errors.Wrap(err, "could not archive validator rewards")
+	}
+	return nil
+}
+
+// epochEndState returns the state at the last slot of the epoch, before the epoch is processed.
+// The head state is such a state when it is at the end of the epoch. Otherwise, the last slot of
+// the epoch was skipped, and the state is regenerated from the last block of the epoch.
+func (s *Service) epochEndState(ctx context.Context, headState *pb.BeaconState, epoch uint64) (*pb.BeaconState, error) {
+	endSlot := helpers.StartSlot(epoch+1) - 1
+	if headState.Slot == endSlot {
+		return headState, nil
+	}
+	root, err := helpers.BlockRootAtSlot(headState, endSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block root")
+	}
+	st, err := s.beaconDB.State(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve state")
+	}
+	if st == nil {
+		return nil, fmt.Errorf("no state for block root %#x", root)
+	}
+	return state.ProcessSlots(ctx, st, endSlot)
+}
+
+// validatorRewards converts the rewards of a validator to their archived format.
+func validatorRewards(index uint64, r *precompute.Rewards) *dbpb.ValidatorRewards {
+	return &dbpb.ValidatorRewards{
+		ValidatorIndex:       index,
+		SourceReward:         r.SourceReward,
+		SourcePenalty:        r.SourcePenalty,
+		TargetReward:         r.TargetReward,
+		TargetPenalty:        r.TargetPenalty,
+		HeadReward:           r.HeadReward,
+		HeadPenalty:          r.HeadPenalty,
+		InclusionDelayReward: r.InclusionDelayReward,
+		ProposerReward:       r.ProposerReward,
+		InactivityPenalty:    r.InactivityPenalty,
+		SlashingPenalty:      r.SlashingPenalty,
+	}
+}
+
 func (s *Service) run(ctx context.Context) {
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
@@ -177,6 +281,12 @@ func (s *Service) run(ctx context.Context) {
 					log.WithError(err).Error("Could not archive validator balances and active indices")
 					continue
 				}
+				if s.archiveRewards {
+					if err := s.archiveValidatorRewards(ctx, headState, epochToArchive); err != nil {
+						log.WithError(err).Error("Could not archive validator rewards")
+						continue
+					}
+				}
 				log.WithField(
 					"epoch",
 					epochToArchive,
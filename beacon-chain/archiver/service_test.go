@@ -347,6 +347,76 @@ func TestArchiverService_SavesExitedValidatorChanges(t *testing.T) {
 	}
 }
 
+func TestArchiverService_SavesValidatorRewards(t *testing.T) {
+	tests := []struct {
+		name        string
+		indices     []uint64
+		wantIndices []uint64
+	}{
+		{
+			name:        "configured validators",
+			indices:     []uint64{3, 0, 3, 200},
+			wantIndices: []uint64{0, 3},
+		},
+		{
+			name: "all validators",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			validatorCount := uint64(100)
+			headState := setupState(validatorCount)
+			svc, beaconDB := setupService(t)
+			defer dbutil.TeardownDB(t, beaconDB)
+			svc.archiveRewards = true
+			svc.rewardsIndices = tt.indices
+			svc.headFetcher = &mock.ChainService{
+				State: headState,
+			}
+			event := &feed.Event{
+				Type: statefeed.BlockProcessed,
+				Data: &statefeed.BlockProcessedData{
+					BlockRoot: [32]byte{1, 2, 3},
+					Verified:  true,
+				},
+			}
+			triggerStateEvent(t, svc, event)
+
+			retrieved, err := svc.beaconDB.ArchivedValidatorRewards(svc.ctx, helpers.CurrentEpoch(headState))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if retrieved == nil {
+				t.Fatal("Expected validator rewards to be archived")
+			}
+			wantIndices := tt.wantIndices
+			if wantIndices == nil {
+				for i := uint64(0); i < validatorCount; i++ {
+					wantIndices = append(wantIndices, i)
+				}
+			}
+			if len(retrieved.Rewards) != len(wantIndices) {
+				t.Fatalf("Wanted rewards of %d validators, received %d", len(wantIndices), len(retrieved.Rewards))
+			}
+			for i, r := range retrieved.Rewards {
+				if r.ValidatorIndex != wantIndices[i] {
+					t.Errorf("Wanted validator index %d, received %d", wantIndices[i], r.ValidatorIndex)
+				}
+				// No validator attested in the previous epoch, so all of them are penalized.
+				if r.SourcePenalty == 0 || r.SourcePenalty != r.TargetPenalty || r.SourcePenalty != r.HeadPenalty {
+					t.Errorf("Wanted equal non zero source, target and head penalties, received %v", r)
+				}
+				if r.SourceReward != 0 || r.TargetReward != 0 || r.HeadReward != 0 || r.InclusionDelayReward != 0 {
+					t.Errorf("Wanted no rewards, received %v", r)
+				}
+			}
+			testutil.AssertLogsContain(t, hook, "Successfully archived")
+			testutil.AssertLogsDoNotContain(t, hook, "Could not archive validator rewards")
+		})
+	}
+}
+
 func setupState(validatorCount uint64) *pb.BeaconState {
 	validators := make([]*ethpb.Validator, validatorCount)
 	balances := make([]uint64, validatorCount)
@@ -0,0 +1,91 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// blockArrivalDelaySize is the number of recent blocks whose arrival delay is kept in memory to
+// break fork choice ties.
+const blockArrivalDelaySize = 1000
+
+// newBlockArrivalDelayCache creates a cache of the arrival delays of recently processed blocks.
+func newBlockArrivalDelayCache() *lru.Cache {
+	cache, err := lru.New(blockArrivalDelaySize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// blockArrivalDelay returns how long after the start of the input slot, as derived from the
+// genesis time, the arrival time is.
+func (s *Service) blockArrivalDelay(slot uint64, arrival time.Time) time.Duration {
+	slotStart := s.genesisTime.Add(time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	return arrival.Sub(slotStart)
+}
+
+// recordBlockArrival saves the arrival delay of a processed block in the DB and in memory, and
+// reports it as a metric.
+func (s *Service) recordBlockArrival(ctx context.Context, blockRoot [32]byte, slot uint64, arrival time.Time) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.recordBlockArrival")
+	defer span.End()
+
+	delay := s.blockArrivalDelay(slot, arrival)
+	blockArrivalDelay.Observe(float64(delay / time.Millisecond))
+	if s.blockArrivalDelays != nil {
+		s.blockArrivalDelays.Add(blockRoot, delay)
+	}
+	if err := s.beaconDB.SaveBlockArrivalDelay(ctx, blockRoot, delay); err != nil {
+		return errors.Wrap(err, "could not save block arrival delay")
+	}
+	return nil
+}
+
+// isTimelyDelay returns true if a block arriving after the input delay is timely, meaning it
+// arrived before attesters of its slot are expected to attest.
+func isTimelyDelay(delay time.Duration) bool {
+	return delay < time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second/3
+}
+
+// timelinessTieBreaker breaks fork choice ties between equally weighted blocks by preferring the
+// timely block when only one of them is timely. It is called while the fork choice store is
+// updated, so only the arrival delays kept in memory are used. Blocks without one, such as the
+// ones from initial sync or processed before a restart, are left to the default tie-break.
+func (s *Service) timelinessTieBreaker(a [32]byte, b [32]byte) int {
+	if s.blockArrivalDelays == nil {
+		return 0
+	}
+	aDelay, ok := s.blockArrivalDelays.Get(a)
+	if !ok {
+		return 0
+	}
+	bDelay, ok := s.blockArrivalDelays.Get(b)
+	if !ok {
+		return 0
+	}
+	aTimely, bTimely := isTimelyDelay(aDelay.(time.Duration)), isTimelyDelay(bDelay.(time.Duration))
+	switch {
+	case aTimely && !bTimely:
+		return 1
+	case !aTimely && bTimely:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// setForkChoiceTieBreaker sets the timeliness tie-breaker on the fork choice store if the feature
+// is enabled.
+func (s *Service) setForkChoiceTieBreaker() {
+	if s.forkChoiceStore == nil || !featureconfig.Get().EnableTimelyTieBreak {
+		return
+	}
+	s.forkChoiceStore.SetTieBreaker(s.timelinessTieBreaker)
+}
@@ -0,0 +1,75 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestRecordBlockArrival_SavesDelay(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := time.Unix(1000, 0)
+	s := &Service{beaconDB: db, genesisTime: genesis, blockArrivalDelays: newBlockArrivalDelayCache()}
+	slot := uint64(3)
+	arrival := genesis.Add(time.Duration(slot*params.BeaconConfig().SecondsPerSlot)*time.Second + 1500*time.Millisecond)
+	root := [32]byte{'a'}
+	if err := s.recordBlockArrival(ctx, root, slot, arrival); err != nil {
+		t.Fatal(err)
+	}
+
+	delay, ok, err := db.BlockArrivalDelay(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("Expected arrival delay to be saved")
+	}
+	if delay != 1500*time.Millisecond {
+		t.Errorf("Wanted delay %v, received %v", 1500*time.Millisecond, delay)
+	}
+	if cached, ok := s.blockArrivalDelays.Get(root); !ok || cached.(time.Duration) != delay {
+		t.Errorf("Wanted delay %v in memory, received %v", delay, cached)
+	}
+}
+
+func TestTimelinessTieBreaker(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	s := &Service{beaconDB: db, blockArrivalDelays: newBlockArrivalDelayCache()}
+	timely, otherTimely, late, unknown := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}, [32]byte{'d'}
+	lateDelay := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 2
+	for r, d := range map[[32]byte]time.Duration{timely: time.Second, otherTimely: 0, late: lateDelay} {
+		s.blockArrivalDelays.Add(r, d)
+	}
+	// Delays only found in the database, such as the ones recorded before a restart, are not used.
+	if err := db.SaveBlockArrivalDelay(ctx, unknown, lateDelay); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		a    [32]byte
+		b    [32]byte
+		want int
+	}{
+		{name: "timely over late", a: timely, b: late, want: 1},
+		{name: "late under timely", a: late, b: timely, want: -1},
+		{name: "both timely", a: timely, b: otherTimely, want: 0},
+		{name: "unknown arrival", a: timely, b: unknown, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.timelinessTieBreaker(tt.a, tt.b); got != tt.want {
+				t.Errorf("Wanted %d, received %d", tt.want, got)
+			}
+		})
+	}
+}
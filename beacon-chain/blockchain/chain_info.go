@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	f "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -56,6 +57,12 @@ type ParticipationFetcher interface {
 	Participation(epoch uint64) *precompute.Balance
 }
 
+// ForkChoiceFetcher retrieves the proto-array fork choice store, to inspect how the head
+// is selected.
+type ForkChoiceFetcher interface {
+	ForkChoiceStore() f.ForkChoicer
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from head state.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	if s.headState == nil || s.headState.FinalizedCheckpoint == nil {
@@ -197,3 +204,8 @@ func (s *Service) Participation(epoch uint64) *precompute.Balance {
 
 	return s.epochParticipation[epoch]
 }
+
+// ForkChoiceStore returns the proto-array fork choice store of the service.
+func (s *Service) ForkChoiceStore() f.ForkChoicer {
+	return s.forkChoiceStore
+}
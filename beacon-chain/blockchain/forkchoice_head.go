@@ -0,0 +1,69 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// forkChoiceHead runs the proto-array fork choice from the justified checkpoint of the service,
+// weighting the latest votes of validators by their effective balances in the justified state.
+func (s *Service) forkChoiceHead(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "forkchoice.forkChoiceHead")
+	defer span.End()
+
+	j := s.justifiedCheckpt
+	f := s.finalizedCheckpt
+	balances, err := s.justifiedBalances(ctx, j)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return s.forkChoiceStore.Head(ctx, f.Epoch, bytesutil.ToBytes32(j.Root), balances, j.Epoch)
+}
+
+// justifiedBalances returns the effective balances of the validators in the state of the input
+// justified checkpoint. Validators which are not active at the checkpoint epoch have no weight in
+// fork choice. The balances are cached until the justified checkpoint changes.
+//
+// Spec pseudocode definition:
+//   def get_latest_attesting_balance(store: Store, root: Root) -> Gwei:
+//    state = store.checkpoint_states[store.justified_checkpoint]
+//    active_indices = get_active_validator_indices(state, get_current_epoch(state))
+//    return Gwei(sum(
+//        state.validators[i].effective_balance for i in active_indices
+//        if (i in store.latest_messages
+//            and get_ancestor(store, store.latest_messages[i].root, store.blocks[root].slot) == root)
+//    ))
+func (s *Service) justifiedBalances(ctx context.Context, c *ethpb.Checkpoint) ([]uint64, error) {
+	s.justifiedBalancesLock.Lock()
+	defer s.justifiedBalancesLock.Unlock()
+
+	root := bytesutil.ToBytes32(c.Root)
+	if s.justifiedBalancesCache != nil && s.justifiedBalancesRoot == root {
+		return s.justifiedBalancesCache, nil
+	}
+
+	baseState, err := s.verifyAttPreState(ctx, c)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get justified state")
+	}
+	justifiedState, err := s.saveCheckpointState(ctx, baseState, c)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get justified checkpoint state")
+	}
+
+	epoch := helpers.CurrentEpoch(justifiedState)
+	balances := make([]uint64, len(justifiedState.Validators))
+	for i, v := range justifiedState.Validators {
+		if helpers.IsActiveValidator(v, epoch) {
+			balances[i] = v.EffectiveBalance
+		}
+	}
+	s.justifiedBalancesRoot = root
+	s.justifiedBalancesCache = balances
+	return balances, nil
+}
@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// saveForkChoiceSnapshot persists a snapshot of the proto-array fork choice store, so that it
+// can be restored on restart.
+func (s *Service) saveForkChoiceSnapshot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.saveForkChoiceSnapshot")
+	defer span.End()
+
+	if s.forkChoiceStore == nil {
+		return nil
+	}
+	return s.beaconDB.SaveForkChoiceStore(ctx, s.forkChoiceStore.Snapshot())
+}
+
+// maybeSaveForkChoiceSnapshot saves a snapshot of the fork choice store once per epoch, when the
+// first block of a later epoch than the last snapshot is processed.
+func (s *Service) maybeSaveForkChoiceSnapshot(ctx context.Context, slot uint64) {
+	epoch := helpers.SlotToEpoch(slot)
+	s.forkChoiceSnapshotLock.Lock()
+	defer s.forkChoiceSnapshotLock.Unlock()
+	if epoch <= s.forkChoiceSnapshotEpoch {
+		return
+	}
+	if err := s.saveForkChoiceSnapshot(ctx); err != nil {
+		log.WithError(err).Error("Could not save fork choice snapshot")
+		return
+	}
+	s.forkChoiceSnapshotEpoch = epoch
+}
+
+// restoreForkChoice loads the fork choice store from the snapshot saved in the database. The
+// snapshot is only used if it is consistent with the database, such that it contains the last
+// finalized block and every block it references is stored. It returns nil if no snapshot was saved.
+func (s *Service) restoreForkChoice(ctx context.Context, finalizedCheckpoint *ethpb.Checkpoint) (*protoarray.ForkChoice, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.restoreForkChoice")
+	defer span.End()
+
+	snapshot, err := s.beaconDB.ForkChoiceStore(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork choice snapshot")
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+	store, err := protoarray.Restore(snapshot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not restore fork choice snapshot")
+	}
+
+	finalizedRoot := bytesutil.ToBytes32(finalizedCheckpoint.Root)
+	hasFinalized := false
+	for _, n := range snapshot.Nodes {
+		root := bytesutil.ToBytes32(n.Root)
+		if root == finalizedRoot {
+			hasFinalized = true
+		}
+		if !s.beaconDB.HasBlock(ctx, root) {
+			return nil, fmt.Errorf("block %#x of fork choice snapshot is not in db", root)
+		}
+	}
+	if !hasFinalized {
+		return nil, fmt.Errorf("finalized block %#x is not in fork choice snapshot", finalizedRoot)
+	}
+	return store, nil
+}
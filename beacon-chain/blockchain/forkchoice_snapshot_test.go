@@ -0,0 +1,115 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestService_ResumeForkChoice_FromSnapshot(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{ParentRoot: params.BeaconConfig().ZeroHash[:]}}
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:]}}
+	blkRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range []*ethpb.SignedBeaconBlock{genesis, blk} {
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.SaveState(ctx, &pb.BeaconState{Slot: 1}, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Service{beaconDB: db, forkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash)}
+	if err := s.forkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.forkChoiceStore.ProcessBlock(ctx, 1, blkRoot, genesisRoot, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.saveForkChoiceSnapshot(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint := &ethpb.Checkpoint{Root: genesisRoot[:]}
+	restarted := &Service{beaconDB: db}
+	if err := restarted.resumeForkChoice(ctx, checkpoint, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	nodes := restarted.forkChoiceStore.Nodes()
+	if len(nodes) != 2 {
+		t.Fatalf("Wanted 2 restored nodes, got %d", len(nodes))
+	}
+	if nodes[1].Parent != 0 {
+		t.Error("Expected the restored block to be linked to its parent")
+	}
+	head, err := restarted.forkChoiceStore.Head(ctx, 0, genesisRoot, []uint64{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != blkRoot {
+		t.Errorf("Wanted head %#x, got %#x", blkRoot, head)
+	}
+}
+
+func TestService_ResumeForkChoice_InconsistentSnapshot(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	head := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: params.BeaconConfig().ZeroHash[:]}}
+	headRoot, err := ssz.HashTreeRoot(head.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, &pb.BeaconState{Slot: 1}, headRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, headRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	// The snapshot references a block which is not in the database.
+	s := &Service{beaconDB: db, forkChoiceStore: protoarray.New(0, 0, params.BeaconConfig().ZeroHash)}
+	if err := s.forkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'a'}, params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.saveForkChoiceSnapshot(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint := &ethpb.Checkpoint{Root: headRoot[:]}
+	restarted := &Service{beaconDB: db}
+	if err := restarted.resumeForkChoice(ctx, checkpoint, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	nodes := restarted.forkChoiceStore.Nodes()
+	if len(nodes) != 1 {
+		t.Fatalf("Wanted only the head block in fork choice, got %d nodes", len(nodes))
+	}
+	if nodes[0].Slot != head.Block.Slot {
+		t.Error("Expected the head block in fork choice")
+	}
+}
@@ -0,0 +1,120 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// headBlockAndRoot returns the current head block and its root.
+func (s *Service) headBlockAndRoot() (*ethpb.SignedBeaconBlock, [32]byte) {
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+
+	return s.headBlock, bytesutil.ToBytes32(s.canonicalRoots[s.headSlot])
+}
+
+// notifyHeadChange sends a HeadChanged event to the state feed when the head moved from the old
+// head block to the new one. When the new head does not descend from the old head, a Reorg event
+// describing the rolled back part of the chain is sent first.
+func (s *Service) notifyHeadChange(
+	ctx context.Context,
+	oldHead *ethpb.SignedBeaconBlock,
+	oldRoot [32]byte,
+	newHead *ethpb.SignedBeaconBlock,
+	newRoot [32]byte) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.notifyHeadChange")
+	defer span.End()
+
+	// Nothing to report if there was no previous head, such as at chain start.
+	if s.stateNotifier == nil || oldHead == nil || oldHead.Block == nil || oldRoot == [32]byte{} || oldRoot == newRoot {
+		return
+	}
+
+	// The new head extends the old head in the common case, which does not require walking back
+	// the chain.
+	if !bytes.Equal(newHead.Block.ParentRoot, oldRoot[:]) {
+		ancestor, ancestorRoot, err := s.commonAncestor(ctx, oldHead.Block, oldRoot, newHead.Block, newRoot)
+		if err != nil {
+			log.WithError(err).Warn("Could not determine common ancestor of old and new head")
+		} else if ancestorRoot != oldRoot {
+			depth := oldHead.Block.Slot - ancestor.Slot
+			reorgCount.Inc()
+			reorgDepth.Observe(float64(depth))
+			log.WithFields(logrus.Fields{
+				"oldSlot":      oldHead.Block.Slot,
+				"oldRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(oldRoot[:])),
+				"newSlot":      newHead.Block.Slot,
+				"newRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(newRoot[:])),
+				"ancestorSlot": ancestor.Slot,
+				"depth":        depth,
+			}).Warn("Chain reorg occurred")
+			s.stateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{
+					OldSlot:            oldHead.Block.Slot,
+					OldBlockRoot:       oldRoot,
+					NewSlot:            newHead.Block.Slot,
+					NewBlockRoot:       newRoot,
+					CommonAncestorSlot: ancestor.Slot,
+					CommonAncestorRoot: ancestorRoot,
+					Depth:              depth,
+				},
+			})
+		}
+	}
+
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.HeadChanged,
+		Data: &statefeed.HeadChangedData{
+			Slot:              newHead.Block.Slot,
+			BlockRoot:         newRoot,
+			PreviousSlot:      oldHead.Block.Slot,
+			PreviousBlockRoot: oldRoot,
+		},
+	})
+}
+
+// commonAncestor returns the latest block which is an ancestor of both given blocks, or one of
+// the blocks itself, by walking back the parents of the block with the higher slot until both
+// walks meet.
+func (s *Service) commonAncestor(
+	ctx context.Context,
+	a *ethpb.BeaconBlock,
+	aRoot [32]byte,
+	b *ethpb.BeaconBlock,
+	bRoot [32]byte) (*ethpb.BeaconBlock, [32]byte, error) {
+	var err error
+	for aRoot != bRoot {
+		if a.Slot >= b.Slot {
+			a, aRoot, err = s.parentBlock(ctx, a)
+		} else {
+			b, bRoot, err = s.parentBlock(ctx, b)
+		}
+		if err != nil {
+			return nil, [32]byte{}, err
+		}
+	}
+	return a, aRoot, nil
+}
+
+// parentBlock retrieves the parent of a block from DB.
+func (s *Service) parentBlock(ctx context.Context, b *ethpb.BeaconBlock) (*ethpb.BeaconBlock, [32]byte, error) {
+	parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+	parent, err := s.beaconDB.Block(ctx, parentRoot)
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get parent block")
+	}
+	if parent == nil || parent.Block == nil {
+		return nil, [32]byte{}, fmt.Errorf("parent block %#x of slot %d is not in db", parentRoot, b.Slot)
+	}
+	return parent.Block, parentRoot, nil
+}
@@ -0,0 +1,129 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// saveBlock saves a block with the given slot and parent along with its state, and returns it
+// with its root.
+func saveBlock(t *testing.T, s *Service, slot uint64, parentRoot [32]byte) (*ethpb.SignedBeaconBlock, [32]byte) {
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+	if err := s.beaconDB.SaveBlock(context.Background(), b); err != nil {
+		t.Fatal(err)
+	}
+	r, err := ssz.HashTreeRoot(b.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.beaconDB.SaveState(context.Background(), &pb.BeaconState{Slot: slot}, r); err != nil {
+		t.Fatal(err)
+	}
+	return b, r
+}
+
+func TestService_SaveHead_NotifiesHeadChanges(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+	s := &Service{
+		beaconDB:       db,
+		canonicalRoots: make(map[uint64][]byte),
+		stateNotifier:  &mockBeaconNode{},
+	}
+	events := make(chan *feed.Event, 10)
+	sub := s.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	genesis, genesisRoot := saveBlock(t, s, 0, [32]byte{})
+	a1, a1Root := saveBlock(t, s, 1, genesisRoot)
+	a2, a2Root := saveBlock(t, s, 2, a1Root)
+	b3, b3Root := saveBlock(t, s, 3, genesisRoot)
+
+	// Extending the head only reports a head change.
+	for _, h := range []struct {
+		blk  *ethpb.SignedBeaconBlock
+		root [32]byte
+	}{{genesis, genesisRoot}, {a1, a1Root}, {a2, a2Root}} {
+		if err := s.saveHead(ctx, h.blk, h.root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, want := range [][32]byte{a1Root, a2Root} {
+		event := <-events
+		if event.Type != statefeed.HeadChanged {
+			t.Fatalf("Wanted head changed event, received %d", event.Type)
+		}
+		if data := event.Data.(*statefeed.HeadChangedData); data.BlockRoot != want {
+			t.Errorf("Wanted head root %#x, received %#x", want, data.BlockRoot)
+		}
+	}
+
+	// Switching to a competing branch reports a reorg, followed by the head change.
+	if err := s.saveHead(ctx, b3, b3Root); err != nil {
+		t.Fatal(err)
+	}
+	event := <-events
+	if event.Type != statefeed.Reorg {
+		t.Fatalf("Wanted reorg event, received %d", event.Type)
+	}
+	reorg := event.Data.(*statefeed.ReorgData)
+	if reorg.OldBlockRoot != a2Root || reorg.NewBlockRoot != b3Root {
+		t.Errorf("Unexpected reorg heads %#x -> %#x", reorg.OldBlockRoot, reorg.NewBlockRoot)
+	}
+	if reorg.CommonAncestorRoot != genesisRoot || reorg.CommonAncestorSlot != 0 {
+		t.Errorf("Wanted common ancestor %#x, received %#x", genesisRoot, reorg.CommonAncestorRoot)
+	}
+	if reorg.Depth != 2 {
+		t.Errorf("Wanted reorg depth 2, received %d", reorg.Depth)
+	}
+	event = <-events
+	if event.Type != statefeed.HeadChanged {
+		t.Fatalf("Wanted head changed event, received %d", event.Type)
+	}
+	if data := event.Data.(*statefeed.HeadChangedData); data.PreviousBlockRoot != a2Root || data.BlockRoot != b3Root {
+		t.Errorf("Unexpected head change %#x -> %#x", data.PreviousBlockRoot, data.BlockRoot)
+	}
+}
+
+func TestService_CommonAncestor(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+	s := &Service{beaconDB: db}
+
+	_, genesisRoot := saveBlock(t, s, 0, [32]byte{})
+	a1, a1Root := saveBlock(t, s, 1, genesisRoot)
+	a3, a3Root := saveBlock(t, s, 3, a1Root)
+	b2, b2Root := saveBlock(t, s, 2, a1Root)
+
+	ancestor, root, err := s.commonAncestor(ctx, a3.Block, a3Root, b2.Block, b2Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != a1Root || ancestor.Slot != a1.Block.Slot {
+		t.Errorf("Wanted common ancestor %#x, received %#x", a1Root, root)
+	}
+
+	// A block is its own common ancestor with any of its descendants.
+	_, root, err = s.commonAncestor(ctx, a1.Block, a1Root, a3.Block, a3Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != a1Root {
+		t.Errorf("Wanted common ancestor %#x, received %#x", a1Root, root)
+	}
+
+	// Walking back past a missing block fails.
+	orphan, orphanRoot := saveBlock(t, s, 4, [32]byte{'x'})
+	if _, _, err := s.commonAncestor(ctx, orphan.Block, orphanRoot, a3.Block, a3Root); err == nil {
+		t.Error("Expected an error walking back to a missing block")
+	}
+}
@@ -26,6 +26,20 @@ var (
 		Name: "competing_blocks",
 		Help: "The # of blocks received and processed from a competing chain",
 	})
+	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_reorg_total",
+		Help: "The # of times the head switched to a chain which does not descend from the previous head",
+	})
+	reorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beacon_reorg_depth_slots",
+		Help:    "The # of slots of the previous chain rolled back by a reorg",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+	})
+	blockArrivalDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beacon_block_arrival_delay_milliseconds",
+		Help:    "The time between the start of a block's slot and its arrival, in milliseconds",
+		Buckets: []float64{250, 500, 1000, 2000, 3000, 4000, 6000, 8000, 12000, 24000},
+	})
 	processedBlkNoPubsub = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "processed_no_pubsub_block_counter",
 		Help: "The # of processed block without pubsub, this usually means the blocks from sync",
@@ -0,0 +1,44 @@
+package blockchain
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// notifyBlockOperations sends a BlockOperationsIncluded event to the operation feed with the
+// operations included in a processed block.
+func (s *Service) notifyBlockOperations(b *ethpb.BeaconBlock, root [32]byte) {
+	if s.opNotifier == nil || b == nil || b.Body == nil {
+		return
+	}
+	s.opNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.BlockOperationsIncluded,
+		Data: &opfeed.BlockOperationsIncludedData{
+			Slot:              b.Slot,
+			BlockRoot:         root,
+			Attestations:      b.Body.Attestations,
+			Deposits:          b.Body.Deposits,
+			VoluntaryExits:    b.Body.VoluntaryExits,
+			ProposerSlashings: b.Body.ProposerSlashings,
+			AttesterSlashings: b.Body.AttesterSlashings,
+		},
+	})
+}
+
+// notifyFinalizedCheckpoint sends a FinalizedCheckpoint event to the state feed when the
+// finalized checkpoint advanced past the given epoch.
+func (s *Service) notifyFinalizedCheckpoint(prevFinalizedEpoch uint64) {
+	if s.stateNotifier == nil || s.finalizedCheckpt == nil || s.finalizedCheckpt.Epoch <= prevFinalizedEpoch {
+		return
+	}
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{
+			Epoch:     s.finalizedCheckpt.Epoch,
+			BlockRoot: bytesutil.ToBytes32(s.finalizedCheckpt.Root),
+		},
+	})
+}
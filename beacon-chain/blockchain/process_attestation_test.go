@@ -1,4 +1,4 @@
-package forkchoice
+package blockchain
 
 import (
 	"context"
@@ -7,8 +7,8 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -22,9 +22,8 @@ func TestStore_OnAttestation(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	store := NewForkChoiceService(ctx, db)
-
-	_, err := blockTree1(db, []byte{'g'})
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -40,7 +39,7 @@ func TestStore_OnAttestation(t *testing.T) {
 		t.Fatal(err)
 	}
 	BlkWithStateBadAttRoot, _ := ssz.HashTreeRoot(BlkWithStateBadAtt.Block)
-	if err := store.db.SaveState(ctx, &pb.BeaconState{}, BlkWithStateBadAttRoot); err != nil {
+	if err := db.SaveState(ctx, &pb.BeaconState{}, BlkWithStateBadAttRoot); err != nil {
 		t.Fatal(err)
 	}
 
@@ -49,7 +48,7 @@ func TestStore_OnAttestation(t *testing.T) {
 		t.Fatal(err)
 	}
 	BlkWithValidStateRoot, _ := ssz.HashTreeRoot(BlkWithValidState.Block)
-	if err := store.db.SaveState(ctx, &pb.BeaconState{
+	if err := db.SaveState(ctx, &pb.BeaconState{
 		Fork: &pb.Fork{
 			Epoch:           0,
 			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
@@ -100,17 +99,10 @@ func TestStore_OnAttestation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := store.GenesisStore(
-				ctx,
-				&ethpb.Checkpoint{Root: BlkWithValidStateRoot[:]},
-				&ethpb.Checkpoint{Root: BlkWithValidStateRoot[:]}); err != nil {
-				t.Fatal(err)
-			}
-
-			_, err := store.OnAttestation(ctx, tt.a)
+			_, err := service.onAttestation(ctx, tt.a)
 			if tt.wantErr {
 				if !strings.Contains(err.Error(), tt.wantErrString) {
-					t.Errorf("Store.OnAttestation() error = %v, wantErr = %v", err, tt.wantErrString)
+					t.Errorf("Service.onAttestation() error = %v, wantErr = %v", err, tt.wantErrString)
 				}
 			} else {
 				t.Error(err)
@@ -125,7 +117,11 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 	defer testDB.TeardownDB(t, db)
 	params.UseDemoBeaconConfig()
 
-	store := NewForkChoiceService(ctx, db)
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	s := &pb.BeaconState{
 		Fork: &pb.Fork{
@@ -142,15 +138,13 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 		FinalizedCheckpoint: &ethpb.Checkpoint{},
 	}
 	r := [32]byte{'g'}
-	if err := store.db.SaveState(ctx, s, r); err != nil {
-		t.Fatal(err)
-	}
-	if err := store.GenesisStore(ctx, &ethpb.Checkpoint{Root: r[:]}, &ethpb.Checkpoint{Root: r[:]}); err != nil {
+	if err := db.SaveState(ctx, s, r); err != nil {
 		t.Fatal(err)
 	}
+	service.checkpointState = cache.NewCheckpointStateCache()
 
 	cp1 := &ethpb.Checkpoint{Epoch: 1, Root: []byte{'A'}}
-	s1, err := store.saveCheckpointState(ctx, s, cp1)
+	s1, err := service.saveCheckpointState(ctx, s, cp1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -159,7 +153,7 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 	}
 
 	cp2 := &ethpb.Checkpoint{Epoch: 2, Root: []byte{'B'}}
-	s2, err := store.saveCheckpointState(ctx, s, cp2)
+	s2, err := service.saveCheckpointState(ctx, s, cp2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -167,7 +161,7 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 		t.Errorf("Wanted state slot: %d, got: %d", 2*params.BeaconConfig().SlotsPerEpoch, s2.Slot)
 	}
 
-	s1, err = store.saveCheckpointState(ctx, nil, cp1)
+	s1, err = service.saveCheckpointState(ctx, nil, cp1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -175,7 +169,7 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 		t.Errorf("Wanted state slot: %d, got: %d", 1*params.BeaconConfig().SlotsPerEpoch, s1.Slot)
 	}
 
-	s1, err = store.checkpointState.StateByCheckpoint(cp1)
+	s1, err = service.checkpointState.StateByCheckpoint(cp1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -183,7 +177,7 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 		t.Errorf("Wanted state slot: %d, got: %d", 1*params.BeaconConfig().SlotsPerEpoch, s1.Slot)
 	}
 
-	s2, err = store.checkpointState.StateByCheckpoint(cp2)
+	s2, err = service.checkpointState.StateByCheckpoint(cp2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -192,11 +186,9 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 	}
 
 	s.Slot = params.BeaconConfig().SlotsPerEpoch + 1
-	if err := store.GenesisStore(ctx, &ethpb.Checkpoint{Root: r[:]}, &ethpb.Checkpoint{Root: r[:]}); err != nil {
-		t.Fatal(err)
-	}
+	service.checkpointState = cache.NewCheckpointStateCache()
 	cp3 := &ethpb.Checkpoint{Epoch: 1, Root: []byte{'C'}}
-	s3, err := store.saveCheckpointState(ctx, s, cp3)
+	s3, err := service.saveCheckpointState(ctx, s, cp3)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -205,41 +197,22 @@ func TestStore_SaveCheckpointState(t *testing.T) {
 	}
 }
 
-func TestStore_ReturnAggregatedAttestation(t *testing.T) {
+func TestStore_UpdateCheckpointState(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	store := NewForkChoiceService(ctx, db)
-	a1 := &ethpb.Attestation{Data: &ethpb.AttestationData{}, AggregationBits: bitfield.Bitlist{0x02}}
-	err := store.db.SaveAttestation(ctx, a1)
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	a2 := &ethpb.Attestation{Data: &ethpb.AttestationData{}, AggregationBits: bitfield.Bitlist{0x03}}
-	saved, err := store.aggregatedAttestations(ctx, a2)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if !reflect.DeepEqual([]*ethpb.Attestation{a2}, saved) {
-		t.Error("did not retrieve saved attestation")
-	}
-}
-
-func TestStore_UpdateCheckpointState(t *testing.T) {
-	ctx := context.Background()
-	db := testDB.SetupDB(t)
-	defer testDB.TeardownDB(t, db)
-
-	store := NewForkChoiceService(ctx, db)
-
 	epoch := uint64(1)
 	baseState, _ := testutil.DeterministicGenesisState(t, 1)
 	baseState.Slot = epoch * params.BeaconConfig().SlotsPerEpoch
 	checkpoint := &ethpb.Checkpoint{Epoch: epoch}
-	returned, err := store.saveCheckpointState(ctx, baseState, checkpoint)
+	returned, err := service.saveCheckpointState(ctx, baseState, checkpoint)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -247,7 +220,7 @@ func TestStore_UpdateCheckpointState(t *testing.T) {
 		t.Error("Incorrectly returned base state")
 	}
 
-	cached, err := store.checkpointState.StateByCheckpoint(checkpoint)
+	cached, err := service.checkpointState.StateByCheckpoint(checkpoint)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -257,7 +230,7 @@ func TestStore_UpdateCheckpointState(t *testing.T) {
 
 	epoch = uint64(2)
 	newCheckpoint := &ethpb.Checkpoint{Epoch: epoch}
-	returned, err = store.saveCheckpointState(ctx, baseState, newCheckpoint)
+	returned, err = service.saveCheckpointState(ctx, baseState, newCheckpoint)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -269,7 +242,7 @@ func TestStore_UpdateCheckpointState(t *testing.T) {
 		t.Error("Incorrectly returned base state")
 	}
 
-	cached, err = store.checkpointState.StateByCheckpoint(newCheckpoint)
+	cached, err = service.checkpointState.StateByCheckpoint(newCheckpoint)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -283,8 +256,12 @@ func TestAttEpoch_MatchPrevEpoch(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	store := NewForkChoiceService(ctx, db)
-	if err := store.verifyAttTargetEpoch(
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.verifyAttTargetEpoch(
 		ctx,
 		0,
 		params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot,
@@ -298,8 +275,12 @@ func TestAttEpoch_MatchCurrentEpoch(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	store := NewForkChoiceService(ctx, db)
-	if err := store.verifyAttTargetEpoch(
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.verifyAttTargetEpoch(
 		ctx,
 		0,
 		params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot,
@@ -313,8 +294,12 @@ func TestAttEpoch_NotMatch(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	store := NewForkChoiceService(ctx, db)
-	if err := store.verifyAttTargetEpoch(
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.verifyAttTargetEpoch(
 		ctx,
 		0,
 		2*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot,
@@ -329,9 +314,13 @@ func TestVerifyBeaconBlock_NoBlock(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	s := NewForkChoiceService(ctx, db)
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
 	d := &ethpb.AttestationData{}
-	if err := s.verifyBeaconBlock(ctx, d); !strings.Contains(err.Error(), "beacon block  does not exist") {
+	if err := service.verifyBeaconBlock(ctx, d); !strings.Contains(err.Error(), "beacon block  does not exist") {
 		t.Error("Did not receive the wanted error")
 	}
 }
@@ -341,13 +330,19 @@ func TestVerifyBeaconBlock_futureBlock(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	s := NewForkChoiceService(ctx, db)
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
 	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2}}
-	s.db.SaveBlock(ctx, b)
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
 	r, _ := ssz.HashTreeRoot(b.Block)
 	d := &ethpb.AttestationData{Slot: 1, BeaconBlockRoot: r[:]}
 
-	if err := s.verifyBeaconBlock(ctx, d); !strings.Contains(err.Error(), "could not process attestation for future block") {
+	if err := service.verifyBeaconBlock(ctx, d); !strings.Contains(err.Error(), "could not process attestation for future block") {
 		t.Error("Did not receive the wanted error")
 	}
 }
@@ -357,13 +352,19 @@ func TestVerifyBeaconBlock_OK(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 
-	s := NewForkChoiceService(ctx, db)
+	cfg := &Config{BeaconDB: db}
+	service, err := NewService(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
 	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2}}
-	s.db.SaveBlock(ctx, b)
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
 	r, _ := ssz.HashTreeRoot(b.Block)
 	d := &ethpb.AttestationData{Slot: 2, BeaconBlockRoot: r[:]}
 
-	if err := s.verifyBeaconBlock(ctx, d); err != nil {
+	if err := service.verifyBeaconBlock(ctx, d); err != nil {
 		t.Error("Did not receive the wanted error")
 	}
 }
@@ -137,7 +137,8 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock)
 
 // onBlockInitialSyncStateTransition is called when an initial sync block is received.
 // It runs state transition on the block and without any BLS verification. The excluded BLS verification
-// includes attestation's aggregated signature. It also does not save attestations.
+// includes attestation's aggregated signature. It also does not save attestations. With batch verification
+// enabled, all the signatures of the block are verified at once instead.
 func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed *ethpb.SignedBeaconBlock) (*pb.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "forkchoice.onBlock")
 	defer span.End()
@@ -158,7 +159,12 @@ func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed
 	}
 	preStateValidatorCount := len(preState.Validators)
 
-	postState, err := state.ExecuteStateTransitionNoVerifyAttSigs(ctx, preState, signed)
+	var postState *pb.BeaconState
+	if featureconfig.Get().EnableBatchVerification {
+		postState, err = state.ExecuteStateTransitionBatchVerify(ctx, preState, signed)
+	} else {
+		postState, err = state.ExecuteStateTransitionNoVerifyAttSigs(ctx, preState, signed)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "could not execute state transition")
 	}
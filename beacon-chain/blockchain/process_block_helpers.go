@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
@@ -16,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -239,9 +239,35 @@ func (s *Service) updateJustified(ctx context.Context, state *pb.BeaconState) er
 	return s.beaconDB.SaveJustifiedCheckpoint(ctx, state.CurrentJustifiedCheckpoint)
 }
 
+// updateJustifiedCheckpoint promotes the best justified checkpoint to the justified checkpoint used
+// by fork choice, which is only done at the start of an epoch to prevent bouncing attacks.
+//
+// Spec pseudocode definition:
+//   def on_tick(store: Store, time: uint64) -> None:
+//    previous_slot = get_current_slot(store)
+//
+//    # update store time
+//    store.time = time
+//
+//    current_slot = get_current_slot(store)
+//    # Not a new epoch, return
+//    if not (current_slot > previous_slot and compute_slots_since_epoch_start(current_slot) == 0):
+//        return
+//    # Update store.justified_checkpoint if a better checkpoint is known
+//    if store.best_justified_checkpoint.epoch > store.justified_checkpoint.epoch:
+//        store.justified_checkpoint = store.best_justified_checkpoint
+func (s *Service) updateJustifiedCheckpoint() {
+	if !helpers.IsEpochStart(s.currentSlot()) {
+		return
+	}
+	if s.bestJustifiedCheckpt != nil && s.bestJustifiedCheckpt.Epoch > s.justifiedCheckpt.Epoch {
+		s.justifiedCheckpt = s.bestJustifiedCheckpt
+	}
+}
+
 // currentSlot returns the current slot based on time.
 func (s *Service) currentSlot() uint64 {
-	return uint64(time.Now().Unix()-s.genesisTime.Unix()) / params.BeaconConfig().SecondsPerSlot
+	return uint64(roughtime.Now().Unix()-s.genesisTime.Unix()) / params.BeaconConfig().SecondsPerSlot
 }
 
 // This receives cached state in memory for initial sync only during initial sync.
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -13,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -33,21 +33,19 @@ func (s *Service) ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Att
 	defer span.End()
 
 	// Update forkchoice store for the new attestation
-	indices, err := s.forkChoiceStoreOld.OnAttestation(ctx, att)
+	indices, err := s.onAttestation(ctx, att)
 	if err != nil {
-		return errors.Wrap(err, "could not process attestation from fork choice service")
-	}
-
-	if featureconfig.Get().ProtoArrayForkChoice {
-		s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(att.Data.BeaconBlockRoot), att.Data.Target.Epoch)
+		return errors.Wrap(err, "could not process attestation")
 	}
+	s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(att.Data.BeaconBlockRoot), att.Data.Target.Epoch)
 
 	// Run fork choice for head block after updating fork choice store.
-	if !featureconfig.Get().DisableForkChoice && !featureconfig.Get().ProtoArrayForkChoice {
-		headRoot, err := s.forkChoiceStoreOld.Head(ctx)
+	if !featureconfig.Get().DisableForkChoice {
+		r, err := s.forkChoiceHead(ctx)
 		if err != nil {
-			return errors.Wrap(err, "could not get head from fork choice service")
+			return errors.Wrap(err, "could not get head from fork choice store")
 		}
+		headRoot := r[:]
 		// Only save head if it's different than the current head.
 		cachedHeadRoot, err := s.HeadRoot(ctx)
 		if err != nil {
@@ -86,6 +84,7 @@ func (s *Service) processAttestation() {
 			return
 		case <-st.C():
 			ctx := context.Background()
+			s.updateJustifiedCheckpoint()
 			atts := s.attPool.ForkchoiceAttestations()
 			for _, a := range atts {
 				hasState := s.beaconDB.HasState(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
@@ -115,7 +114,7 @@ func (s *Service) processAttestation() {
 // This verifies the epoch of input checkpoint is within current epoch and previous epoch
 // with respect to current time. Returns true if it's within, false if it's not.
 func (s *Service) verifyCheckpointEpoch(c *ethpb.Checkpoint) bool {
-	now := uint64(time.Now().Unix())
+	now := uint64(roughtime.Now().Unix())
 	genesisTime := uint64(s.genesisTime.Unix())
 	currentSlot := (now - genesisTime) / params.BeaconConfig().SecondsPerSlot
 	currentEpoch := helpers.SlotToEpoch(currentSlot)
@@ -6,8 +6,10 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"golang.org/x/net/context"
@@ -20,25 +22,30 @@ func TestReceiveAttestationNoPubsub_ProcessCorrectly(t *testing.T) {
 	ctx := context.Background()
 
 	chainService := setupBeaconChain(t, db)
-	r, _ := ssz.HashTreeRoot(&ethpb.BeaconBlock{})
-	chainService.forkChoiceStoreOld = &store{headRoot: r[:]}
-
-	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
-	if err := chainService.beaconDB.SaveBlock(ctx, b); err != nil {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	beaconState.GenesisTime = uint64(time.Now().Unix()) - 2*params.BeaconConfig().SecondsPerSlot
+	stateRoot, err := stateutil.HashTreeRootState(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	if err := chainService.beaconDB.SaveBlock(ctx, genesis); err != nil {
 		t.Fatal(err)
 	}
-	root, err := ssz.HashTreeRoot(b.Block)
+	root, err := ssz.HashTreeRoot(genesis.Block)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := chainService.beaconDB.SaveState(ctx, &pb.BeaconState{}, root); err != nil {
+	if err := chainService.beaconDB.SaveState(ctx, beaconState, root); err != nil {
 		t.Fatal(err)
 	}
+	setupGenesisCheckpoints(t, chainService, root)
 
-	a := &ethpb.Attestation{Data: &ethpb.AttestationData{
-		Target: &ethpb.Checkpoint{Root: root[:]},
-	}}
-	if err := chainService.ReceiveAttestationNoPubsub(ctx, a); err != nil {
+	atts, err := testutil.GenerateAttestations(beaconState, privKeys, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chainService.ReceiveAttestationNoPubsub(ctx, atts[0]); err != nil {
 		t.Fatal(err)
 	}
 
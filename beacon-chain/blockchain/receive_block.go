@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
@@ -16,6 +17,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -24,7 +26,7 @@ import (
 // BlockReceiver interface defines the methods of chain service receive and processing new blocks.
 type BlockReceiver interface {
 	ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlock) error
-	ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock) error
+	ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock, arrival time.Time) error
 	ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *ethpb.SignedBeaconBlock) error
 	ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedBeaconBlock) error
 }
@@ -38,6 +40,7 @@ type BlockReceiver interface {
 func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlock")
 	defer span.End()
+	arrival := roughtime.Now()
 
 	root, err := ssz.HashTreeRoot(block.Block)
 	if err != nil {
@@ -52,7 +55,7 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
 
-	if err := s.ReceiveBlockNoPubsub(ctx, block); err != nil {
+	if err := s.ReceiveBlockNoPubsub(ctx, block, arrival); err != nil {
 		return err
 	}
 
@@ -65,34 +68,29 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 //   1. Validate block, apply state transition and update check points
 //   2. Apply fork choice to the processed block
 //   3. Save latest head info
-func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
+// The arrival time is the time at which the block was first received by the node, before any
+// queueing, and is recorded as the arrival delay of the block.
+func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock, arrival time.Time) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlockNoPubsub")
 	defer span.End()
 	blockCopy := proto.Clone(block).(*ethpb.SignedBeaconBlock)
 
 	// Apply state transition on the new block.
-	var postState *pb.BeaconState
-	var err error
-	if featureconfig.Get().ProtoArrayForkChoice {
-		postState, err = s.onBlock(ctx, blockCopy)
-		if err != nil {
-			err := errors.Wrap(err, "could not process block")
-			traceutil.AnnotateError(span, err)
-			return err
-		}
-	} else {
-		postState, err = s.forkChoiceStoreOld.OnBlockCacheFilteredTree(ctx, blockCopy)
-		if err != nil {
-			err := errors.Wrap(err, "could not process block from fork choice service")
-			traceutil.AnnotateError(span, err)
-			return err
-		}
+	prevFinalizedEpoch := s.finalizedCheckpt.Epoch
+	postState, err := s.onBlock(ctx, blockCopy)
+	if err != nil {
+		err := errors.Wrap(err, "could not process block")
+		traceutil.AnnotateError(span, err)
+		return err
 	}
 
 	root, err := ssz.HashTreeRoot(blockCopy.Block)
 	if err != nil {
 		return errors.Wrap(err, "could not get signing root on received block")
 	}
+	if err := s.recordBlockArrival(ctx, root, blockCopy.Block.Slot, arrival); err != nil {
+		return err
+	}
 
 	// Send notification of the processed block to the state feed.
 	s.stateNotifier.StateFeed().Send(&feed.Event{
@@ -102,6 +100,8 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 			Verified:  true,
 		},
 	})
+	s.notifyBlockOperations(blockCopy.Block, root)
+	s.notifyFinalizedCheckpoint(prevFinalizedEpoch)
 
 	// Add attestations from the block to the pool for fork choice.
 	if err := s.attPool.SaveBlockAttestations(blockCopy.Block.Body.Attestations); err != nil {
@@ -129,50 +129,24 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 			return errors.Wrap(err, "could not save head")
 		}
 	} else {
-		headRoot := make([]byte, 0)
-		if featureconfig.Get().ProtoArrayForkChoice {
-			if err := s.forkChoiceStore.ProcessBlock(ctx, blockCopy.Block.Slot, root, bytesutil.ToBytes32(blockCopy.Block.ParentRoot), postState.CurrentJustifiedCheckpoint.Epoch, postState.FinalizedCheckpoint.Epoch); err != nil {
-				return errors.Wrap(err, "could not process block for proto array fork choice")
-			}
-
-			for _, a := range blockCopy.Block.Body.Attestations {
-				committee, err := helpers.BeaconCommitteeFromState(postState, a.Data.Slot, a.Data.CommitteeIndex)
-				if err != nil {
-					return err
-				}
-				indices, err := helpers.AttestingIndices(a.AggregationBits, committee)
-				if err != nil {
-					return err
-				}
-				s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
-			}
-
-			f := s.finalizedCheckpt
-			j := s.justifiedCheckpt
-			headRootProtoArray, err := s.forkChoiceStore.Head(
-				ctx,
-				f.Epoch,
-				bytesutil.ToBytes32(j.Root),
-				postState.Balances,
-				j.Epoch)
-			if err != nil {
-				log.Warnf("Skip head update for slot %d: %v", block.Block.Slot, err)
-				return nil
-			}
+		if err := s.insertForkChoiceBlock(ctx, blockCopy.Block, root, postState); err != nil {
+			return err
+		}
 
-			if postState.FinalizedCheckpoint.Epoch > f.Epoch {
-				if err := s.forkChoiceStore.Prune(ctx, bytesutil.ToBytes32(postState.FinalizedCheckpoint.Root)); err != nil {
-					return errors.Wrap(err, "could not prune proto array fork choice")
-				}
-			}
+		headRootProtoArray, err := s.forkChoiceHead(ctx)
+		if err != nil {
+			log.Warnf("Skip head update for slot %d: %v", block.Block.Slot, err)
+			return nil
+		}
 
-			headRoot = headRootProtoArray[:]
-		} else {
-			headRoot, err = s.forkChoiceStoreOld.Head(ctx)
-			if err != nil {
-				return errors.Wrap(err, "could not get head from fork choice service")
+		if s.finalizedCheckpt.Epoch > prevFinalizedEpoch {
+			if err := s.forkChoiceStore.Prune(ctx, bytesutil.ToBytes32(s.finalizedCheckpt.Root)); err != nil {
+				log.WithError(err).Warn("Could not prune fork choice store")
 			}
 		}
+		s.maybeSaveForkChoiceSnapshot(ctx, blockCopy.Block.Slot)
+
+		headRoot := headRootProtoArray[:]
 		// Only save head if it's different than the current head.
 		cachedHeadRoot, err := s.HeadRoot(ctx)
 		if err != nil {
@@ -206,22 +180,12 @@ func (s *Service) ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *eth
 	blockCopy := proto.Clone(block).(*ethpb.SignedBeaconBlock)
 
 	// Apply state transition on the new block.
-	var postState *pb.BeaconState
-	var err error
-	if featureconfig.Get().ProtoArrayForkChoice {
-		postState, err = s.onBlock(ctx, blockCopy)
-		if err != nil {
-			err := errors.Wrap(err, "could not process block")
-			traceutil.AnnotateError(span, err)
-			return err
-		}
-	} else {
-		postState, err = s.forkChoiceStoreOld.OnBlock(ctx, blockCopy)
-		if err != nil {
-			err := errors.Wrap(err, "could not process block from fork choice service")
-			traceutil.AnnotateError(span, err)
-			return err
-		}
+	prevFinalizedEpoch := s.finalizedCheckpt.Epoch
+	postState, err := s.onBlock(ctx, blockCopy)
+	if err != nil {
+		err := errors.Wrap(err, "could not process block")
+		traceutil.AnnotateError(span, err)
+		return err
 	}
 
 	root, err := ssz.HashTreeRoot(blockCopy.Block)
@@ -238,23 +202,10 @@ func (s *Service) ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *eth
 		}
 	}
 
-	if featureconfig.Get().ProtoArrayForkChoice {
-		if err := s.forkChoiceStore.ProcessBlock(ctx, blockCopy.Block.Slot, root, bytesutil.ToBytes32(blockCopy.Block.ParentRoot), postState.CurrentJustifiedCheckpoint.Epoch, postState.FinalizedCheckpoint.Epoch); err != nil {
-			return errors.Wrap(err, "could not process block for proto array fork choice")
-		}
-
-		for _, a := range blockCopy.Block.Body.Attestations {
-			committee, err := helpers.BeaconCommitteeFromState(postState, a.Data.Slot, a.Data.CommitteeIndex)
-			if err != nil {
-				return err
-			}
-			indices, err := helpers.AttestingIndices(a.AggregationBits, committee)
-			if err != nil {
-				return err
-			}
-			s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
-		}
+	if err := s.insertForkChoiceBlock(ctx, blockCopy.Block, root, postState); err != nil {
+		return err
 	}
+	s.maybeSaveForkChoiceSnapshot(ctx, blockCopy.Block.Slot)
 
 	// Send notification of the processed block to the state feed.
 	s.stateNotifier.StateFeed().Send(&feed.Event{
@@ -264,6 +215,8 @@ func (s *Service) ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *eth
 			Verified:  true,
 		},
 	})
+	s.notifyBlockOperations(blockCopy.Block, root)
+	s.notifyFinalizedCheckpoint(prevFinalizedEpoch)
 
 	// Reports on block and fork choice metrics.
 	s.reportSlotMetrics(blockCopy.Block.Slot)
@@ -288,20 +241,12 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 	blockCopy := proto.Clone(block).(*ethpb.SignedBeaconBlock)
 
 	// Apply state transition on the incoming newly received blockCopy without verifying its BLS contents.
-	var postState *pb.BeaconState
-	var err error
-	if featureconfig.Get().ProtoArrayForkChoice {
-		postState, err = s.onBlockInitialSyncStateTransition(ctx, blockCopy)
-		if err != nil {
-			err := errors.Wrap(err, "could not process block")
-			traceutil.AnnotateError(span, err)
-			return err
-		}
-	} else {
-		postState, err = s.forkChoiceStoreOld.OnBlockInitialSyncStateTransition(ctx, blockCopy)
-		if err != nil {
-			return errors.Wrap(err, "could not process blockCopy from fork choice service")
-		}
+	prevFinalizedEpoch := s.finalizedCheckpt.Epoch
+	postState, err := s.onBlockInitialSyncStateTransition(ctx, blockCopy)
+	if err != nil {
+		err := errors.Wrap(err, "could not process block")
+		traceutil.AnnotateError(span, err)
+		return err
 	}
 
 	root, err := ssz.HashTreeRoot(blockCopy.Block)
@@ -314,22 +259,8 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 		return errors.Wrap(err, "could not get head root from cache")
 	}
 
-	if featureconfig.Get().ProtoArrayForkChoice {
-		if err := s.forkChoiceStore.ProcessBlock(ctx, blockCopy.Block.Slot, root, bytesutil.ToBytes32(blockCopy.Block.ParentRoot), postState.CurrentJustifiedCheckpoint.Epoch, postState.FinalizedCheckpoint.Epoch); err != nil {
-			return errors.Wrap(err, "could not process block for proto array fork choice")
-		}
-
-		for _, a := range blockCopy.Block.Body.Attestations {
-			committee, err := helpers.BeaconCommitteeFromState(postState, a.Data.Slot, a.Data.CommitteeIndex)
-			if err != nil {
-				return err
-			}
-			indices, err := helpers.AttestingIndices(a.AggregationBits, committee)
-			if err != nil {
-				return err
-			}
-			s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
-		}
+	if err := s.insertForkChoiceBlock(ctx, blockCopy.Block, root, postState); err != nil {
+		return err
 	}
 
 	if featureconfig.Get().InitSyncCacheState {
@@ -358,6 +289,7 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 			Verified:  false,
 		},
 	})
+	s.notifyFinalizedCheckpoint(prevFinalizedEpoch)
 
 	// Reports on blockCopy and fork choice metrics.
 	s.reportSlotMetrics(blockCopy.Block.Slot)
@@ -376,6 +308,27 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 	return nil
 }
 
+// This inserts a processed block into the fork choice store, and accounts for the votes of the
+// attestations included in the block.
+func (s *Service) insertForkChoiceBlock(ctx context.Context, b *ethpb.BeaconBlock, root [32]byte, postState *pb.BeaconState) error {
+	if err := s.forkChoiceStore.ProcessBlock(ctx, b.Slot, root, bytesutil.ToBytes32(b.ParentRoot), postState.CurrentJustifiedCheckpoint.Epoch, postState.FinalizedCheckpoint.Epoch); err != nil {
+		return errors.Wrap(err, "could not process block for proto array fork choice")
+	}
+
+	for _, a := range b.Body.Attestations {
+		committee, err := helpers.BeaconCommitteeFromState(postState, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return err
+		}
+		indices, err := helpers.AttestingIndices(a.AggregationBits, committee)
+		if err != nil {
+			return err
+		}
+		s.forkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
+	}
+	return nil
+}
+
 // This checks if the block is from a competing chain, emits warning and updates metrics.
 func isCompetingBlock(root []byte, slot uint64, headRoot []byte, headSlot uint64) {
 	if !bytes.Equal(root[:], headRoot) {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/stateutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -43,10 +45,7 @@ func TestReceiveBlock_ProcessCorrectly(t *testing.T) {
 	if err := db.SaveState(ctx, beaconState, genesisBlkRoot); err != nil {
 		t.Fatal(err)
 	}
-	cp := &ethpb.Checkpoint{Root: genesisBlkRoot[:]}
-	if err := chainService.forkChoiceStoreOld.GenesisStore(ctx, cp, cp); err != nil {
-		t.Fatal(err)
-	}
+	setupGenesisCheckpoints(t, chainService, genesisBlkRoot)
 
 	if err := chainService.beaconDB.SaveBlock(ctx, genesis); err != nil {
 		t.Fatalf("Could not save block to db: %v", err)
@@ -73,6 +72,7 @@ func TestReceiveReceiveBlockNoPubsub_CanSaveHeadInfo(t *testing.T) {
 	ctx := context.Background()
 
 	chainService := setupBeaconChain(t, db)
+	beaconState, privKeys := receiveBlockGenesis(t, chainService)
 
 	headBlk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 100}}
 	if err := db.SaveBlock(ctx, headBlk); err != nil {
@@ -86,14 +86,13 @@ func TestReceiveReceiveBlockNoPubsub_CanSaveHeadInfo(t *testing.T) {
 	if err := db.SaveState(ctx, head, r); err != nil {
 		t.Fatal(err)
 	}
-	chainService.forkChoiceStoreOld = &store{headRoot: r[:]}
+	chainService.forkChoiceStore = &store{headRoot: r[:]}
 
-	if err := chainService.ReceiveBlockNoPubsub(ctx, &ethpb.SignedBeaconBlock{
-		Block: &ethpb.BeaconBlock{
-			Slot: 1,
-			Body: &ethpb.BeaconBlockBody{},
-		},
-	}); err != nil {
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, beaconState.Slot+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chainService.ReceiveBlockNoPubsub(ctx, block, time.Now()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -119,32 +118,53 @@ func TestReceiveReceiveBlockNoPubsub_SameHead(t *testing.T) {
 	ctx := context.Background()
 
 	chainService := setupBeaconChain(t, db)
+	beaconState, privKeys := receiveBlockGenesis(t, chainService)
 
-	headBlk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
-	if err := db.SaveBlock(ctx, headBlk); err != nil {
+	newBlk, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, beaconState.Slot+1)
+	if err != nil {
 		t.Fatal(err)
 	}
-	newBlk := &ethpb.SignedBeaconBlock{
-		Block: &ethpb.BeaconBlock{
-			Slot: 1,
-			Body: &ethpb.BeaconBlockBody{},
-		},
-	}
-	newRoot, _ := ssz.HashTreeRoot(newBlk.Block)
-	if err := db.SaveBlock(ctx, newBlk); err != nil {
+	newRoot, err := ssz.HashTreeRoot(newBlk.Block)
+	if err != nil {
 		t.Fatal(err)
 	}
 
-	chainService.forkChoiceStoreOld = &store{headRoot: newRoot[:]}
+	chainService.forkChoiceStore = &store{headRoot: newRoot[:]}
 	chainService.canonicalRoots[0] = newRoot[:]
 
-	if err := chainService.ReceiveBlockNoPubsub(ctx, newBlk); err != nil {
+	if err := chainService.ReceiveBlockNoPubsub(ctx, newBlk, time.Now()); err != nil {
 		t.Fatal(err)
 	}
 
 	testutil.AssertLogsDoNotContain(t, hook, "Saved new head info")
 }
 
+// receiveBlockGenesis saves a genesis block and state for the chain service to build blocks on top of.
+func receiveBlockGenesis(t *testing.T, s *Service) (*pb.BeaconState, []*bls.SecretKey) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := stateutil.HashTreeRootState(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.beaconDB.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.beaconDB.SaveState(ctx, beaconState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	setupGenesisCheckpoints(t, s, genesisRoot)
+	return beaconState, privKeys
+}
+
 func TestReceiveBlockNoPubsubForkchoice_ProcessCorrectly(t *testing.T) {
 	hook := logTest.NewGlobal()
 	db := testDB.SetupDB(t)
@@ -175,9 +195,7 @@ func TestReceiveBlockNoPubsubForkchoice_ProcessCorrectly(t *testing.T) {
 	if err := db.SaveState(ctx, beaconState, parentRoot); err != nil {
 		t.Fatal(err)
 	}
-	if err := chainService.forkChoiceStoreOld.GenesisStore(ctx, &ethpb.Checkpoint{Root: parentRoot[:]}, &ethpb.Checkpoint{Root: parentRoot[:]}); err != nil {
-		t.Fatal(err)
-	}
+	setupGenesisCheckpoints(t, chainService, parentRoot)
 
 	if err := chainService.beaconDB.SaveBlock(ctx, block); err != nil {
 		t.Fatalf("Could not save block to db: %v", err)
@@ -11,14 +11,16 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
-	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -48,7 +50,6 @@ type Service struct {
 	chainStartFetcher      powchain.ChainStartFetcher
 	attPool                attestations.Pool
 	exitPool               *voluntaryexits.Pool
-	forkChoiceStoreOld     forkchoice.ForkChoicer
 	genesisTime            time.Time
 	p2p                    p2p.Broadcaster
 	maxRoutines            int64
@@ -58,6 +59,7 @@ type Service struct {
 	canonicalRoots         map[uint64][]byte
 	headLock               sync.RWMutex
 	stateNotifier          statefeed.Notifier
+	opNotifier             opfeed.Notifier
 	genesisRoot            [32]byte
 	epochParticipation     map[uint64]*precompute.Balance
 	epochParticipationLock sync.RWMutex
@@ -67,9 +69,20 @@ type Service struct {
 	finalizedCheckpt       *ethpb.Checkpoint
 	prevFinalizedCheckpt   *ethpb.Checkpoint
 	nextEpochBoundarySlot  uint64
-	voteLock               sync.RWMutex
 	initSyncState          map[[32]byte]*pb.BeaconState
 	initSyncStateLock      sync.RWMutex
+	checkpointState        *cache.CheckpointStateCache
+	checkpointStateLock    sync.Mutex
+	// justifiedBalancesCache holds the effective balances of the justified state used to weight
+	// votes in fork choice, for the justified checkpoint of root justifiedBalancesRoot.
+	justifiedBalancesCache []uint64
+	justifiedBalancesRoot  [32]byte
+	justifiedBalancesLock  sync.Mutex
+	// forkChoiceSnapshotEpoch is the epoch of the last fork choice snapshot saved to the database.
+	forkChoiceSnapshotEpoch uint64
+	forkChoiceSnapshotLock  sync.Mutex
+	// blockArrivalDelays holds the arrival delays of recently processed blocks, by block root.
+	blockArrivalDelays *lru.Cache
 }
 
 // Config options for the service.
@@ -83,6 +96,7 @@ type Config struct {
 	P2p               p2p.Broadcaster
 	MaxRoutines       int64
 	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
 	ForkChoiceStore   f.ForkChoicer
 }
 
@@ -90,8 +104,7 @@ type Config struct {
 // be registered into a running beacon node.
 func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	store := forkchoice.NewForkChoiceService(ctx, cfg.BeaconDB)
-	return &Service{
+	s := &Service{
 		ctx:                ctx,
 		cancel:             cancel,
 		beaconDB:           cfg.BeaconDB,
@@ -99,15 +112,19 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		chainStartFetcher:  cfg.ChainStartFetcher,
 		attPool:            cfg.AttPool,
 		exitPool:           cfg.ExitPool,
-		forkChoiceStoreOld: store,
 		p2p:                cfg.P2p,
 		canonicalRoots:     make(map[uint64][]byte),
 		maxRoutines:        cfg.MaxRoutines,
 		stateNotifier:      cfg.StateNotifier,
+		opNotifier:         cfg.OperationNotifier,
 		epochParticipation: make(map[uint64]*precompute.Balance),
 		forkChoiceStore:    cfg.ForkChoiceStore,
 		initSyncState:      make(map[[32]byte]*pb.BeaconState),
-	}, nil
+		checkpointState:    cache.NewCheckpointStateCache(),
+		blockArrivalDelays: newBlockArrivalDelayCache(),
+	}
+	s.setForkChoiceTieBreaker()
+	return s, nil
 }
 
 // Start a blockchain service's main event loop.
@@ -149,19 +166,13 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatalf("Could not get finalized checkpoint: %v", err)
 		}
-		if err := s.forkChoiceStoreOld.GenesisStore(ctx, justifiedCheckpoint, finalizedCheckpoint); err != nil {
-			log.Fatalf("Could not start fork choice service: %v", err)
-		}
-
-		if featureconfig.Get().ProtoArrayForkChoice {
-			s.justifiedCheckpt = proto.Clone(justifiedCheckpoint).(*ethpb.Checkpoint)
-			s.bestJustifiedCheckpt = proto.Clone(justifiedCheckpoint).(*ethpb.Checkpoint)
-			s.finalizedCheckpt = proto.Clone(finalizedCheckpoint).(*ethpb.Checkpoint)
-			s.prevFinalizedCheckpt = proto.Clone(finalizedCheckpoint).(*ethpb.Checkpoint)
+		s.justifiedCheckpt = proto.Clone(justifiedCheckpoint).(*ethpb.Checkpoint)
+		s.bestJustifiedCheckpt = proto.Clone(justifiedCheckpoint).(*ethpb.Checkpoint)
+		s.finalizedCheckpt = proto.Clone(finalizedCheckpoint).(*ethpb.Checkpoint)
+		s.prevFinalizedCheckpt = proto.Clone(finalizedCheckpoint).(*ethpb.Checkpoint)
 
-			if err := s.resumeForkChoice(ctx, justifiedCheckpoint, finalizedCheckpoint); err != nil {
-				log.Fatalf("Could not resume fork choice: %v", err)
-			}
+		if err := s.resumeForkChoice(ctx, justifiedCheckpoint, finalizedCheckpoint); err != nil {
+			log.Fatalf("Could not resume fork choice: %v", err)
 		}
 
 		s.stateNotifier.StateFeed().Send(&feed.Event{
@@ -259,6 +270,10 @@ func (s *Service) initializeBeaconChain(
 // Stop the blockchain service's main event loop and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
+
+	if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+		log.WithError(err).Error("Could not save fork choice snapshot")
+	}
 	return nil
 }
 
@@ -271,8 +286,19 @@ func (s *Service) Status() error {
 	return nil
 }
 
-// This gets called to update canonical root mapping.
+// This gets called to update canonical root mapping. Subscribers of the state feed are notified
+// of the head change, and of the reorg if the new head does not descend from the previous head.
 func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) error {
+	oldHeadBlock, oldHeadRoot := s.headBlockAndRoot()
+	if err := s.setHead(ctx, signed, r); err != nil {
+		return err
+	}
+	s.notifyHeadChange(ctx, oldHeadBlock, oldHeadRoot, signed, r)
+	return nil
+}
+
+// This updates the head info and saves the head block root in DB.
+func (s *Service) setHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) error {
 	s.headLock.Lock()
 	defer s.headLock.Unlock()
 
@@ -306,6 +332,16 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 // root in DB. With the inception of inital-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
 func (s *Service) saveHeadNoDB(ctx context.Context, b *ethpb.SignedBeaconBlock, r [32]byte) error {
+	oldHeadBlock, oldHeadRoot := s.headBlockAndRoot()
+	if err := s.setHeadNoDB(ctx, b, r); err != nil {
+		return err
+	}
+	s.notifyHeadChange(ctx, oldHeadBlock, oldHeadRoot, b, r)
+	return nil
+}
+
+// This updates the head info without saving the head block root in DB.
+func (s *Service) setHeadNoDB(ctx context.Context, b *ethpb.SignedBeaconBlock, r [32]byte) error {
 	s.headLock.Lock()
 	defer s.headLock.Unlock()
 
@@ -371,25 +407,22 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState *pb.BeaconSt
 	}
 
 	genesisCheckpoint := &ethpb.Checkpoint{Root: genesisBlkRoot[:]}
-	if err := s.forkChoiceStoreOld.GenesisStore(ctx, genesisCheckpoint, genesisCheckpoint); err != nil {
-		return errors.Wrap(err, "Could not start fork choice service: %v")
+	s.justifiedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
+	s.bestJustifiedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
+	s.finalizedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
+	s.prevFinalizedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
+	if featureconfig.Get().InitSyncCacheState {
+		s.initSyncState[genesisBlkRoot] = genesisState
 	}
 
 	// Add the genesis block to the fork choice store.
-	if featureconfig.Get().ProtoArrayForkChoice {
-		s.justifiedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
-		s.bestJustifiedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
-		s.finalizedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
-		s.prevFinalizedCheckpt = proto.Clone(genesisCheckpoint).(*ethpb.Checkpoint)
-
-		if err := s.forkChoiceStore.ProcessBlock(ctx,
-			genesisBlk.Block.Slot,
-			genesisBlkRoot,
-			params.BeaconConfig().ZeroHash,
-			genesisCheckpoint.Epoch,
-			genesisCheckpoint.Epoch); err != nil {
-			log.Fatalf("Could not process genesis block for fork choice: %v", err)
-		}
+	if err := s.forkChoiceStore.ProcessBlock(ctx,
+		genesisBlk.Block.Slot,
+		genesisBlkRoot,
+		params.BeaconConfig().ZeroHash,
+		genesisCheckpoint.Epoch,
+		genesisCheckpoint.Epoch); err != nil {
+		log.Fatalf("Could not process genesis block for fork choice: %v", err)
 	}
 
 	s.genesisRoot = genesisBlkRoot
@@ -444,14 +477,25 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	return nil
 }
 
-// This is called when a client starts from non-genesis slot. This passes last justified and finalized
-// information to fork choice service to initializes fork choice store.
+// This is called when a client starts from non-genesis slot. The fork choice store is restored from
+// the snapshot saved in DB, if it is consistent with DB. Otherwise, this passes last justified and
+// finalized information to fork choice service to initializes fork choice store.
 func (s *Service) resumeForkChoice(
 	ctx context.Context,
 	justifiedCheckpoint *ethpb.Checkpoint,
 	finalizedCheckpoint *ethpb.Checkpoint) error {
-	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
-	s.forkChoiceStore = store
+	store, err := s.restoreForkChoice(ctx, finalizedCheckpoint)
+	if err != nil {
+		log.WithError(err).Warn("Could not restore fork choice from snapshot, starting from head block")
+	}
+	if store != nil {
+		log.WithField("nodes", len(store.Nodes())).Info("Restored fork choice from snapshot")
+		s.forkChoiceStore = store
+	} else {
+		s.forkChoiceStore = protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	}
+	s.setForkChoiceTieBreaker()
+	s.forkChoiceSnapshotEpoch = helpers.SlotToEpoch(s.headSlot)
 
 	headBlock, err := s.beaconDB.HeadBlock(ctx)
 	if err != nil {
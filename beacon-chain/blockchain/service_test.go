@@ -21,9 +21,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -38,42 +40,47 @@ func init() {
 	logrus.SetOutput(ioutil.Discard)
 }
 
+// store is a mock fork choice store which always returns the configured head root.
 type store struct {
 	headRoot []byte
 }
 
-func (s *store) OnBlock(ctx context.Context, b *ethpb.SignedBeaconBlock) (*pb.BeaconState, error) {
-	return nil, nil
+func (s *store) Head(_ context.Context, _ uint64, _ [32]byte, _ []uint64, _ uint64) ([32]byte, error) {
+	return bytesutil.ToBytes32(s.headRoot), nil
 }
 
-func (s *store) OnBlockCacheFilteredTree(ctx context.Context, b *ethpb.SignedBeaconBlock) (*pb.BeaconState, error) {
-	return nil, nil
+func (s *store) ProcessBlock(_ context.Context, _ uint64, _ [32]byte, _ [32]byte, _ uint64, _ uint64) error {
+	return nil
 }
 
-func (s *store) OnBlockInitialSyncStateTransition(ctx context.Context, b *ethpb.SignedBeaconBlock) (*pb.BeaconState, error) {
-	return nil, nil
-}
+func (s *store) ProcessAttestation(_ context.Context, _ []uint64, _ [32]byte, _ uint64) {}
 
-func (s *store) OnAttestation(ctx context.Context, a *ethpb.Attestation) ([]uint64, error) {
-	return nil, nil
+func (s *store) Prune(_ context.Context, _ [32]byte) error {
+	return nil
 }
 
-func (s *store) GenesisStore(ctx context.Context, justifiedCheckpoint *ethpb.Checkpoint, finalizedCheckpoint *ethpb.Checkpoint) error {
+func (s *store) Nodes() []*protoarray.Node {
 	return nil
 }
 
-func (s *store) FinalizedCheckpt() *ethpb.Checkpoint {
-	return nil
+func (s *store) JustifiedEpoch() uint64 {
+	return 0
 }
 
-func (s *store) JustifiedCheckpt() *ethpb.Checkpoint {
-	return nil
+func (s *store) FinalizedEpoch() uint64 {
+	return 0
+}
+
+func (s *store) IsViableForHead(_ *protoarray.Node) bool {
+	return true
 }
 
-func (s *store) Head(ctx context.Context) ([]byte, error) {
-	return s.headRoot, nil
+func (s *store) Snapshot() *dbpb.ForkChoiceStore {
+	return &dbpb.ForkChoiceStore{}
 }
 
+func (s *store) SetTieBreaker(_ protoarray.TieBreaker) {}
+
 type mockBeaconNode struct {
 	stateFeed *event.Feed
 }
@@ -119,6 +126,7 @@ func setupBeaconChain(t *testing.T, beaconDB db.Database) *Service {
 		P2p:               &mockBroadcaster{},
 		StateNotifier:     &mockBeaconNode{},
 		AttPool:           attestations.NewPool(),
+		ForkChoiceStore:   protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
 	}
 	if err != nil {
 		t.Fatalf("could not register blockchain service: %v", err)
@@ -132,6 +140,19 @@ func setupBeaconChain(t *testing.T, beaconDB db.Database) *Service {
 	return chainService
 }
 
+// setupGenesisCheckpoints anchors the chain service's checkpoints and fork choice store at the given root.
+func setupGenesisCheckpoints(t *testing.T, s *Service, root [32]byte) {
+	cp := &ethpb.Checkpoint{Root: root[:]}
+	s.justifiedCheckpt = proto.Clone(cp).(*ethpb.Checkpoint)
+	s.bestJustifiedCheckpt = proto.Clone(cp).(*ethpb.Checkpoint)
+	s.finalizedCheckpt = proto.Clone(cp).(*ethpb.Checkpoint)
+	s.prevFinalizedCheckpt = proto.Clone(cp).(*ethpb.Checkpoint)
+	s.forkChoiceStore = protoarray.New(0, 0, root)
+	if err := s.forkChoiceStore.ProcessBlock(context.Background(), 0, root, params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestChainStartStop_Uninitialized(t *testing.T) {
 	hook := logTest.NewGlobal()
 	db := testDB.SetupDB(t)
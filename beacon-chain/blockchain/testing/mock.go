@@ -13,6 +13,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -28,10 +29,12 @@ type ChainService struct {
 	CurrentJustifiedCheckPoint  *ethpb.Checkpoint
 	PreviousJustifiedCheckPoint *ethpb.Checkpoint
 	BlocksReceived              []*ethpb.SignedBeaconBlock
+	BlockArrivals               []time.Time
 	Balance                     *precompute.Balance
 	Genesis                     time.Time
 	Fork                        *pb.Fork
 	DB                          db.Database
+	ForkChoice                  forkchoice.ForkChoicer
 	stateNotifier               statefeed.Notifier
 	opNotifier                  opfeed.Notifier
 }
@@ -89,7 +92,8 @@ func (ms *ChainService) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.S
 }
 
 // ReceiveBlockNoPubsub mocks ReceiveBlockNoPubsub method in chain service.
-func (ms *ChainService) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
+func (ms *ChainService) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock, arrival time.Time) error {
+	ms.BlockArrivals = append(ms.BlockArrivals, arrival)
 	return nil
 }
 
@@ -195,3 +199,8 @@ func (ms *ChainService) GenesisTime() time.Time {
 func (ms *ChainService) Participation(epoch uint64) *precompute.Balance {
 	return ms.Balance
 }
+
+// ForkChoiceStore mocks the same method in the chain service.
+func (ms *ChainService) ForkChoiceStore() forkchoice.ForkChoicer {
+	return ms.ForkChoice
+}
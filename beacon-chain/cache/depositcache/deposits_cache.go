@@ -8,11 +8,15 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -27,8 +31,10 @@ var (
 // DepositFetcher defines a struct which can retrieve deposit information from a store.
 type DepositFetcher interface {
 	AllDeposits(ctx context.Context, beforeBlk *big.Int) []*ethpb.Deposit
+	AllDepositContainers(ctx context.Context) []*dbpb.DepositContainer
 	DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int)
 	DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte)
+	DepositTrie(ctx context.Context, beforeBlk *big.Int) (*trieutil.SparseMerkleTrie, error)
 }
 
 // DepositCache stores all in-memory deposit objects. This
@@ -37,6 +43,7 @@ type DepositCache struct {
 	// Beacon chain deposits in memory.
 	pendingDeposits       []*dbpb.DepositContainer
 	deposits              []*dbpb.DepositContainer
+	snapshot              *dbpb.DepositSnapshot
 	depositsLock          sync.RWMutex
 	chainStartDeposits    []*ethpb.Deposit
 	chainstartPubkeys     map[string]bool
@@ -88,6 +95,17 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*dbp
 	historicalDepositsCount.Add(float64(len(ctrs)))
 }
 
+// InsertDepositSnapshot sets the snapshot of the finalized deposits, which stands in for the
+// deposits preceding it when they are not in the cache.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *dbpb.DepositSnapshot) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	dc.snapshot = snapshot
+}
+
 // AllDepositContainers returns a list of deposits all historical deposit containers until the given block number.
 func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*dbpb.DepositContainer {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.AllDepositContainers")
@@ -145,12 +163,59 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
-	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
-	// deposit.
 	if heightIdx == 0 {
+		// send the deposits of the snapshot when the deposits preceding the earliest deposit are
+		// only known from it.
+		if dc.snapshot != nil && dc.snapshot.Eth1BlockHeight <= blockHeight.Uint64() {
+			return dc.snapshot.DepositCount, bytesutil.ToBytes32(dc.snapshot.DepositRoot)
+		}
+		// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
+		// deposit.
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	// the cache starts after the deposits of the snapshot when it was bootstrapped from one.
+	return uint64(dc.deposits[0].Index) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+}
+
+// DepositTrie returns the deposit trie of all deposits until the given block number (inclusive), or
+// of all deposits if no block is specified. When the cache starts after the deposits of the snapshot,
+// the trie is rebuilt from the snapshot and only provides valid proofs for the following deposits.
+func (dc *DepositCache) DepositTrie(ctx context.Context, beforeBlk *big.Int) (*trieutil.SparseMerkleTrie, error) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositTrie")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	fromSnapshot := dc.snapshot != nil && (len(dc.deposits) == 0 || dc.deposits[0].Index > 0)
+	var leaves [][]byte
+	var indices []int
+	for _, ctnr := range dc.deposits {
+		if beforeBlk != nil && beforeBlk.Uint64() < ctnr.Eth1BlockHeight {
+			continue
+		}
+		if fromSnapshot && uint64(ctnr.Index) < dc.snapshot.DepositCount {
+			continue
+		}
+		depHash, err := ssz.HashTreeRoot(ctnr.Deposit.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		leaves = append(leaves, depHash[:])
+		indices = append(indices, int(ctnr.Index))
+	}
+	if !fromSnapshot {
+		return trieutil.GenerateTrieFromItems(leaves, depth)
+	}
+
+	depositTrie, err := trieutil.GenerateTrieFromFinalizedBranch(dc.snapshot.Finalized, int(dc.snapshot.DepositCount), depth)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate deposit trie from snapshot")
+	}
+	for i, leaf := range leaves {
+		depositTrie.Insert(leaf, indices[i])
+	}
+	return depositTrie, nil
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -172,3 +237,26 @@ func (dc *DepositCache) DepositByPubkey(ctx context.Context, pubKey []byte) (*et
 	}
 	return deposit, blockNum
 }
+
+// PruneDepositsAfterHeight removes the deposits and pending deposits included in blocks above the
+// given block number, which were replaced by an eth1 reorg. It returns the number of deposits removed.
+func (dc *DepositCache) PruneDepositsAfterHeight(ctx context.Context, blockHeight uint64) int {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.PruneDepositsAfterHeight")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight })
+	removed := len(dc.deposits) - heightIdx
+	dc.deposits = dc.deposits[:heightIdx]
+
+	var pendingDeposits []*dbpb.DepositContainer
+	for _, ctnr := range dc.pendingDeposits {
+		if ctnr.Eth1BlockHeight <= blockHeight {
+			pendingDeposits = append(pendingDeposits, ctnr)
+		}
+	}
+	dc.pendingDeposits = pendingDeposits
+	pendingDepositsCount.Set(float64(len(dc.pendingDeposits)))
+	return removed
+}
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"math/big"
+	"reflect"
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -273,3 +277,185 @@ func TestBeaconDB_DepositByPubkey_ReturnsFirstMatchingDeposit(t *testing.T) {
 		t.Errorf("Returned wrong block number %v", blkNum)
 	}
 }
+
+func TestDepositCache_DepositTrie(t *testing.T) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	var ctnrs []*dbpb.DepositContainer
+	var leaves [][]byte
+	for i := 0; i < 12; i++ {
+		pubkey := make([]byte, 48)
+		pubkey[0] = byte(i)
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pubkey,
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+		}
+		leaf, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaves = append(leaves, leaf[:])
+		ctnrs = append(ctnrs, &dbpb.DepositContainer{
+			Deposit:         &ethpb.Deposit{Data: data},
+			Eth1BlockHeight: uint64(10 + i/2),
+			Index:           int64(i),
+		})
+	}
+	fullTrie, err := trieutil.GenerateTrieFromItems(leaves, depth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		count     int
+		beforeBlk *big.Int
+		wantItems int
+	}{
+		{
+			name:      "no snapshot",
+			count:     0,
+			wantItems: 12,
+		},
+		{
+			name:      "no snapshot up to block",
+			count:     0,
+			beforeBlk: big.NewInt(13),
+			wantItems: 8,
+		},
+		{
+			name:      "snapshot",
+			count:     5,
+			wantItems: 12,
+		},
+		{
+			name:      "snapshot up to block",
+			count:     5,
+			beforeBlk: big.NewInt(13),
+			wantItems: 8,
+		},
+		{
+			name:      "snapshot of all deposits",
+			count:     12,
+			wantItems: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dc := NewDepositCache()
+			dc.InsertDepositContainers(context.Background(), ctnrs[tt.count:])
+			if tt.count > 0 {
+				branch, err := fullTrie.FinalizedBranch(tt.count)
+				if err != nil {
+					t.Fatal(err)
+				}
+				dc.InsertDepositSnapshot(context.Background(), &dbpb.DepositSnapshot{
+					Finalized:    branch,
+					DepositCount: uint64(tt.count),
+				})
+			}
+
+			depositTrie, err := dc.DepositTrie(context.Background(), tt.beforeBlk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantTrie, err := trieutil.GenerateTrieFromItems(leaves[:tt.wantItems], depth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if depositTrie.HashTreeRoot() != wantTrie.HashTreeRoot() {
+				t.Errorf("Wanted deposit root %#x, received %#x", wantTrie.HashTreeRoot(), depositTrie.HashTreeRoot())
+			}
+			for i := tt.count; i < tt.wantItems; i++ {
+				proof, err := depositTrie.MerkleProof(i)
+				if err != nil {
+					t.Fatal(err)
+				}
+				wantProof, err := wantTrie.MerkleProof(i)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(proof, wantProof) {
+					t.Errorf("Wrong merkle proof for deposit %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestDepositCache_DepositsNumberAndRootAtHeight_Snapshot(t *testing.T) {
+	dc := NewDepositCache()
+	dc.InsertDepositSnapshot(context.Background(), &dbpb.DepositSnapshot{
+		DepositCount:    5,
+		DepositRoot:     []byte("snapshot root"),
+		Eth1BlockHeight: 10,
+	})
+	dc.InsertDepositContainers(context.Background(), []*dbpb.DepositContainer{
+		{
+			Eth1BlockHeight: 12,
+			Deposit:         &ethpb.Deposit{},
+			Index:           5,
+			DepositRoot:     []byte("root 5"),
+		},
+		{
+			Eth1BlockHeight: 13,
+			Deposit:         &ethpb.Deposit{},
+			Index:           6,
+			DepositRoot:     []byte("root 6"),
+		},
+	})
+
+	tests := []struct {
+		height    int64
+		wantCount uint64
+		wantRoot  []byte
+	}{
+		{
+			height:    9,
+			wantCount: 0,
+		},
+		{
+			height:    11,
+			wantCount: 5,
+			wantRoot:  []byte("snapshot root"),
+		},
+		{
+			height:    12,
+			wantCount: 6,
+			wantRoot:  []byte("root 5"),
+		},
+		{
+			height:    20,
+			wantCount: 7,
+			wantRoot:  []byte("root 6"),
+		},
+	}
+	for _, tt := range tests {
+		n, root := dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(tt.height))
+		if n != tt.wantCount {
+			t.Errorf("Returned unexpected deposits number %d at height %d, wanted %d", n, tt.height, tt.wantCount)
+		}
+		if root != bytesutil.ToBytes32(tt.wantRoot) {
+			t.Errorf("Returned unexpected root %#x at height %d", root, tt.height)
+		}
+	}
+}
+
+func TestDepositCache_PruneDepositsAfterHeight(t *testing.T) {
+	dc := NewDepositCache()
+	for i := int64(0); i < 4; i++ {
+		dc.InsertDeposit(context.Background(), &ethpb.Deposit{}, uint64(10+i), i, [32]byte{byte(i)})
+		dc.InsertPendingDeposit(context.Background(), &ethpb.Deposit{}, uint64(10+i), i, [32]byte{byte(i)})
+	}
+
+	if removed := dc.PruneDepositsAfterHeight(context.Background(), 11); removed != 2 {
+		t.Errorf("Removed %d deposits, wanted 2", removed)
+	}
+	if n, root := dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(20)); n != 2 || root != [32]byte{1} {
+		t.Errorf("Returned %d deposits with root %#x, wanted 2 deposits with the root of deposit 1", n, root)
+	}
+	if pending := dc.PendingContainers(context.Background(), nil); len(pending) != 2 {
+		t.Errorf("Kept %d pending deposits, wanted 2", len(pending))
+	}
+}
@@ -0,0 +1,165 @@
+// Package dutycache defines a lookahead cache of the committee and proposer duties of the
+// validators for the current and next epoch of the head of the chain, so that validator RPCs do
+// not recompute shuffles for every request.
+package dutycache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+var (
+	dutiesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "duties_cache_miss",
+		Help: "The number of duties requests for an epoch that aren't present in the cache.",
+	})
+	dutiesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "duties_cache_hit",
+		Help: "The number of duties requests for an epoch that are present in the cache.",
+	})
+)
+
+// EpochDuties is the duty table of the active validators for an epoch.
+type EpochDuties struct {
+	Epoch uint64
+	// Committees maps the index of a validator to its committee assignment.
+	Committees map[uint64]*helpers.CommitteeAssignmentContainer
+	// ProposerSlots maps the index of a validator to the slot it proposes at.
+	ProposerSlots map[uint64]uint64
+}
+
+// DutiesCache stores the duty tables of the current and next epoch of the head state, built once
+// per epoch. A nil cache is valid and never contains any duties.
+type DutiesCache struct {
+	lock   sync.RWMutex
+	duties map[uint64]*EpochDuties
+	// headEpoch is the epoch of the head state the duties were computed from.
+	headEpoch uint64
+	valid     bool
+	// generation is incremented when the cache is invalidated, so that duties computed from a head
+	// state fetched before a reorg are not stored.
+	generation uint64
+}
+
+// NewDutiesCache creates an empty duties cache.
+func NewDutiesCache() *DutiesCache {
+	return &DutiesCache{
+		duties: make(map[uint64]*EpochDuties),
+	}
+}
+
+// Duties returns the duty table of the given epoch, or nil if it is not cached.
+func (c *DutiesCache) Duties(epoch uint64) *EpochDuties {
+	if c == nil {
+		return nil
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	d, ok := c.duties[epoch]
+	if !ok {
+		dutiesCacheMiss.Inc()
+		return nil
+	}
+	dutiesCacheHit.Inc()
+	return d
+}
+
+// Invalidate removes all the duty tables, which are rebuilt on the next update.
+func (c *DutiesCache) Invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.invalidate()
+}
+
+// invalidateIfStale removes the duty tables if they were computed from a head state of an epoch
+// before the given one, and returns true if the duties have to be computed.
+func (c *DutiesCache) invalidateIfStale(headEpoch uint64) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.valid {
+		return true
+	}
+	if headEpoch <= c.headEpoch {
+		return false
+	}
+	c.invalidate()
+	return true
+}
+
+// invalidate removes all the duty tables. The caller must hold the lock.
+func (c *DutiesCache) invalidate() {
+	c.duties = make(map[uint64]*EpochDuties)
+	c.valid = false
+	c.generation++
+}
+
+// needsUpdate returns true if the duties have to be computed from a head state of the given epoch.
+func (c *DutiesCache) needsUpdate(headEpoch uint64) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return !c.valid || headEpoch > c.headEpoch
+}
+
+// Update computes the duty tables of the current and next epoch of the head state, if the cache
+// does not already hold them.
+func (c *DutiesCache) Update(ctx context.Context, headState *pb.BeaconState) error {
+	headEpoch := helpers.CurrentEpoch(headState)
+	if !c.needsUpdate(headEpoch) {
+		return nil
+	}
+	c.lock.RLock()
+	generation := c.generation
+	c.lock.RUnlock()
+
+	current, err := ComputeDuties(ctx, headState, headEpoch)
+	if err != nil {
+		return err
+	}
+	next, err := ComputeDuties(ctx, headState, headEpoch+1)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if generation != c.generation {
+		return nil
+	}
+	c.duties = map[uint64]*EpochDuties{
+		current.Epoch: current,
+		next.Epoch:    next,
+	}
+	c.headEpoch = headEpoch
+	c.valid = true
+	return nil
+}
+
+// ComputeDuties computes the duty table of the given epoch, advancing a copy of the state with
+// empty slots up to the start of the epoch if needed.
+func ComputeDuties(ctx context.Context, st *pb.BeaconState, epoch uint64) (*EpochDuties, error) {
+	st = proto.Clone(st).(*pb.BeaconState)
+	if epochStartSlot := helpers.StartSlot(epoch); st.Slot < epochStartSlot {
+		var err error
+		st, err = state.ProcessSlots(ctx, st, epochStartSlot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", epochStartSlot)
+		}
+	}
+	committees, proposerSlots, err := helpers.CommitteeAssignments(st, epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute committee assignments of epoch %d", epoch)
+	}
+	return &EpochDuties{
+		Epoch:         epoch,
+		Committees:    committees,
+		ProposerSlots: proposerSlots,
+	}, nil
+}
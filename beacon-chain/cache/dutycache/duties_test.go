@@ -0,0 +1,184 @@
+package dutycache
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestDutiesCache_Update(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	c := NewDutiesCache()
+	if err := c.Update(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	for _, epoch := range []uint64{0, 1} {
+		d := c.Duties(epoch)
+		if d == nil {
+			t.Fatalf("Expected duties of epoch %d to be cached", epoch)
+		}
+		want, err := ComputeDuties(ctx, beaconState, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(d, want) {
+			t.Errorf("Wanted duties %v for epoch %d, received %v", want, epoch, d)
+		}
+	}
+	if beaconState.Slot != 0 {
+		t.Errorf("Expected head state to be left untouched, received slot %d", beaconState.Slot)
+	}
+
+	// Duties are not recomputed within the same epoch.
+	cached := c.Duties(0)
+	beaconState.Slot = 1
+	if err := c.Update(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if c.Duties(0) != cached {
+		t.Error("Expected duties to not be recomputed within the same epoch")
+	}
+
+	nextEpochState := proto.Clone(beaconState).(*pb.BeaconState)
+	nextEpochState.Slot = params.BeaconConfig().SlotsPerEpoch
+	if err := c.Update(ctx, nextEpochState); err != nil {
+		t.Fatal(err)
+	}
+	if c.Duties(0) != nil {
+		t.Error("Expected duties of previous epoch to be pruned")
+	}
+	if c.Duties(1) == nil || c.Duties(2) == nil {
+		t.Error("Expected duties of current and next epoch to be cached")
+	}
+
+	c.Invalidate()
+	if c.Duties(1) != nil {
+		t.Error("Expected duties to be removed")
+	}
+}
+
+func TestDutiesCache_Nil(t *testing.T) {
+	var c *DutiesCache
+	if c.Duties(0) != nil {
+		t.Error("Expected nil cache to not contain duties")
+	}
+}
+
+func TestDutiesCache_HandleEvent(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	tests := []struct {
+		name           string
+		event          *feed.Event
+		wantUpdate     bool
+		wantInvalidate bool
+	}{
+		{
+			name:       "initialized",
+			event:      &feed.Event{Type: statefeed.Initialized, Data: &statefeed.InitializedData{}},
+			wantUpdate: true,
+		},
+		{
+			name:  "head in same epoch",
+			event: &feed.Event{Type: statefeed.HeadChanged, Data: &statefeed.HeadChangedData{Slot: 2}},
+		},
+		{
+			name:           "head in next epoch",
+			event:          &feed.Event{Type: statefeed.HeadChanged, Data: &statefeed.HeadChangedData{Slot: slotsPerEpoch}},
+			wantUpdate:     true,
+			wantInvalidate: true,
+		},
+		{
+			name: "reorg within epoch",
+			event: &feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{OldSlot: 3, NewSlot: 4, CommonAncestorSlot: 1},
+			},
+			wantUpdate:     true,
+			wantInvalidate: true,
+		},
+		{
+			name: "reorg crossing epoch boundary",
+			event: &feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{OldSlot: slotsPerEpoch + 1, NewSlot: slotsPerEpoch + 2, CommonAncestorSlot: slotsPerEpoch - 1},
+			},
+			wantUpdate:     true,
+			wantInvalidate: true,
+		},
+		{
+			name: "reorg to previous epoch",
+			event: &feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{OldSlot: slotsPerEpoch, NewSlot: 3, CommonAncestorSlot: 2},
+			},
+			wantUpdate:     true,
+			wantInvalidate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewDutiesCache()
+			if err := c.Update(context.Background(), beaconState); err != nil {
+				t.Fatal(err)
+			}
+			if got := c.handleEvent(tt.event); got != tt.wantUpdate {
+				t.Errorf("Wanted update %v, received %v", tt.wantUpdate, got)
+			}
+			if invalidated := c.Duties(0) == nil; invalidated != tt.wantInvalidate {
+				t.Errorf("Wanted invalidated %v, received %v", tt.wantInvalidate, invalidated)
+			}
+		})
+	}
+}
+
+func TestDutiesCache_PredictedDutiesNotServedAfterEpochChange(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	c := NewDutiesCache()
+	if err := c.Update(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if c.Duties(1) == nil {
+		t.Fatal("Expected duties of next epoch to be cached")
+	}
+
+	nextEpochSlot := params.BeaconConfig().SlotsPerEpoch
+	c.handleEvent(&feed.Event{Type: statefeed.HeadChanged, Data: &statefeed.HeadChangedData{Slot: nextEpochSlot}})
+	if c.Duties(1) != nil {
+		t.Error("Expected duties predicted from the previous epoch to not be served")
+	}
+
+	nextEpochState := proto.Clone(beaconState).(*pb.BeaconState)
+	nextEpochState.Slot = nextEpochSlot
+	if err := c.Update(ctx, nextEpochState); err != nil {
+		t.Fatal(err)
+	}
+	if c.Duties(1) == nil {
+		t.Error("Expected duties to be computed from the new head state")
+	}
+}
+
+func TestComputeDuties_MatchesCommitteeAssignments(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	d, err := ComputeDuties(context.Background(), beaconState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committees, proposerSlots, err := helpers.CommitteeAssignments(proto.Clone(beaconState).(*pb.BeaconState), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(d.Committees, committees) || !reflect.DeepEqual(d.ProposerSlots, proposerSlots) {
+		t.Error("Expected duties to match committee assignments")
+	}
+}
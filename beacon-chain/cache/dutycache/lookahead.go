@@ -0,0 +1,93 @@
+package dutycache
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "dutycache")
+
+// HeadStateFetcher retrieves the head state of the chain.
+type HeadStateFetcher interface {
+	HeadState(ctx context.Context) (*pb.BeaconState, error)
+}
+
+// Run keeps the cache up to date with the head of the chain until the context is canceled. The
+// duties are computed when the head moves to a new epoch, and recomputed after any reorg. Stale
+// duties are removed as soon as the event is received, while new duties are computed in the
+// background so that the state feed is never blocked.
+func (c *DutiesCache) Run(ctx context.Context, headFetcher HeadStateFetcher, stateNotifier statefeed.Notifier) {
+	update := make(chan struct{}, 1)
+	update <- struct{}{}
+	go func() {
+		for {
+			select {
+			case <-update:
+				c.updateFromHead(ctx, headFetcher)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if !c.handleEvent(event) {
+				continue
+			}
+			// An update already pending will pick up the latest head state.
+			select {
+			case update <- struct{}{}:
+			default:
+			}
+		case <-stateSub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// handleEvent invalidates the cache if needed and returns true if the duties should be computed
+// after the given state feed event.
+func (c *DutiesCache) handleEvent(event *feed.Event) bool {
+	switch data := event.Data.(type) {
+	case *statefeed.InitializedData:
+		return true
+	case *statefeed.ReorgData:
+		// Even a reorg within the epoch of the head changes the duties of the next epoch, as its
+		// proposers depend on the effective balances at the end of the current epoch.
+		c.Invalidate()
+		return true
+	case *statefeed.HeadChangedData:
+		// The duties of the new epoch were predicted from a head state of the previous epoch, so
+		// they must not be served until they are computed from the new head state.
+		return c.invalidateIfStale(helpers.SlotToEpoch(data.Slot))
+	}
+	return false
+}
+
+func (c *DutiesCache) updateFromHead(ctx context.Context, headFetcher HeadStateFetcher) {
+	headState, err := headFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state to compute duties")
+		return
+	}
+	if headState == nil {
+		return
+	}
+	if err := c.Update(ctx, headState); err != nil {
+		log.WithError(err).Error("Could not compute duties")
+		return
+	}
+	log.WithField("epoch", helpers.CurrentEpoch(headState)).Debug("Computed duties of current and next epoch")
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
-	"reflect"
 	"sort"
 
 	"github.com/gogo/protobuf/proto"
@@ -715,57 +714,11 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *pb.BeaconState,
 	ctx, span := trace.StartSpan(ctx, "core.VerifyIndexedAttestation")
 	defer span.End()
 
-	indices := indexedAtt.AttestingIndices
-
-	if uint64(len(indices)) > params.BeaconConfig().MaxValidatorsPerCommittee {
-		return fmt.Errorf("validator indices count exceeds MAX_VALIDATORS_PER_COMMITTEE, %d > %d", len(indices), params.BeaconConfig().MaxValidatorsPerCommittee)
-	}
-
-	set := make(map[uint64]bool)
-	setIndices := make([]uint64, 0, len(indices))
-	for _, i := range indices {
-		if ok := set[i]; ok {
-			continue
-		}
-		setIndices = append(setIndices, i)
-		set[i] = true
-	}
-	sort.SliceStable(setIndices, func(i, j int) bool {
-		return setIndices[i] < setIndices[j]
-	})
-	if !reflect.DeepEqual(setIndices, indices) {
-		return errors.New("attesting indices is not uniquely sorted")
-	}
-
-	domain := helpers.Domain(beaconState.Fork, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
-	var pubkey *bls.PublicKey
-	var err error
-	if len(indices) > 0 {
-		pubkey, err = bls.PublicKeyFromBytes(beaconState.Validators[indices[0]].PublicKey)
-		if err != nil {
-			return errors.Wrap(err, "could not deserialize validator public key")
-		}
-		for _, i := range indices[1:] {
-			pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
-			if err != nil {
-				return errors.Wrap(err, "could not deserialize validator public key")
-			}
-			pubkey.Aggregate(pk)
-		}
-	}
-
-	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
+	set, err := IndexedAttestationSignatureSet(ctx, beaconState, indexedAtt)
 	if err != nil {
-		return errors.Wrap(err, "could not tree hash att data")
-	}
-
-	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
+		return err
 	}
-
-	voted := len(indices) > 0
-	if voted && !sig.Verify(messageHash[:], pubkey, domain) {
+	if !set.Verify() {
 		return ErrSigFailedToVerify
 	}
 	return nil
@@ -881,9 +834,7 @@ func ProcessDeposit(beaconState *pb.BeaconState, deposit *ethpb.Deposit, valInde
 	amount := deposit.Data.Amount
 	index, ok := valIndexMap[bytesutil.ToBytes48(pubKey)]
 	if !ok {
-		domain := bls.ComputeDomain(params.BeaconConfig().DomainDeposit)
-		depositSig := deposit.Data.Signature
-		if err := verifyDepositDataSigningRoot(deposit.Data, pubKey, depositSig, domain); err != nil {
+		if err := VerifyDepositSignature(deposit.Data); err != nil {
 			// Ignore this error as in the spec pseudo code.
 			log.Errorf("Skipping deposit: could not verify deposit data signature: %v", err)
 			return beaconState, nil
@@ -911,6 +862,13 @@ func ProcessDeposit(beaconState *pb.BeaconState, deposit *ethpb.Deposit, valInde
 	return beaconState, nil
 }
 
+// VerifyDepositSignature verifies the proof of possession of the deposit data, which deposits
+// creating a new validator must carry. The deposit contract does not check signatures.
+func VerifyDepositSignature(data *ethpb.Deposit_Data) error {
+	domain := bls.ComputeDomain(params.BeaconConfig().DomainDeposit)
+	return verifyDepositDataSigningRoot(data, data.PublicKey, data.Signature, domain)
+}
+
 func verifyDeposit(beaconState *pb.BeaconState, deposit *ethpb.Deposit) error {
 	// Verify Merkle proof of deposit and deposit trie root.
 	receiptRoot := beaconState.Eth1Data.DepositRoot
@@ -992,6 +950,31 @@ func ProcessVoluntaryExitsNoVerify(
 	return beaconState, nil
 }
 
+// ProcessVoluntaryExitsNoVerifySignature processes all the voluntary exits in a block body, and
+// validates them without verifying their BLS signatures. It is used when the signatures are
+// verified separately, see VoluntaryExitSignatureSet.
+func ProcessVoluntaryExitsNoVerifySignature(beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	var err error
+	exits := body.VoluntaryExits
+
+	for idx, exit := range exits {
+		if exit == nil || exit.Exit == nil {
+			return nil, fmt.Errorf("nil exit at index %d", idx)
+		}
+		if int(exit.Exit.ValidatorIndex) >= len(beaconState.Validators) {
+			return nil, fmt.Errorf("validator index out of bound %d > %d", exit.Exit.ValidatorIndex, len(beaconState.Validators))
+		}
+		if err := verifyExitConditions(beaconState.Validators[exit.Exit.ValidatorIndex], beaconState.Slot, exit); err != nil {
+			return nil, errors.Wrapf(err, "could not verify exit %d", idx)
+		}
+		beaconState, err = v.InitiateValidatorExit(beaconState, exit.Exit.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return beaconState, nil
+}
+
 // VerifyExit implements the spec defined validation for voluntary exits.
 //
 // Spec pseudocode definition:
@@ -1012,6 +995,19 @@ func ProcessVoluntaryExitsNoVerify(
 //    domain = get_domain(state, DOMAIN_VOLUNTARY_EXIT, exit.epoch)
 //    assert bls_verify(validator.pubkey, signing_root(exit), exit.signature, domain)
 func VerifyExit(validator *ethpb.Validator, currentSlot uint64, fork *pb.Fork, signed *ethpb.SignedVoluntaryExit) error {
+	if err := verifyExitConditions(validator, currentSlot, signed); err != nil {
+		return err
+	}
+	domain := helpers.Domain(fork, signed.Exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+	if err := verifySigningRoot(signed.Exit, validator.PublicKey, signed.Signature, domain); err != nil {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// verifyExitConditions implements the spec defined validation for voluntary exits, except for the
+// signature verification.
+func verifyExitConditions(validator *ethpb.Validator, currentSlot uint64, signed *ethpb.SignedVoluntaryExit) error {
 	if signed == nil || signed.Exit == nil {
 		return errors.New("nil exit")
 	}
@@ -1039,10 +1035,6 @@ func VerifyExit(validator *ethpb.Validator, currentSlot uint64, fork *pb.Fork, s
 			validator.ActivationEpoch+params.BeaconConfig().PersistentCommitteePeriod,
 		)
 	}
-	domain := helpers.Domain(fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
-	if err := verifySigningRoot(exit, validator.PublicKey, signed.Signature, domain); err != nil {
-		return ErrSigFailedToVerify
-	}
 	return nil
 }
 
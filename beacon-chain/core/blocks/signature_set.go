@@ -0,0 +1,167 @@
+package blocks
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// signatureSet returns a set with a single signature of a message root.
+func signatureSet(root [32]byte, pub []byte, signature []byte, domain uint64) (*bls.SignatureSet, error) {
+	publicKey, err := bls.PublicKeyFromBytes(pub)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to public key")
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+	return bls.NewSet().Add(sig, publicKey, root, domain), nil
+}
+
+// BlockSignatureSet retrieves the proposer signature of a block, so that it can be verified
+// together with other signatures. The state is expected to be at the slot of the block.
+func BlockSignatureSet(beaconState *pb.BeaconState, block *ethpb.SignedBeaconBlock) (*bls.SignatureSet, error) {
+	if block == nil || block.Block == nil {
+		return nil, errors.New("nil block")
+	}
+	idx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, err
+	}
+	proposer := beaconState.Validators[idx]
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainBeaconProposer)
+	root, err := ssz.HashTreeRoot(block.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	return signatureSet(root, proposer.PublicKey, block.Signature, domain)
+}
+
+// RandaoSignatureSet retrieves the randao reveal of a block body, so that it can be verified
+// together with other signatures. The state is expected to be at the slot of the block.
+func RandaoSignatureSet(beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*bls.SignatureSet, error) {
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon proposer index")
+	}
+	proposerPub := beaconState.Validators[proposerIdx].PublicKey
+
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, currentEpoch)
+
+	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
+	return signatureSet(bytesutil.ToBytes32(buf), proposerPub, body.RandaoReveal, domain)
+}
+
+// AttestationSignatureSet converts an attestation into an indexed attestation, and retrieves its
+// aggregate signature, so that it can be verified together with other signatures.
+func AttestationSignatureSet(ctx context.Context, beaconState *pb.BeaconState, att *ethpb.Attestation) (*bls.SignatureSet, error) {
+	if att == nil || att.Data == nil || att.Data.Target == nil {
+		return nil, errors.New("nil attestation data target")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indexedAtt, err := ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert to indexed attestation")
+	}
+	return IndexedAttestationSignatureSet(ctx, beaconState, indexedAtt)
+}
+
+// IndexedAttestationSignatureSet validates the attesting indices of an indexed attestation, and
+// retrieves its aggregate signature, so that it can be verified together with other signatures.
+// The set is empty if there are no attesting indices.
+func IndexedAttestationSignatureSet(ctx context.Context, beaconState *pb.BeaconState, indexedAtt *ethpb.IndexedAttestation) (*bls.SignatureSet, error) {
+	ctx, span := trace.StartSpan(ctx, "core.IndexedAttestationSignatureSet")
+	defer span.End()
+
+	indices := indexedAtt.AttestingIndices
+
+	if uint64(len(indices)) > params.BeaconConfig().MaxValidatorsPerCommittee {
+		return nil, fmt.Errorf("validator indices count exceeds MAX_VALIDATORS_PER_COMMITTEE, %d > %d", len(indices), params.BeaconConfig().MaxValidatorsPerCommittee)
+	}
+
+	set := make(map[uint64]bool)
+	setIndices := make([]uint64, 0, len(indices))
+	for _, i := range indices {
+		if ok := set[i]; ok {
+			continue
+		}
+		setIndices = append(setIndices, i)
+		set[i] = true
+	}
+	sort.SliceStable(setIndices, func(i, j int) bool {
+		return setIndices[i] < setIndices[j]
+	})
+	if !reflect.DeepEqual(setIndices, indices) {
+		return nil, errors.New("attesting indices is not uniquely sorted")
+	}
+
+	domain := helpers.Domain(beaconState.Fork, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	var pubkey *bls.PublicKey
+	var err error
+	if len(indices) > 0 {
+		pubkey, err = bls.PublicKeyFromBytes(beaconState.Validators[indices[0]].PublicKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
+		}
+		for _, i := range indices[1:] {
+			pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not deserialize validator public key")
+			}
+			pubkey.Aggregate(pk)
+		}
+	}
+
+	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not tree hash att data")
+	}
+
+	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+
+	sigSet := bls.NewSet()
+	if len(indices) > 0 {
+		sigSet.Add(sig, pubkey, messageHash, domain)
+	}
+	return sigSet, nil
+}
+
+// VoluntaryExitSignatureSet retrieves the signature of a voluntary exit, so that it can be
+// verified together with other signatures.
+func VoluntaryExitSignatureSet(beaconState *pb.BeaconState, signed *ethpb.SignedVoluntaryExit) (*bls.SignatureSet, error) {
+	if signed == nil || signed.Exit == nil {
+		return nil, errors.New("nil exit")
+	}
+	exit := signed.Exit
+	if int(exit.ValidatorIndex) >= len(beaconState.Validators) {
+		return nil, fmt.Errorf("validator index out of bound %d > %d", exit.ValidatorIndex, len(beaconState.Validators))
+	}
+	domain := helpers.Domain(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+	root, err := ssz.HashTreeRoot(exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	return signatureSet(root, beaconState.Validators[exit.ValidatorIndex].PublicKey, signed.Signature, domain)
+}
@@ -36,6 +36,36 @@ func ProcessRewardsAndPenaltiesPrecompute(state *pb.BeaconState, bp *Balance, vp
 	return state, nil
 }
 
+// ValidatorRewards returns the breakdown of the rewards and penalties applied to each validator
+// when processing the epoch of the given state. The state must have its justification and
+// finalization processed, as done before processing rewards and penalties.
+func ValidatorRewards(state *pb.BeaconState, bp *Balance, vp []*Validator) ([]*Rewards, error) {
+	if len(vp) != len(state.Validators) {
+		return nil, errors.New("precomputed registries not the same length as state registries")
+	}
+	rewards := make([]*Rewards, len(vp))
+	if helpers.CurrentEpoch(state) == 0 {
+		for i := range rewards {
+			rewards[i] = &Rewards{}
+		}
+	} else {
+		for i, v := range vp {
+			rewards[i] = attestationRewards(state, bp, v)
+		}
+		proposerRewards, err := proposerDeltaPrecompute(state, bp, vp)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get proposer delta")
+		}
+		for i, r := range proposerRewards {
+			rewards[i].ProposerReward = r
+		}
+	}
+	for i, p := range slashingPenalties(state, bp) {
+		rewards[i].SlashingPenalty = p
+	}
+	return rewards, nil
+}
+
 // This computes the rewards and penalties differences for individual validators based on the
 // voting records.
 func attestationDeltas(state *pb.BeaconState, bp *Balance, vp []*Validator) ([]uint64, []uint64, error) {
@@ -49,49 +79,56 @@ func attestationDeltas(state *pb.BeaconState, bp *Balance, vp []*Validator) ([]u
 }
 
 func attestationDelta(state *pb.BeaconState, bp *Balance, v *Validator) (uint64, uint64) {
+	r := attestationRewards(state, bp, v)
+	return r.Reward(), r.Penalty()
+}
+
+// This computes the breakdown of the rewards and penalties of an individual validator based on
+// its voting record.
+func attestationRewards(state *pb.BeaconState, bp *Balance, v *Validator) *Rewards {
+	r := &Rewards{}
 	eligible := v.IsActivePrevEpoch || (v.IsSlashed && !v.IsWithdrawableCurrentEpoch)
 	if !eligible {
-		return 0, 0
+		return r
 	}
 
 	e := helpers.PrevEpoch(state)
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(bp.CurrentEpoch) / params.BeaconConfig().BaseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
-		r += br * bp.PrevEpochAttesters / bp.CurrentEpoch
+		r.SourceReward = br * bp.PrevEpochAttesters / bp.CurrentEpoch
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAtteserReward := br - proposerReward
-		r += maxAtteserReward / v.InclusionDistance
+		r.InclusionDelayReward = maxAtteserReward / v.InclusionDistance
 	} else {
-		p += br
+		r.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
 	if v.IsPrevEpochTargetAttester && !v.IsSlashed {
-		r += br * bp.PrevEpochTargetAttesters / bp.CurrentEpoch
+		r.TargetReward = br * bp.PrevEpochTargetAttesters / bp.CurrentEpoch
 	} else {
-		p += br
+		r.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
 	if v.IsPrevEpochHeadAttester && !v.IsSlashed {
-		r += br * bp.PrevEpochHeadAttesters / bp.CurrentEpoch
+		r.HeadReward = br * bp.PrevEpochHeadAttesters / bp.CurrentEpoch
 	} else {
-		p += br
+		r.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
 	finalityDelay := e - state.FinalizedCheckpoint.Epoch
 	if finalityDelay > params.BeaconConfig().MinEpochsToInactivityPenalty {
-		p += params.BeaconConfig().BaseRewardsPerEpoch * br
+		r.InactivityPenalty = params.BeaconConfig().BaseRewardsPerEpoch * br
 		if !v.IsPrevEpochTargetAttester {
-			p += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
+			r.InactivityPenalty += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return r
 }
 
 // This computes the rewards and penalties differences for individual validators based on the
@@ -130,6 +130,58 @@ func TestAttestationDeltaPrecompute(t *testing.T) {
 	}
 }
 
+func TestValidatorRewards_MatchesBalanceChanges(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	state := buildState(e+3, validatorCount)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  1,
+		}
+	}
+	state.PreviousEpochAttestations = atts
+
+	vp, bp := New(context.Background(), state)
+	vp, bp, err := ProcessAttestations(context.Background(), state, vp, bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewards, err := ValidatorRewards(state, bp, vp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rewards) != len(state.Validators) {
+		t.Fatalf("Wanted %d rewards, got %d", len(state.Validators), len(rewards))
+	}
+
+	preBalances := make([]uint64, len(state.Balances))
+	copy(preBalances, state.Balances)
+	state, err = ProcessRewardsAndPenaltiesPrecompute(state, bp, vp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range rewards {
+		if wanted := preBalances[i] + r.Reward() - r.Penalty(); state.Balances[i] != wanted {
+			t.Fatalf("Validator %d: wanted balance %d, got %d", i, wanted, state.Balances[i])
+		}
+	}
+
+	// Indices that voted everything except for head.
+	if r := rewards[4]; r.SourceReward == 0 || r.TargetReward == 0 || r.InclusionDelayReward == 0 || r.HeadPenalty == 0 {
+		t.Errorf("Unexpected rewards for attester: %+v", r)
+	}
+	// Indices that did not vote.
+	if r := rewards[0]; r.Reward() != 0 || r.SourcePenalty == 0 || r.TargetPenalty == 0 || r.HeadPenalty == 0 {
+		t.Errorf("Unexpected rewards for non attester: %+v", r)
+	}
+}
+
 func buildState(slot uint64, validatorCount uint64) *pb.BeaconState {
 	validators := make([]*ethpb.Validator, validatorCount)
 	for i := 0; i < len(validators); i++ {
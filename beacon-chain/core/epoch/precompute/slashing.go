@@ -10,8 +10,19 @@ import (
 // ProcessSlashingsPrecompute processes the slashed validators during epoch processing.
 // This is an optimized version by passing in precomputed total epoch balances.
 func ProcessSlashingsPrecompute(state *pb.BeaconState, p *Balance) *pb.BeaconState {
+	for index, penalty := range slashingPenalties(state, p) {
+		if penalty > 0 {
+			state = helpers.DecreaseBalance(state, uint64(index), penalty)
+		}
+	}
+	return state
+}
+
+// This computes the slashing penalties of the slashed validators for the current epoch.
+func slashingPenalties(state *pb.BeaconState, p *Balance) []uint64 {
 	currentEpoch := helpers.CurrentEpoch(state)
 	exitLength := params.BeaconConfig().EpochsPerSlashingsVector
+	penalties := make([]uint64, len(state.Validators))
 
 	// Compute the sum of state slashings
 	totalSlashing := uint64(0)
@@ -26,9 +37,8 @@ func ProcessSlashingsPrecompute(state *pb.BeaconState, p *Balance) *pb.BeaconSta
 			minSlashing := mathutil.Min(totalSlashing*3, p.CurrentEpoch)
 			increment := params.BeaconConfig().EffectiveBalanceIncrement
 			penaltyNumerator := validator.EffectiveBalance / increment * minSlashing
-			penalty := penaltyNumerator / p.CurrentEpoch * increment
-			state = helpers.DecreaseBalance(state, uint64(index), penalty)
+			penalties[index] = penaltyNumerator / p.CurrentEpoch * increment
 		}
 	}
-	return state
+	return penalties
 }
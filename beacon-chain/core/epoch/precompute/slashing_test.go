@@ -107,6 +107,13 @@ func TestProcessSlashingsPrecompute_SlashedLess(t *testing.T) {
 			bp := &precompute.Balance{CurrentEpoch: ab}
 
 			original := proto.Clone(tt.state)
+			rewards, err := precompute.ValidatorRewards(tt.state, bp, make([]*precompute.Validator, len(tt.state.Validators)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if penalty := tt.state.Balances[0] - tt.want; rewards[0].SlashingPenalty != penalty {
+				t.Errorf("Wanted slashing penalty %d, got %d", penalty, rewards[0].SlashingPenalty)
+			}
 			newState := precompute.ProcessSlashingsPrecompute(tt.state, bp)
 
 			if newState.Balances[0] != tt.want {
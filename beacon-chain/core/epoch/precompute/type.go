@@ -54,3 +54,40 @@ type Balance struct {
 	// correctly for head block during prev epoch.
 	PrevEpochHeadAttesters uint64
 }
+
+// Rewards stores the breakdown of the rewards and penalties applied to a validator balance during
+// the processing of an epoch, in Gwei. Attestation rewards and penalties are for the votes of the
+// previous epoch.
+type Rewards struct {
+	// SourceReward is the reward for voting the correct source checkpoint.
+	SourceReward uint64
+	// SourcePenalty is the penalty for not voting the correct source checkpoint.
+	SourcePenalty uint64
+	// TargetReward is the reward for voting the correct target checkpoint.
+	TargetReward uint64
+	// TargetPenalty is the penalty for not voting the correct target checkpoint.
+	TargetPenalty uint64
+	// HeadReward is the reward for voting the correct head block.
+	HeadReward uint64
+	// HeadPenalty is the penalty for not voting the correct head block.
+	HeadPenalty uint64
+	// InclusionDelayReward is the reward for the attestation being included quickly in the chain.
+	InclusionDelayReward uint64
+	// ProposerReward is the reward for including attestations in proposed blocks.
+	ProposerReward uint64
+	// InactivityPenalty is the penalty applied while the chain is not finalizing.
+	InactivityPenalty uint64
+	// SlashingPenalty is the penalty applied halfway through the withdrawability delay of a slashed
+	// validator.
+	SlashingPenalty uint64
+}
+
+// Reward is the sum of all the rewards.
+func (r *Rewards) Reward() uint64 {
+	return r.SourceReward + r.TargetReward + r.HeadReward + r.InclusionDelayReward + r.ProposerReward
+}
+
+// Penalty is the sum of all the penalties.
+func (r *Rewards) Penalty() uint64 {
+	return r.SourcePenalty + r.TargetPenalty + r.HeadPenalty + r.InactivityPenalty + r.SlashingPenalty
+}
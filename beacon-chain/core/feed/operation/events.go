@@ -15,6 +15,22 @@ const (
 
 	// ExitReceived is sent after an voluntary exit object has been received from the outside world (eg in RPC or sync)
 	ExitReceived
+
+	// ProposerSlashingReceived is sent after a proposer slashing object has been received from the
+	// outside world. (eg. in sync)
+	ProposerSlashingReceived
+
+	// AttesterSlashingReceived is sent after an attester slashing object has been received from the
+	// outside world. (eg. in sync)
+	AttesterSlashingReceived
+
+	// DepositProcessed is sent after a deposit log from the deposit contract has been processed by
+	// the ETH1.0 chain service.
+	DepositProcessed
+
+	// BlockOperationsIncluded is sent after a block has been received and processed, with the
+	// operations it included.
+	BlockOperationsIncluded
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -34,3 +50,45 @@ type ExitReceivedData struct {
 	// Exit is the voluntary exit object.
 	Exit *ethpb.SignedVoluntaryExit
 }
+
+// ProposerSlashingReceivedData is the data sent with ProposerSlashingReceived events.
+type ProposerSlashingReceivedData struct {
+	// ProposerSlashing is the proposer slashing object.
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// AttesterSlashingReceivedData is the data sent with AttesterSlashingReceived events.
+type AttesterSlashingReceivedData struct {
+	// AttesterSlashing is the attester slashing object.
+	AttesterSlashing *ethpb.AttesterSlashing
+}
+
+// DepositProcessedData is the data sent with DepositProcessed events.
+type DepositProcessedData struct {
+	// Deposit is the processed deposit object.
+	Deposit *ethpb.Deposit
+	// MerkleTreeIndex is the index of the deposit in the deposit contract's merkle tree.
+	MerkleTreeIndex uint64
+	// Eth1BlockNumber is the number of the ETH1.0 block which included the deposit log.
+	Eth1BlockNumber uint64
+	// Valid is false if the deposit was rejected while building the genesis state.
+	Valid bool
+}
+
+// BlockOperationsIncludedData is the data sent with BlockOperationsIncluded events.
+type BlockOperationsIncludedData struct {
+	// Slot of the block.
+	Slot uint64
+	// BlockRoot is the root of the block.
+	BlockRoot [32]byte
+	// Attestations included in the block.
+	Attestations []*ethpb.Attestation
+	// Deposits included in the block.
+	Deposits []*ethpb.Deposit
+	// VoluntaryExits included in the block.
+	VoluntaryExits []*ethpb.SignedVoluntaryExit
+	// ProposerSlashings included in the block.
+	ProposerSlashings []*ethpb.ProposerSlashing
+	// AttesterSlashings included in the block.
+	AttesterSlashings []*ethpb.AttesterSlashing
+}
@@ -9,6 +9,13 @@ const (
 	ChainStarted
 	// Initialized is sent when the internal beacon node's state is ready to be accessed.
 	Initialized
+	// HeadChanged is sent when the head block of the chain changes.
+	HeadChanged
+	// Reorg is sent when the new head block of the chain does not descend from the previous
+	// head block. It is sent before the corresponding HeadChanged event.
+	Reorg
+	// FinalizedCheckpoint is sent when the finalized checkpoint of the chain advances.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -30,3 +37,42 @@ type InitializedData struct {
 	// StartTime is the time at which the chain started.
 	StartTime time.Time
 }
+
+// HeadChangedData is the data sent with HeadChanged events.
+type HeadChangedData struct {
+	// Slot of the new head block.
+	Slot uint64
+	// BlockRoot is the root of the new head block.
+	BlockRoot [32]byte
+	// PreviousSlot is the slot of the previous head block.
+	PreviousSlot uint64
+	// PreviousBlockRoot is the root of the previous head block.
+	PreviousBlockRoot [32]byte
+}
+
+// ReorgData is the data sent with Reorg events.
+type ReorgData struct {
+	// OldSlot is the slot of the head block before the reorg.
+	OldSlot uint64
+	// OldBlockRoot is the root of the head block before the reorg.
+	OldBlockRoot [32]byte
+	// NewSlot is the slot of the head block after the reorg.
+	NewSlot uint64
+	// NewBlockRoot is the root of the head block after the reorg.
+	NewBlockRoot [32]byte
+	// CommonAncestorSlot is the slot of the latest block shared by the old and new chains.
+	CommonAncestorSlot uint64
+	// CommonAncestorRoot is the root of the latest block shared by the old and new chains.
+	CommonAncestorRoot [32]byte
+	// Depth is the number of slots of the old chain which were rolled back, from the common
+	// ancestor to the old head.
+	Depth uint64
+}
+
+// FinalizedCheckpointData is the data sent with FinalizedCheckpoint events.
+type FinalizedCheckpointData struct {
+	// Epoch of the new finalized checkpoint.
+	Epoch uint64
+	// BlockRoot is the root of the new finalized checkpoint block.
+	BlockRoot [32]byte
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/stateutil"
@@ -129,6 +130,55 @@ func ExecuteStateTransitionNoVerifyAttSigs(
 	return state, nil
 }
 
+// ExecuteStateTransitionBatchVerify defines the procedure for a state transition function.
+// Unlike ExecuteStateTransition, the proposer signature, the randao reveal, and the signatures of
+// attestations and voluntary exits in a block are not verified one by one. Instead, they are
+// collected and verified in a single batch, once the rest of the block has been processed. This
+// is used in initial sync, where blocks are processed back to back and BLS verification
+// dominates the processing time.
+//
+// WARNING: This method modifies the passed in state.
+//
+// Spec pseudocode definition:
+//  def state_transition(state: BeaconState, block: BeaconBlock, validate_state_root: bool=False) -> BeaconState:
+//    # Process slots (including those with no blocks) since block
+//    process_slots(state, block.slot)
+//    # Process block
+//    process_block(state, block)
+//    # Return post-state
+//    return state
+func ExecuteStateTransitionBatchVerify(
+	ctx context.Context,
+	state *pb.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*pb.BeaconState, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if signed == nil || signed.Block == nil {
+		return nil, errors.New("nil block")
+	}
+
+	b.ClearEth1DataVoteCache()
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.ExecuteStateTransitionBatchVerify")
+	defer span.End()
+	var err error
+
+	// Execute per slots transition.
+	state, err = ProcessSlots(ctx, state, signed.Block.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slot")
+	}
+
+	// Execute per block transition.
+	state, err = processBlockBatchVerify(ctx, state, signed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
+	}
+
+	return state, nil
+}
+
 // CalculateStateRoot defines the procedure for a state transition function.
 // This does not validate any BLS signatures in a block, it is used for calculating the
 // state root of the state for the block proposer to use.
@@ -398,6 +448,117 @@ func processBlockNoVerifyAttSigs(
 	return state, nil
 }
 
+// processBlockBatchVerify creates a new, modified beacon state by applying block operation
+// transformations as defined in the Ethereum Serenity specification. The signatures of the
+// block, except for the ones of slashings, are verified in a single batch.
+//
+// Spec pseudocode definition:
+//
+//  def process_block(state: BeaconState, block: BeaconBlock) -> None:
+//    process_block_header(state, block)
+//    process_randao(state, block.body)
+//    process_eth1_data(state, block.body)
+//    process_operations(state, block.body)
+func processBlockBatchVerify(
+	ctx context.Context,
+	state *pb.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlock")
+	defer span.End()
+
+	// Signature sets are retrieved from the pre-block state. Processing the block does not alter
+	// validator keys, committees or the fork, so they stay valid for the post-block state.
+	sets, descriptions, err := blockSignatureSets(ctx, state, signed)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not retrieve block signatures")
+	}
+
+	state, err = b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block header")
+	}
+
+	state, err = b.ProcessRandaoNoVerify(state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process randao")
+	}
+
+	state, err = b.ProcessEth1DataInBlock(state, signed.Block)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process eth1 data")
+	}
+
+	state, err = processOperationsBatchVerify(ctx, state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block operation")
+	}
+
+	for i, valid := range bls.VerifyBatch(sets) {
+		if !valid {
+			err := errors.Wrapf(b.ErrSigFailedToVerify, "could not verify %s", descriptions[i])
+			traceutil.AnnotateError(span, err)
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+// blockSignatureSets retrieves the signatures of a block, which are verified in a batch by
+// processBlockBatchVerify, along with a description of each set for error reporting.
+func blockSignatureSets(
+	ctx context.Context,
+	state *pb.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) ([]*bls.SignatureSet, []string, error) {
+	body := signed.Block.Body
+	if body == nil {
+		return nil, nil, errors.New("nil block body")
+	}
+	sets := make([]*bls.SignatureSet, 0, 2+len(body.Attestations)+len(body.VoluntaryExits))
+	descriptions := make([]string, 0, cap(sets))
+
+	set, err := b.BlockSignatureSet(state, signed)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not retrieve block signature")
+	}
+	sets = append(sets, set)
+	descriptions = append(descriptions, "block signature")
+
+	set, err = b.RandaoSignatureSet(state, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not retrieve block randao")
+	}
+	sets = append(sets, set)
+	descriptions = append(descriptions, "block randao")
+
+	for idx, att := range body.Attestations {
+		set, err := b.AttestationSignatureSet(ctx, state, att)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not retrieve signature of attestation at index %d in block", idx)
+		}
+		sets = append(sets, set)
+		descriptions = append(descriptions, fmt.Sprintf("attestation at index %d in block", idx))
+	}
+
+	for idx, exit := range body.VoluntaryExits {
+		set, err := b.VoluntaryExitSignatureSet(state, exit)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not retrieve signature of exit %d", idx)
+		}
+		sets = append(sets, set)
+		descriptions = append(descriptions, fmt.Sprintf("exit %d", idx))
+	}
+
+	return sets, descriptions, nil
+}
+
 // ProcessOperations processes the operations in the beacon block and updates beacon state
 // with the operations in block.
 //
@@ -515,6 +676,61 @@ func processOperationsNoVerify(
 	return state, nil
 }
 
+// processOperationsBatchVerify processes the operations in the beacon block and updates beacon
+// state with the operations in block. Slashings are fully verified, while the signatures of
+// attestations and voluntary exits are left to be verified in a batch by the caller.
+//
+// Spec pseudocode definition:
+//
+//  def process_operations(state: BeaconState, body: BeaconBlockBody) -> None:
+//    # Verify that outstanding deposits are processed up to the maximum number of deposits
+//    assert len(body.deposits) == min(MAX_DEPOSITS, state.eth1_data.deposit_count - state.eth1_deposit_index)
+//
+//    all_operations = (
+//        (body.proposer_slashings, process_proposer_slashing),
+//        (body.attester_slashings, process_attester_slashing),
+//        (body.attestations, process_attestation),
+//        (body.deposits, process_deposit),
+//        (body.voluntary_exits, process_voluntary_exit),
+//    )  # type: Sequence[Tuple[List, Callable]]
+//    for operations, function in all_operations:
+//        for operation in operations:
+//            function(state, operation)
+func processOperationsBatchVerify(
+	ctx context.Context,
+	state *pb.BeaconState,
+	body *ethpb.BeaconBlockBody) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessOperations")
+	defer span.End()
+
+	if err := verifyOperationLengths(state, body); err != nil {
+		return nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	state, err := b.ProcessProposerSlashings(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	state, err = b.ProcessAttesterSlashings(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	state, err = b.ProcessAttestationsNoVerify(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attestations")
+	}
+	state, err = b.ProcessDeposits(ctx, state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, err = b.ProcessVoluntaryExitsNoVerifySignature(state, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process validator exits")
+	}
+
+	return state, nil
+}
+
 func verifyOperationLengths(state *pb.BeaconState, body *ethpb.BeaconBlockBody) error {
 	if uint64(len(body.ProposerSlashings)) > params.BeaconConfig().MaxProposerSlashings {
 		return fmt.Errorf(
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
@@ -101,6 +102,33 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	}
 }
 
+func TestExecuteStateTransitionBatchVerify(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumAttestations: 2}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	postState, err := state.ExecuteStateTransitionBatchVerify(context.Background(), proto.Clone(beaconState).(*pb.BeaconState), block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, err := state.ExecuteStateTransitionNoVerifyAttSigs(context.Background(), proto.Clone(beaconState).(*pb.BeaconState), block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(postState, wanted) {
+		t.Error("Batch verified state transition resulted in a different state")
+	}
+
+	// Replace the block signature with another valid signature of the proposer.
+	block.Signature = block.Block.Body.RandaoReveal
+	want := "could not verify block signature"
+	if _, err := state.ExecuteStateTransitionBatchVerify(context.Background(), beaconState, block); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessBlock_IncorrectProposerSlashing(t *testing.T) {
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
 
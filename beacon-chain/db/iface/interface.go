@@ -4,6 +4,7 @@ package iface
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -26,6 +27,7 @@ type ReadOnlyDatabase interface {
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
+	BlockArrivalDelay(ctx context.Context, blockRoot [32]byte) (time.Duration, bool, error)
 	// Validator related methods.
 	ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, bool, error)
 	HasValidatorIndex(ctx context.Context, publicKey []byte) bool
@@ -49,10 +51,14 @@ type ReadOnlyDatabase interface {
 	ArchivedCommitteeInfo(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedCommitteeInfo, error)
 	ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error)
 	ArchivedValidatorParticipation(ctx context.Context, epoch uint64) (*eth.ValidatorParticipation, error)
+	ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*db.ArchivedValidatorRewards, error)
 	// Deposit contract related handlers.
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	DepositSnapshot(ctx context.Context) (*db.DepositSnapshot, error)
+	// Fork choice operations.
+	ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error)
 }
 
 // NoHeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.NoHeadAccessDatabase
@@ -70,6 +76,7 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBlockArrivalDelay(ctx context.Context, blockRoot [32]byte, delay time.Duration) error
 	// Validator related methods.
 	DeleteValidatorIndex(ctx context.Context, publicKey []byte) error
 	SaveValidatorIndex(ctx context.Context, publicKey []byte, validatorIdx uint64) error
@@ -94,10 +101,14 @@ type NoHeadAccessDatabase interface {
 	SaveArchivedCommitteeInfo(ctx context.Context, epoch uint64, info *ethereum_beacon_p2p_v1.ArchivedCommitteeInfo) error
 	SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error
 	SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error
+	SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *db.ArchivedValidatorRewards) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	SaveDepositSnapshot(ctx context.Context, snapshot *db.DepositSnapshot) error
+	// Fork choice operations.
+	SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error
 }
 
 // HeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.HeadAccessDatabase
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -191,6 +192,11 @@ func (e Exporter) ArchivedValidatorParticipation(ctx context.Context, epoch uint
 	return e.db.ArchivedValidatorParticipation(ctx, epoch)
 }
 
+// ArchivedValidatorRewards -- passthrough.
+func (e Exporter) ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*db.ArchivedValidatorRewards, error) {
+	return e.db.ArchivedValidatorRewards(ctx, epoch)
+}
+
 // DepositContractAddress -- passthrough.
 func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
@@ -271,6 +277,11 @@ func (e Exporter) SaveArchivedValidatorParticipation(ctx context.Context, epoch
 	return e.db.SaveArchivedValidatorParticipation(ctx, epoch, part)
 }
 
+// SaveArchivedValidatorRewards -- passthrough.
+func (e Exporter) SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *db.ArchivedValidatorRewards) error {
+	return e.db.SaveArchivedValidatorRewards(ctx, epoch, rewards)
+}
+
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
@@ -296,6 +307,16 @@ func (e Exporter) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	return e.db.IsFinalizedBlock(ctx, blockRoot)
 }
 
+// BlockArrivalDelay -- passthrough.
+func (e Exporter) BlockArrivalDelay(ctx context.Context, blockRoot [32]byte) (time.Duration, bool, error) {
+	return e.db.BlockArrivalDelay(ctx, blockRoot)
+}
+
+// SaveBlockArrivalDelay -- passthrough.
+func (e Exporter) SaveBlockArrivalDelay(ctx context.Context, blockRoot [32]byte, delay time.Duration) error {
+	return e.db.SaveBlockArrivalDelay(ctx, blockRoot, delay)
+}
+
 // PowchainData -- passthrough
 func (e Exporter) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 	return e.db.PowchainData(ctx)
@@ -305,3 +326,23 @@ func (e Exporter) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error {
 	return e.db.SavePowchainData(ctx, data)
 }
+
+// DepositSnapshot -- passthrough
+func (e Exporter) DepositSnapshot(ctx context.Context) (*db.DepositSnapshot, error) {
+	return e.db.DepositSnapshot(ctx)
+}
+
+// SaveDepositSnapshot -- passthrough
+func (e Exporter) SaveDepositSnapshot(ctx context.Context, snapshot *db.DepositSnapshot) error {
+	return e.db.SaveDepositSnapshot(ctx, snapshot)
+}
+
+// ForkChoiceStore -- passthrough
+func (e Exporter) ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error) {
+	return e.db.ForkChoiceStore(ctx)
+}
+
+// SaveForkChoiceStore -- passthrough
+func (e Exporter) SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error {
+	return e.db.SaveForkChoiceStore(ctx, store)
+}
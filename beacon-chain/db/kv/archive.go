@@ -6,6 +6,7 @@ import (
 
 	"github.com/boltdb/bolt"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"go.opencensus.io/trace"
 )
@@ -143,6 +144,40 @@ func (k *Store) SaveArchivedValidatorParticipation(ctx context.Context, epoch ui
 	})
 }
 
+// ArchivedValidatorRewards retrieval by epoch.
+func (k *Store) ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*dbpb.ArchivedValidatorRewards, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedValidatorRewards")
+	defer span.End()
+
+	buf := uint64ToBytes(epoch)
+	var target *dbpb.ArchivedValidatorRewards
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(archivedValidatorRewardsBucket)
+		enc := bkt.Get(buf)
+		if enc == nil {
+			return nil
+		}
+		target = &dbpb.ArchivedValidatorRewards{}
+		return decode(enc, target)
+	})
+	return target, err
+}
+
+// SaveArchivedValidatorRewards by epoch.
+func (k *Store) SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *dbpb.ArchivedValidatorRewards) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedValidatorRewards")
+	defer span.End()
+	buf := uint64ToBytes(epoch)
+	enc, err := encode(rewards)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedValidatorRewardsBucket)
+		return bucket.Put(buf, enc)
+	})
+}
+
 func marshalBalances(bals []uint64) []byte {
 	res := make([]byte, len(bals)*8)
 	offset := 0
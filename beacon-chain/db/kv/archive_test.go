@@ -7,6 +7,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
@@ -164,3 +165,33 @@ func TestStore_ArchivedValidatorParticipation(t *testing.T) {
 		t.Errorf("Wanted %v, received %v", part, retrieved)
 	}
 }
+
+func TestStore_ArchivedValidatorRewards(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	epoch := uint64(10)
+	retrieved, err := db.ArchivedValidatorRewards(ctx, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved != nil {
+		t.Errorf("Expected no rewards before saving, received %v", retrieved)
+	}
+	rewards := &dbpb.ArchivedValidatorRewards{
+		Rewards: []*dbpb.ValidatorRewards{
+			{ValidatorIndex: 1, SourceReward: 10, TargetReward: 11, HeadPenalty: 12, InclusionDelayReward: 13},
+			{ValidatorIndex: 5, SourcePenalty: 20, TargetPenalty: 21, InactivityPenalty: 22, SlashingPenalty: 23},
+		},
+	}
+	if err := db.SaveArchivedValidatorRewards(ctx, epoch, rewards); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err = db.ArchivedValidatorRewards(ctx, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(rewards, retrieved) {
+		t.Errorf("Wanted %v, received %v", rewards, retrieved)
+	}
+}
@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"go.opencensus.io/trace"
+)
+
+// BlockArrivalDelay retrieves how long after the start of its slot a block was received, and
+// whether such a delay was recorded for the block root.
+func (k *Store) BlockArrivalDelay(ctx context.Context, blockRoot [32]byte) (time.Duration, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockArrivalDelay")
+	defer span.End()
+
+	var delay time.Duration
+	var ok bool
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blockArrivalDelayBucket)
+		enc := bkt.Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		delay = time.Duration(binary.LittleEndian.Uint64(enc))
+		ok = true
+		return nil
+	})
+	return delay, ok, err
+}
+
+// SaveBlockArrivalDelay saves how long after the start of its slot a block was received. Negative
+// delays, caused by clock disparity with the proposer, are saved as zero.
+func (k *Store) SaveBlockArrivalDelay(ctx context.Context, blockRoot [32]byte, delay time.Duration) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlockArrivalDelay")
+	defer span.End()
+
+	if delay < 0 {
+		delay = 0
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blockArrivalDelayBucket)
+		return bkt.Put(blockRoot[:], uint64ToBytes(uint64(delay)))
+	})
+}
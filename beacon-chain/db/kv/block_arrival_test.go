@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+)
+
+func TestStore_BlockArrivalDelay_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		root  [32]byte
+		delay time.Duration
+		want  time.Duration
+	}{
+		{name: "timely block", root: [32]byte{'A'}, delay: 1500 * time.Millisecond, want: 1500 * time.Millisecond},
+		{name: "late block", root: [32]byte{'B'}, delay: 7 * time.Second, want: 7 * time.Second},
+		{name: "block before slot start", root: [32]byte{'C'}, delay: -200 * time.Millisecond, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok, err := db.BlockArrivalDelay(ctx, tt.root); err != nil || ok {
+				t.Fatalf("Expected no arrival delay, received ok=%v err=%v", ok, err)
+			}
+			if err := db.SaveBlockArrivalDelay(ctx, tt.root, tt.delay); err != nil {
+				t.Fatal(err)
+			}
+			delay, ok, err := db.BlockArrivalDelay(ctx, tt.root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Fatal("Expected arrival delay to be saved")
+			}
+			if delay != tt.want {
+				t.Errorf("Wanted delay %v, received %v", tt.want, delay)
+			}
+		})
+	}
+}
+
+func TestStore_BlockArrivalDelay_DeletedWithBlock(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 20}}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlockArrivalDelay(ctx, root, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteBlock(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := db.BlockArrivalDelay(ctx, root); err != nil || ok {
+		t.Errorf("Expected arrival delay to be deleted, received ok=%v err=%v", ok, err)
+	}
+}
@@ -221,6 +221,9 @@ func (k *Store) DeleteBlock(ctx context.Context, blockRoot [32]byte) error {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
 		k.blockCache.Del(string(blockRoot[:]))
+		if err := tx.Bucket(blockArrivalDelayBucket).Delete(blockRoot[:]); err != nil {
+			return err
+		}
 		return bkt.Delete(blockRoot[:])
 	})
 }
@@ -246,6 +249,9 @@ func (k *Store) DeleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
 				return errors.Wrap(err, "could not delete root for DB indices")
 			}
 			k.blockCache.Del(string(blockRoot[:]))
+			if err := tx.Bucket(blockArrivalDelayBucket).Delete(blockRoot[:]); err != nil {
+				return err
+			}
 			if err := bkt.Delete(blockRoot[:]); err != nil {
 				return err
 			}
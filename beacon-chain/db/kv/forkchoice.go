@@ -0,0 +1,43 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceStore saves a snapshot of the fork choice store, replacing any previous one.
+func (k *Store) SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceStore")
+	defer span.End()
+
+	enc, err := encode(store)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(forkChoiceBucket)
+		return bkt.Put(forkChoiceStoreKey, enc)
+	})
+}
+
+// ForkChoiceStore retrieves the last saved snapshot of the fork choice store, or nil if none
+// was saved.
+func (k *Store) ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceStore")
+	defer span.End()
+
+	var store *db.ForkChoiceStore
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(forkChoiceBucket)
+		enc := bkt.Get(forkChoiceStoreKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		store = &db.ForkChoiceStore{}
+		return decode(enc, store)
+	})
+	return store, err
+}
@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_ForkChoiceStore_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	retrieved, err := db.ForkChoiceStore(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved != nil {
+		t.Errorf("Expected no fork choice store, received %v", retrieved)
+	}
+
+	root := [32]byte{'A'}
+	store := &dbpb.ForkChoiceStore{
+		JustifiedEpoch: 1,
+		FinalizedEpoch: 1,
+		FinalizedRoot:  root[:],
+		PruneThreshold: 256,
+		Nodes: []*dbpb.ForkChoiceNode{
+			{Slot: 8, Root: root[:], Parent: ^uint64(0), BestChild: ^uint64(0), BestDescendant: ^uint64(0)},
+		},
+		Votes:    []*dbpb.ForkChoiceVote{{CurrentRoot: root[:], NextRoot: root[:], NextEpoch: 1}},
+		Balances: []uint64{32},
+	}
+	if err := db.SaveForkChoiceStore(ctx, store); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err = db.ForkChoiceStore(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(store, retrieved) {
+		t.Errorf("Wanted %v, received %v", store, retrieved)
+	}
+}
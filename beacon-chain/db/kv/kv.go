@@ -95,7 +95,10 @@ func NewKVStore(dirPath string) (*Store, error) {
 			archivedCommitteeInfoBucket,
 			archivedBalancesBucket,
 			archivedValidatorParticipationBucket,
+			archivedValidatorRewardsBucket,
 			powchainBucket,
+			forkChoiceBucket,
+			blockArrivalDelayBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
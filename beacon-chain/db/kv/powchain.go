@@ -41,3 +41,37 @@ func (k *Store) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 	})
 	return data, err
 }
+
+// SaveDepositSnapshot saves the snapshot of the finalized deposits, replacing any previous one.
+func (k *Store) SaveDepositSnapshot(ctx context.Context, snapshot *db.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositSnapshot")
+	defer span.End()
+
+	enc, err := encode(snapshot)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		return bkt.Put(depositSnapshotKey, enc)
+	})
+}
+
+// DepositSnapshot retrieves the last saved snapshot of the finalized deposits, or nil if none was
+// saved.
+func (k *Store) DepositSnapshot(ctx context.Context) (*db.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositSnapshot")
+	defer span.End()
+
+	var snapshot *db.DepositSnapshot
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc := bkt.Get(depositSnapshotKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		snapshot = &db.DepositSnapshot{}
+		return decode(enc, snapshot)
+	})
+	return snapshot, err
+}
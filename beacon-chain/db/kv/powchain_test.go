@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_DepositSnapshot_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	retrieved, err := db.DepositSnapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved != nil {
+		t.Errorf("Expected no deposit snapshot, received %v", retrieved)
+	}
+
+	node := [32]byte{'A'}
+	root := [32]byte{'B'}
+	hash := [32]byte{'C'}
+	snapshot := &dbpb.DepositSnapshot{
+		Finalized:       [][]byte{node[:], node[:]},
+		DepositRoot:     root[:],
+		DepositCount:    3,
+		Eth1BlockHash:   hash[:],
+		Eth1BlockHeight: 100,
+		Epoch:           5,
+	}
+	if err := db.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err = db.DepositSnapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(snapshot, retrieved) {
+		t.Errorf("Wanted %v, received %v", snapshot, retrieved)
+	}
+}
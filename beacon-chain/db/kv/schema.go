@@ -20,7 +20,10 @@ var (
 	archivedCommitteeInfoBucket          = []byte("archived-committee-info")
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
+	archivedValidatorRewardsBucket       = []byte("archived-validator-rewards")
 	powchainBucket                       = []byte("powchain")
+	forkChoiceBucket                     = []byte("fork-choice")
+	blockArrivalDelayBucket              = []byte("block-arrival-delay")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -39,6 +42,8 @@ var (
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	depositSnapshotKey        = []byte("deposit-snapshot")
+	forkChoiceStoreKey        = []byte("fork-choice-store")
 
 	// Migration bucket.
 	migrationBucket = []byte("migrations")
@@ -29,4 +29,16 @@ var (
 		Name:  "archive-attestations",
 		Usage: "Whether or not beacon chain should archive historical blocks",
 	}
+	// ArchiveValidatorRewardsFlag defines whether or not the beacon chain should archive the
+	// breakdown of the rewards and penalties of validators every epoch.
+	ArchiveValidatorRewardsFlag = cli.BoolFlag{
+		Name:  "archive-validator-rewards",
+		Usage: "Whether or not beacon chain should archive the breakdown of validator rewards and penalties every epoch",
+	}
+	// ArchiveValidatorRewardsIndexFlag defines the validators whose rewards and penalties the
+	// beacon chain should archive. All validators are archived if none is set.
+	ArchiveValidatorRewardsIndexFlag = cli.IntSliceFlag{
+		Name:  "archive-validator-rewards-index",
+		Usage: "Index of a validator whose rewards and penalties should be archived, can be repeated (default: all validators)",
+	}
 )
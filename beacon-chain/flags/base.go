@@ -22,6 +22,36 @@ var (
 		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint.",
 		Value: "wss://goerli.prylabs.net/websocket",
 	}
+	// FallbackWeb3ProviderFlag defines a flag for the mainchain RPC endpoints used when the primary
+	// web3 provider is unhealthy, in order of preference.
+	FallbackWeb3ProviderFlag = cli.StringSliceFlag{
+		Name:  "fallback-web3provider",
+		Usage: "A fallback mainchain web3 provider IPC or WebSocket endpoint, used in order when the providers before it are unhealthy. This flag may be used multiple times, once for each --fallback-http-web3provider.",
+	}
+	// FallbackHTTPWeb3ProviderFlag defines a flag for the HTTP endpoints of the fallback web3
+	// providers, matching FallbackWeb3ProviderFlag by position.
+	FallbackHTTPWeb3ProviderFlag = cli.StringSliceFlag{
+		Name:  "fallback-http-web3provider",
+		Usage: "The HTTP endpoint of a fallback mainchain web3 provider. This flag may be used multiple times, once for each --fallback-web3provider.",
+	}
+	// PollWeb3ProviderFlag defines a flag to poll the HTTP web3 providers for new blocks instead of
+	// subscribing to them, for providers without WebSocket support.
+	PollWeb3ProviderFlag = cli.BoolFlag{
+		Name:  "poll-web3provider",
+		Usage: "Poll the HTTP web3 providers for new blocks instead of subscribing to them, so that no IPC or WebSocket web3 provider is needed. The --web3provider and --fallback-web3provider flags are then ignored.",
+	}
+	// Eth1SimulatedFlag defines a flag to run the beacon node on a simulated, in-process ETH1.0 chain
+	// for local devnets.
+	Eth1SimulatedFlag = cli.BoolFlag{
+		Name:  "eth1-simulated",
+		Usage: "Run on a simulated eth1 chain started by the beacon node with a new deposit contract, instead of connecting to the web3 providers. Meant for local devnets, the beacon chain database must be new.",
+	}
+	// Eth1SimulatedDepositsFlag defines the number of deposits of interop validators made on the
+	// simulated ETH1.0 chain when it starts.
+	Eth1SimulatedDepositsFlag = cli.Uint64Flag{
+		Name:  "eth1-simulated-deposits",
+		Usage: "Number of deposits of the deterministic interop validator keys made to the deposit contract when the simulated eth1 chain starts. Must be used with --eth1-simulated",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = cli.StringFlag{
 		Name:  "deposit-contract",
@@ -46,6 +76,20 @@ var (
 		Usage: "Max number of items returned per page in RPC responses for paginated endpoints (default: 500)",
 		Value: 500,
 	}
+	// HistoricalStateCacheSize defines the number of historical states regenerated for RPC queries
+	// kept in memory.
+	HistoricalStateCacheSize = cli.IntFlag{
+		Name:  "historical-state-cache-size",
+		Usage: "Number of historical states regenerated for RPC queries at past slots kept in memory",
+		Value: 8,
+	}
+	// HistoricalStateMaxReplaySlots defines the maximum number of slots replayed from the nearest
+	// stored state to regenerate a historical state.
+	HistoricalStateMaxReplaySlots = cli.IntFlag{
+		Name:  "historical-state-max-replay-slots",
+		Usage: "Maximum number of slots replayed from the nearest stored state to regenerate a historical state for RPC queries, unlimited if 0",
+		Value: 1024,
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = cli.StringFlag{
 		Name:  "tls-cert",
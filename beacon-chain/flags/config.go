@@ -13,8 +13,12 @@ type GlobalFlags struct {
 	EnableArchivedValidatorSetChanges bool
 	EnableArchivedBlocks              bool
 	EnableArchivedAttestations        bool
+	EnableArchivedValidatorRewards    bool
+	ArchivedValidatorRewardsIndices   []uint64
 	MinimumSyncPeers                  int
 	MaxPageSize                       int
+	HistoricalStateCacheSize          int
+	HistoricalStateMaxReplaySlots     uint64
 	DeploymentBlock                   int
 }
 
@@ -49,7 +53,15 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	if ctx.GlobalBool(ArchiveAttestationsFlag.Name) {
 		cfg.EnableArchivedAttestations = true
 	}
+	if ctx.GlobalBool(ArchiveValidatorRewardsFlag.Name) {
+		cfg.EnableArchivedValidatorRewards = true
+	}
+	for _, index := range ctx.GlobalIntSlice(ArchiveValidatorRewardsIndexFlag.Name) {
+		cfg.ArchivedValidatorRewardsIndices = append(cfg.ArchivedValidatorRewardsIndices, uint64(index))
+	}
 	cfg.MaxPageSize = ctx.GlobalInt(RPCMaxPageSize.Name)
+	cfg.HistoricalStateCacheSize = ctx.GlobalInt(HistoricalStateCacheSize.Name)
+	cfg.HistoricalStateMaxReplaySlots = uint64(ctx.GlobalInt(HistoricalStateMaxReplaySlots.Name))
 	cfg.DeploymentBlock = ctx.GlobalInt(ContractDeploymentBlock.Name)
 	configureMinimumPeers(ctx, cfg)
 
@@ -0,0 +1,56 @@
+package forkchoice
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/emicklei/dot"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// Graph renders the block tree of the fork choice store in the Graphviz DOT format. Every block
+// node points to its parent, and is labeled with its slot, root, weight, checkpoint epochs and
+// best descendant. The head block is highlighted, and blocks which are not viable for head are
+// drawn with a dashed border.
+func Graph(store Getter, headRoot [32]byte) string {
+	nodes := store.Nodes()
+
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make([]dot.Node, len(nodes))
+	for i, n := range nodes {
+		root := n.Root()
+		bestDescendant := "none"
+		if n.BestDescendent < uint64(len(nodes)) {
+			bestDescendant = strconv.FormatUint(n.BestDescendent, 10)
+		}
+		label := fmt.Sprintf(
+			"slot: %d\n index: %d\n root: %#x\n weight: %d\n justified epoch: %d\n finalized epoch: %d\n bestDescendent: %s",
+			n.Slot,
+			i,
+			bytesutil.Trunc(root[:]),
+			n.Weight,
+			n.JustifiedEpoch(),
+			n.FinalizedEpoch(),
+			bestDescendant,
+		)
+		dotN := graph.Node(strconv.Itoa(i)).Box().Attr("label", label)
+		if !store.IsViableForHead(n) {
+			dotN.Attr("style", "dashed")
+		}
+		if root == headRoot {
+			dotN.Attr("color", "red")
+		}
+		dotNodes[i] = dotN
+	}
+
+	for i, n := range nodes {
+		if n.Parent < uint64(len(nodes)) {
+			graph.Edge(dotNodes[i], dotNodes[n.Parent])
+		}
+	}
+
+	return graph.String()
+}
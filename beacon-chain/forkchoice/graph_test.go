@@ -0,0 +1,34 @@
+package forkchoice
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestGraph(t *testing.T) {
+	ctx := context.Background()
+	store := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	if err := store.ProcessBlock(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	graph := Graph(store, [32]byte{'b'})
+	for _, want := range []string{
+		"digraph",
+		"slot: 1",
+		"root: 0x620000000000",
+		"->",
+		"red",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Errorf("Expected graph to contain %q, got:\n%s", want, graph)
+		}
+	}
+}
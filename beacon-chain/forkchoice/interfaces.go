@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 )
 
 // ForkChoicer represents the full fork choice interface composed of all of the sub-interfaces.
@@ -13,6 +14,8 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	Snapshotter          // to persist fork choice across restarts.
+	TieBreakerSetter     // to customize how equally weighted blocks are compared.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -38,4 +41,17 @@ type Pruner interface {
 // Getter returns fork choice related information.
 type Getter interface {
 	Nodes() []*protoarray.Node
+	JustifiedEpoch() uint64
+	FinalizedEpoch() uint64
+	IsViableForHead(*protoarray.Node) bool
+}
+
+// Snapshotter takes a snapshot of the fork choice store, which can be saved to the database.
+type Snapshotter interface {
+	Snapshot() *db.ForkChoiceStore
+}
+
+// TieBreakerSetter sets the hook used to break ties between equally weighted blocks.
+type TieBreakerSetter interface {
+	SetTieBreaker(protoarray.TieBreaker)
 }
@@ -0,0 +1,109 @@
+package protoarray
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	TestCases []struct {
+		Blocks []struct {
+			ID     string `yaml:"id"`
+			Parent string `yaml:"parent"`
+		} `yaml:"blocks"`
+		Weights map[string]int `yaml:"weights"`
+		Head    string         `yaml:"head"`
+	} `yaml:"test_cases"`
+}
+
+func TestGetHeadFromYaml(t *testing.T) {
+	ctx := context.Background()
+	filename, _ := filepath.Abs("./lmd_ghost_test.yaml")
+	yamlFile, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c *Config
+	if err := yaml.Unmarshal(yamlFile, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range c.TestCases {
+		var f *ForkChoice
+		blksRoot := make(map[int][32]byte)
+		// Construct block tree from yaml.
+		for _, blk := range test.Blocks {
+			// genesis block condition
+			if blk.ID == blk.Parent {
+				root, err := ssz.HashTreeRoot(&ethpb.BeaconBlock{Slot: 0, ParentRoot: []byte{'g'}})
+				if err != nil {
+					t.Fatal(err)
+				}
+				blksRoot[0] = root
+				f = New(0, 0, root)
+				if err := f.ProcessBlock(ctx, 0, root, params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+					t.Fatal(err)
+				}
+				continue
+			}
+			slot, err := strconv.Atoi(blk.ID[1:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			parentSlot, err := strconv.Atoi(blk.Parent[1:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			parentRoot := blksRoot[parentSlot]
+			root, err := ssz.HashTreeRoot(&ethpb.BeaconBlock{Slot: uint64(slot), ParentRoot: parentRoot[:]})
+			if err != nil {
+				t.Fatal(err)
+			}
+			blksRoot[slot] = root
+			if err := f.ProcessBlock(ctx, uint64(slot), root, parentRoot, 0, 0); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// Assign validator votes to the blocks as weights.
+		count := uint64(0)
+		for blk, votes := range test.Weights {
+			slot, err := strconv.Atoi(blk[1:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < votes; i++ {
+				f.ProcessAttestation(ctx, []uint64{count}, blksRoot[slot], 0)
+				count++
+			}
+		}
+
+		balances := make([]uint64, count)
+		for i := range balances {
+			balances[i] = 1e9
+		}
+
+		head, err := f.Head(ctx, 0, blksRoot[0], balances, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headSlot, err := strconv.Atoi(test.Head[1:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantedHead := blksRoot[headSlot]
+
+		if head != wantedHead {
+			t.Errorf("wanted root %#x, got root %#x", wantedHead, head)
+		}
+	}
+}
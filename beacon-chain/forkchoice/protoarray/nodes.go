@@ -240,8 +240,8 @@ func (s *Store) updateBestChildAndDescendant(ctx context.Context, parentIndex ui
 				newParentChild = noChange
 			} else if child.Weight == bestChild.Weight {
 				// If both are viable, compare their weights.
-				// Tie-breaker of equal weights by root.
-				if bytes.Compare(child.root[:], bestChild.root[:]) > 0 {
+				// Tie-breaker of equal weights by the tie-breaker hook, then by root.
+				if s.prefersChild(child, bestChild) {
 					newParentChild = changeToChild
 				} else {
 					newParentChild = noChange
@@ -273,6 +273,17 @@ func (s *Store) updateBestChildAndDescendant(ctx context.Context, parentIndex ui
 	return nil
 }
 
+// prefersChild breaks the tie between a child and the current best child of equal weight. The
+// optional tie-breaker hook is consulted first, then the node with the higher root wins.
+func (s *Store) prefersChild(child *Node, bestChild *Node) bool {
+	if s.tieBreaker != nil {
+		if c := s.tieBreaker(child.root, bestChild.root); c != 0 {
+			return c > 0
+		}
+	}
+	return bytes.Compare(child.root[:], bestChild.root[:]) > 0
+}
+
 // prune prunes the store with the new finalized root. The tree is only
 // pruned if the input finalized root are different than the one in stored and
 // the number of the nodes in store has met prune threshold.
@@ -295,6 +295,38 @@ func TestStore_UpdateBestChildAndDescendant_ChangeChildByWeight(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateBestChildAndDescendant_TieBreaker(t *testing.T) {
+	// Parent's best child and the child have equal weight, the child has the lower root.
+	tests := []struct {
+		name          string
+		tieBreaker    TieBreaker
+		wantBestChild uint64
+	}{
+		{name: "tie broken by root", tieBreaker: nil, wantBestChild: 1},
+		{name: "hook prefers child", tieBreaker: func(a [32]byte, b [32]byte) int { return 1 }, wantBestChild: 2},
+		{name: "hook prefers best child", tieBreaker: func(a [32]byte, b [32]byte) int { return -1 }, wantBestChild: 1},
+		{name: "hook has no preference", tieBreaker: func(a [32]byte, b [32]byte) int { return 0 }, wantBestChild: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Store{
+				justifiedEpoch: 1,
+				finalizedEpoch: 1,
+				tieBreaker:     tt.tieBreaker,
+				nodes: []*Node{{bestChild: 1, justifiedEpoch: 1, finalizedEpoch: 1},
+					{root: [32]byte{'b'}, BestDescendent: nonExistentNode, justifiedEpoch: 1, finalizedEpoch: 1},
+					{root: [32]byte{'a'}, BestDescendent: nonExistentNode, justifiedEpoch: 1, finalizedEpoch: 1}}}
+
+			if err := s.updateBestChildAndDescendant(context.Background(), 0, 2); err != nil {
+				t.Fatal(err)
+			}
+			if s.nodes[0].bestChild != tt.wantBestChild {
+				t.Errorf("Wanted best child %d, received %d", tt.wantBestChild, s.nodes[0].bestChild)
+			}
+		})
+	}
+}
+
 func TestStore_UpdateBestChildAndDescendant_ChangeChildAtLeaf(t *testing.T) {
 	// Make parent's best child to none and input child leads to viable index.
 	s := &Store{
@@ -0,0 +1,135 @@
+package protoarray
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// Snapshot returns a copy of the fork choice store, including its block nodes, the latest votes
+// of the validators and their balances, which can be persisted and later restored with Restore.
+func (f *ForkChoice) Snapshot() *dbpb.ForkChoiceStore {
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+
+	nodes := make([]*dbpb.ForkChoiceNode, len(f.store.nodes))
+	for i, n := range f.store.nodes {
+		root := n.root
+		nodes[i] = &dbpb.ForkChoiceNode{
+			Slot:           n.Slot,
+			Root:           root[:],
+			Parent:         n.Parent,
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.Weight,
+			BestChild:      n.bestChild,
+			BestDescendant: n.BestDescendent,
+		}
+	}
+	votes := make([]*dbpb.ForkChoiceVote, len(f.votes))
+	for i, v := range f.votes {
+		currentRoot, nextRoot := v.currentRoot, v.nextRoot
+		votes[i] = &dbpb.ForkChoiceVote{
+			CurrentRoot: currentRoot[:],
+			NextRoot:    nextRoot[:],
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	balances := make([]uint64, len(f.balances))
+	copy(balances, f.balances)
+	finalizedRoot := f.store.finalizedRoot
+
+	return &dbpb.ForkChoiceStore{
+		JustifiedEpoch: f.store.justifiedEpoch,
+		FinalizedEpoch: f.store.finalizedEpoch,
+		FinalizedRoot:  finalizedRoot[:],
+		PruneThreshold: f.store.pruneThreshold,
+		Nodes:          nodes,
+		Votes:          votes,
+		Balances:       balances,
+	}
+}
+
+// Restore rebuilds a fork choice store from a snapshot taken with Snapshot. The snapshot is
+// checked for consistency, such that every node index refers to an existing node and every
+// parent precedes its children, as the node list is expected to be topologically sorted.
+func Restore(snapshot *dbpb.ForkChoiceStore) (*ForkChoice, error) {
+	if snapshot == nil {
+		return nil, errors.New("nil fork choice snapshot")
+	}
+	if len(snapshot.FinalizedRoot) != 32 {
+		return nil, fmt.Errorf("invalid finalized root length %d", len(snapshot.FinalizedRoot))
+	}
+	pruneThreshold := snapshot.PruneThreshold
+	if pruneThreshold == 0 {
+		pruneThreshold = defaultPruneThreshold
+	}
+	s := &Store{
+		justifiedEpoch: snapshot.JustifiedEpoch,
+		finalizedEpoch: snapshot.FinalizedEpoch,
+		finalizedRoot:  bytesutil.ToBytes32(snapshot.FinalizedRoot),
+		nodes:          make([]*Node, len(snapshot.Nodes)),
+		nodeIndices:    make(map[[32]byte]uint64, len(snapshot.Nodes)),
+		pruneThreshold: pruneThreshold,
+	}
+
+	numNodes := uint64(len(snapshot.Nodes))
+	validIndex := func(i uint64) bool {
+		return i == nonExistentNode || i < numNodes
+	}
+	for i, n := range snapshot.Nodes {
+		if n == nil {
+			return nil, fmt.Errorf("nil node at index %d", i)
+		}
+		if len(n.Root) != 32 {
+			return nil, fmt.Errorf("invalid root length %d of node %d", len(n.Root), i)
+		}
+		root := bytesutil.ToBytes32(n.Root)
+		if _, ok := s.nodeIndices[root]; ok {
+			return nil, fmt.Errorf("duplicate node %#x", root)
+		}
+		if n.Parent != nonExistentNode && n.Parent >= uint64(i) {
+			return nil, errors.Wrapf(errInvalidNodeIndex, "parent index %d of node %d", n.Parent, i)
+		}
+		if !validIndex(n.BestChild) {
+			return nil, errors.Wrapf(errInvalidBestChildIndex, "node %d", i)
+		}
+		if !validIndex(n.BestDescendant) {
+			return nil, errors.Wrapf(errInvalidBestDescendantIndex, "node %d", i)
+		}
+		s.nodes[i] = &Node{
+			Slot:           n.Slot,
+			root:           root,
+			Parent:         n.Parent,
+			justifiedEpoch: n.JustifiedEpoch,
+			finalizedEpoch: n.FinalizedEpoch,
+			Weight:         n.Weight,
+			bestChild:      n.BestChild,
+			BestDescendent: n.BestDescendant,
+		}
+		s.nodeIndices[root] = uint64(i)
+	}
+
+	votes := make([]Vote, len(snapshot.Votes))
+	for i, v := range snapshot.Votes {
+		if v == nil {
+			return nil, fmt.Errorf("nil vote at index %d", i)
+		}
+		if len(v.CurrentRoot) != 32 || len(v.NextRoot) != 32 {
+			return nil, fmt.Errorf("invalid root length of vote %d", i)
+		}
+		votes[i] = Vote{
+			currentRoot: bytesutil.ToBytes32(v.CurrentRoot),
+			nextRoot:    bytesutil.ToBytes32(v.NextRoot),
+			nextEpoch:   v.NextEpoch,
+		}
+	}
+	balances := make([]uint64, len(snapshot.Balances))
+	copy(balances, snapshot.Balances)
+
+	nodeCount.Set(float64(len(s.nodes)))
+
+	return &ForkChoice{store: s, votes: votes, balances: balances}, nil
+}
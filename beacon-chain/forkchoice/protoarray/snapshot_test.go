@@ -0,0 +1,112 @@
+package protoarray
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestForkChoice_SnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+	f := New(0, 0, params.BeaconConfig().ZeroHash)
+	if err := f.ProcessBlock(ctx, 0, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	// Insert two competing branches on top of genesis.
+	if err := f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(2), 0)
+	balances := []uint64{1, 1}
+	wanted, err := f.Head(ctx, 0, params.BeaconConfig().ZeroHash, balances, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wanted != indexToHash(2) {
+		t.Fatal("Expected the voted branch to be head")
+	}
+
+	restored, err := Restore(f.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.store.nodes, f.store.nodes) {
+		t.Error("Restored nodes do not match")
+	}
+	if !reflect.DeepEqual(restored.store.nodeIndices, f.store.nodeIndices) {
+		t.Error("Restored node indices do not match")
+	}
+	if !reflect.DeepEqual(restored.votes, f.votes) {
+		t.Error("Restored votes do not match")
+	}
+	if !reflect.DeepEqual(restored.balances, f.balances) {
+		t.Error("Restored balances do not match")
+	}
+	head, err := restored.Head(ctx, 0, params.BeaconConfig().ZeroHash, balances, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != wanted {
+		t.Errorf("Wanted head %#x, got %#x", wanted, head)
+	}
+}
+
+func TestRestore_InvalidSnapshot(t *testing.T) {
+	root := indexToHash(1)
+	tests := []struct {
+		name     string
+		snapshot *dbpb.ForkChoiceStore
+	}{
+		{
+			name: "nil snapshot",
+		},
+		{
+			name:     "short finalized root",
+			snapshot: &dbpb.ForkChoiceStore{FinalizedRoot: []byte{'a'}},
+		},
+		{
+			name: "duplicate node",
+			snapshot: &dbpb.ForkChoiceStore{
+				FinalizedRoot: root[:],
+				Nodes: []*dbpb.ForkChoiceNode{
+					{Root: root[:], Parent: nonExistentNode, BestChild: nonExistentNode, BestDescendant: nonExistentNode},
+					{Root: root[:], Parent: 0, BestChild: nonExistentNode, BestDescendant: nonExistentNode},
+				},
+			},
+		},
+		{
+			name: "parent after child",
+			snapshot: &dbpb.ForkChoiceStore{
+				FinalizedRoot: root[:],
+				Nodes:         []*dbpb.ForkChoiceNode{{Root: root[:], Parent: 0, BestChild: nonExistentNode, BestDescendant: nonExistentNode}},
+			},
+		},
+		{
+			name: "best descendant out of range",
+			snapshot: &dbpb.ForkChoiceStore{
+				FinalizedRoot: root[:],
+				Nodes:         []*dbpb.ForkChoiceNode{{Root: root[:], Parent: nonExistentNode, BestChild: nonExistentNode, BestDescendant: 1}},
+			},
+		},
+		{
+			name: "short vote root",
+			snapshot: &dbpb.ForkChoiceStore{
+				FinalizedRoot: root[:],
+				Votes:         []*dbpb.ForkChoiceVote{{CurrentRoot: root[:]}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Restore(tt.snapshot); err == nil {
+				t.Error("Expected an error restoring an invalid snapshot")
+			}
+		})
+	}
+}
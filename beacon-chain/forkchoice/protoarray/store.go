@@ -95,9 +95,51 @@ func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
 	return f.store.prune(ctx, finalizedRoot)
 }
 
+// SetTieBreaker sets the hook used to break ties between equally weighted blocks. A nil hook
+// falls back to comparing the block roots. This should be set before the store is used.
+func (f *ForkChoice) SetTieBreaker(tb TieBreaker) {
+	f.store.tieBreaker = tb
+}
+
 // Nodes returns the copied list of block nodes in the fork choice store.
 func (f *ForkChoice) Nodes() []*Node {
 	cpy := make([]*Node, len(f.store.nodes))
 	copy(cpy, f.store.nodes)
 	return cpy
 }
+
+// JustifiedEpoch returns the latest justified epoch in the fork choice store.
+func (f *ForkChoice) JustifiedEpoch() uint64 {
+	return f.store.justifiedEpoch
+}
+
+// FinalizedEpoch returns the latest finalized epoch in the fork choice store.
+func (f *ForkChoice) FinalizedEpoch() uint64 {
+	return f.store.finalizedEpoch
+}
+
+// IsViableForHead returns true if the node can be selected as head, as its justified and
+// finalized epochs match the ones of the fork choice store.
+func (f *ForkChoice) IsViableForHead(node *Node) bool {
+	return f.store.viableForHead(context.Background(), node)
+}
+
+// Root returns the root of the block converted to the node.
+func (n *Node) Root() [32]byte {
+	return n.root
+}
+
+// JustifiedEpoch returns the justified epoch of the node.
+func (n *Node) JustifiedEpoch() uint64 {
+	return n.justifiedEpoch
+}
+
+// FinalizedEpoch returns the finalized epoch of the node.
+func (n *Node) FinalizedEpoch() uint64 {
+	return n.finalizedEpoch
+}
+
+// BestChild returns the index of the best child of the node.
+func (n *Node) BestChild() uint64 {
+	return n.bestChild
+}
@@ -18,8 +18,14 @@ type Store struct {
 	nodes           []*Node             // list of block nodes, each node is a representation of one block.
 	nodeIndices     map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	nodeIndicesLock sync.RWMutex
+	tieBreaker      TieBreaker // optional hook to break ties between equally weighted nodes.
 }
 
+// TieBreaker compares two equally weighted sibling blocks by root. It returns a positive number
+// if the first block should be preferred, a negative number if the second block should be preferred,
+// and zero if it has no preference, in which case the tie is broken by comparing the roots.
+type TieBreaker func(a [32]byte, b [32]byte) int
+
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
 // This is used as an array based stateful DAG for efficient fork choice look up.
 type Node struct {
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
@@ -47,11 +49,17 @@ func (g *Gateway) Start() {
 
 	g.conn = conn
 
-	gwmux := gwruntime.NewServeMux(gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}))
+	gwmux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}),
+		gwruntime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+	)
 	for _, f := range []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
 		ethpb.RegisterNodeHandler,
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
+		pbrpc.RegisterNodeHandler,
+		pbrpc.RegisterDebugHandler,
+		pbrpc.RegisterChainHandler,
 	} {
 		if err := f(ctx, gwmux, conn); err != nil {
 			log.WithError(err).Error("Failed to start gateway")
@@ -60,6 +68,7 @@ func (g *Gateway) Start() {
 		}
 	}
 
+	g.mux.Handle(eventsPath, eventsServer(conn))
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
@@ -103,6 +112,16 @@ func (g *Gateway) Stop() error {
 	return nil
 }
 
+// incomingHeaderMatcher forwards the headers selecting the historical state read by the beacon
+// chain RPCs as is, in addition to the headers forwarded by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch k := strings.ToLower(key); k {
+	case "x-state-slot", "x-state-block-root", "x-state-root":
+		return k, true
+	}
+	return gwruntime.DefaultHeaderMatcher(key)
+}
+
 // New returns a new gateway server which translates HTTP into gRPC.
 // Accepts a context and optional http.ServeMux.
 func New(ctx context.Context, remoteAddress, gatewayAddress string, mux *http.ServeMux) *Gateway {
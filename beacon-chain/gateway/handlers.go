@@ -1,11 +1,19 @@
 package gateway
 
 import (
+	"fmt"
 	"net/http"
 	"path"
 	"strings"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
+// Path of the server-sent events stream of the beacon node events.
+const eventsPath = "/prysm/events/stream"
+
 // Swagger directory for the runtime files provided by bazel data.
 const swaggerDir = "proto/beacon/rpc/v1/"
 
@@ -24,3 +32,51 @@ func SwaggerServer() http.HandlerFunc {
 		http.ServeFile(w, r, p)
 	}
 }
+
+// eventsServer streams the events of the beacon node as server-sent events, forwarding the
+// StreamEvents gRPC stream. The topics to stream are given by the "topics" query parameters, which
+// may also be comma separated, such as "/prysm/events/stream?topics=head,finalized_checkpoint".
+func eventsServer(conn *grpc.ClientConn) http.HandlerFunc {
+	client := pbrpc.NewEventsClient(conn)
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		var topics []string
+		for _, t := range r.URL.Query()["topics"] {
+			for _, topic := range strings.Split(t, ",") {
+				if topic != "" {
+					topics = append(topics, topic)
+				}
+			}
+		}
+		stream, err := client.StreamEvents(r.Context(), &pbrpc.StreamEventsRequest{Topics: topics})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				// Errors such as an unknown topic are only known once the stream has started, so they
+				// are reported to the client as an error event before closing the stream.
+				if r.Context().Err() == nil {
+					fmt.Fprintf(w, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+					flusher.Flush()
+				}
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Topic, event.Data)
+			flusher.Flush()
+		}
+	}
+}
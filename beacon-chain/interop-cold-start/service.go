@@ -12,10 +12,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
 var _ = shared.Service(&Service{})
@@ -112,6 +115,11 @@ func (s *Service) AllDeposits(ctx context.Context, beforeBlk *big.Int) []*ethpb.
 	return []*ethpb.Deposit{}
 }
 
+// AllDepositContainers mocks out the deposit cache functionality for interop.
+func (s *Service) AllDepositContainers(ctx context.Context) []*protodb.DepositContainer {
+	return []*protodb.DepositContainer{}
+}
+
 // ChainStartDeposits mocks out the powchain functionality for interop.
 func (s *Service) ChainStartDeposits() []*ethpb.Deposit {
 	return s.chainStartDeposits
@@ -142,6 +150,11 @@ func (s *Service) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight
 	return 0, [32]byte{}
 }
 
+// DepositTrie mocks out the deposit cache functionality for interop.
+func (s *Service) DepositTrie(ctx context.Context, beforeBlk *big.Int) (*trieutil.SparseMerkleTrie, error) {
+	return trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+}
+
 func (s *Service) saveGenesisState(ctx context.Context, genesisState *pb.BeaconState) error {
 	s.chainStartDeposits = make([]*ethpb.Deposit, len(genesisState.Validators))
 	stateRoot, err := stateutil.HashTreeRootState(genesisState)
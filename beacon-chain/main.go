@@ -28,6 +28,11 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.FallbackWeb3ProviderFlag,
+	flags.FallbackHTTPWeb3ProviderFlag,
+	flags.PollWeb3ProviderFlag,
+	flags.Eth1SimulatedFlag,
+	flags.Eth1SimulatedDepositsFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -35,6 +40,8 @@ var appFlags = []cli.Flag{
 	flags.GRPCGatewayPort,
 	flags.MinSyncPeers,
 	flags.RPCMaxPageSize,
+	flags.HistoricalStateCacheSize,
+	flags.HistoricalStateMaxReplaySlots,
 	flags.ContractDeploymentBlock,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
@@ -44,6 +51,8 @@ var appFlags = []cli.Flag{
 	flags.ArchiveValidatorSetChangesFlag,
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
+	flags.ArchiveValidatorRewardsFlag,
+	flags.ArchiveValidatorRewardsIndexFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
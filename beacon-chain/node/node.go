@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/archiver"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
@@ -29,6 +30,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/simulated"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
@@ -37,6 +39,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
@@ -322,6 +325,7 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		P2p:               b.fetchP2P(ctx),
 		MaxRoutines:       maxRoutines,
 		StateNotifier:     b,
+		OperationNotifier: b,
 		ForkChoiceStore:   b.forkChoiceStore,
 	})
 	if err != nil {
@@ -345,6 +349,15 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		return b.services.RegisterService(&powchain.Service{})
 	}
 	depAddress := cliCtx.GlobalString(flags.DepositContractFlag.Name)
+	var backend powchain.Backend
+	if cliCtx.GlobalBool(flags.Eth1SimulatedFlag.Name) {
+		simulatedChain, err := b.registerSimulatedETH1Chain(cliCtx)
+		if err != nil {
+			return errors.Wrap(err, "could not register simulated eth1 chain")
+		}
+		backend = simulatedChain
+		depAddress = simulatedChain.ContractAddress().Hex()
+	}
 	if depAddress == "" {
 		var err error
 		depAddress, err = fetchDepositContract()
@@ -357,14 +370,37 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
+	pollOnly := cliCtx.GlobalBool(flags.PollWeb3ProviderFlag.Name)
+	fallbackEndpoints := cliCtx.GlobalStringSlice(flags.FallbackWeb3ProviderFlag.Name)
+	fallbackHTTPEndpoints := cliCtx.GlobalStringSlice(flags.FallbackHTTPWeb3ProviderFlag.Name)
+	if pollOnly {
+		// Only the HTTP endpoints are used when polling.
+		fallbackEndpoints = fallbackHTTPEndpoints
+	}
+	if len(fallbackEndpoints) != len(fallbackHTTPEndpoints) {
+		return fmt.Errorf(
+			"%d fallback web3 providers were given with %d fallback HTTP web3 providers, expected one of each per provider",
+			len(fallbackEndpoints),
+			len(fallbackHTTPEndpoints),
+		)
+	}
+	fallbacks := make([]powchain.Endpoint, len(fallbackEndpoints))
+	for i := range fallbackEndpoints {
+		fallbacks[i] = powchain.Endpoint{ETH1: fallbackEndpoints[i], HTTP: fallbackHTTPEndpoints[i]}
+	}
+
 	ctx := context.Background()
 	cfg := &powchain.Web3ServiceConfig{
-		ETH1Endpoint:    cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
-		HTTPEndPoint:    cliCtx.GlobalString(flags.HTTPWeb3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		BeaconDB:        b.db,
-		DepositCache:    b.depositCache,
-		StateNotifier:   b,
+		ETH1Endpoint:      cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
+		HTTPEndPoint:      cliCtx.GlobalString(flags.HTTPWeb3ProviderFlag.Name),
+		FallbackEndpoints: fallbacks,
+		PollOnly:          pollOnly,
+		Backend:           backend,
+		DepositContract:   common.HexToAddress(depAddress),
+		BeaconDB:          b.db,
+		DepositCache:      b.depositCache,
+		StateNotifier:     b,
+		OperationNotifier: b,
 	}
 	web3Service, err := powchain.NewService(ctx, cfg)
 	if err != nil {
@@ -385,6 +421,27 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	return b.services.RegisterService(web3Service)
 }
 
+// registerSimulatedETH1Chain starts a simulated eth1 chain with the deposits of the first interop
+// validator keys, which the powchain service uses instead of the web3 providers.
+func (b *BeaconNode) registerSimulatedETH1Chain(cliCtx *cli.Context) (*simulated.Chain, error) {
+	var deposits []*ethpb.Deposit_Data
+	if numDeposits := cliCtx.GlobalUint64(flags.Eth1SimulatedDepositsFlag.Name); numDeposits > 0 {
+		privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(0 /*startIndex*/, numDeposits)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate interop validator keys")
+		}
+		deposits, _, err = interop.DepositDataFromKeys(privKeys, pubKeys)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate deposit data")
+		}
+	}
+	simulatedChain, err := simulated.NewChain(context.Background(), deposits)
+	if err != nil {
+		return nil, err
+	}
+	return simulatedChain, b.services.RegisterService(simulatedChain)
+}
+
 func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	var web3Service *powchain.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
@@ -402,13 +459,14 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	}
 
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
-		DB:            b.db,
-		P2P:           b.fetchP2P(ctx),
-		Chain:         chainService,
-		InitialSync:   initSync,
-		StateNotifier: b,
-		AttPool:       b.attestationPool,
-		ExitPool:      b.exitPool,
+		DB:                b.db,
+		P2P:               b.fetchP2P(ctx),
+		Chain:             chainService,
+		InitialSync:       initSync,
+		StateNotifier:     b,
+		OperationNotifier: b,
+		AttPool:           b.attestationPool,
+		ExitPool:          b.exitPool,
 	})
 
 	return b.services.RegisterService(rs)
@@ -487,12 +545,14 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		BlockReceiver:         chainService,
 		AttestationReceiver:   chainService,
 		GenesisTimeFetcher:    chainService,
+		ForkChoiceFetcher:     chainService,
 		AttestationsPool:      b.attestationPool,
 		ExitPool:              b.exitPool,
 		POWChainService:       web3Service,
 		ChainStartFetcher:     chainStartFetcher,
 		MockEth1Votes:         mockEth1DataVotes,
 		SyncService:           syncService,
+		SyncProgressFetcher:   syncService,
 		DepositFetcher:        depositFetcher,
 		PendingDepositFetcher: b.depositCache,
 		StateNotifier:         b,
@@ -572,10 +632,12 @@ func (b *BeaconNode) registerArchiverService(ctx *cli.Context) error {
 		return err
 	}
 	svc := archiver.NewArchiverService(context.Background(), &archiver.Config{
-		BeaconDB:             b.db,
-		HeadFetcher:          chainService,
-		ParticipationFetcher: chainService,
-		StateNotifier:        b,
+		BeaconDB:                b.db,
+		HeadFetcher:             chainService,
+		ParticipationFetcher:    chainService,
+		StateNotifier:           b,
+		ArchiveValidatorRewards: flags.Get().EnableArchivedValidatorRewards,
+		ValidatorRewardsIndices: flags.Get().ArchivedValidatorRewardsIndices,
 	})
 	return b.services.RegisterService(svc)
 }
@@ -108,6 +108,46 @@ func NewService(cfg *Config) (*Service, error) {
 	}
 	s.host = h
 
+	if err := s.startPubsub(); err != nil {
+		return nil, err
+	}
+
+	s.peers = peers.NewStatus(maxBadResponses)
+
+	return s, nil
+}
+
+// NewServiceWithHost initializes a new p2p service on top of an existing libp2p host, such as a
+// host of an in-memory network. Discovery is disabled, so connections to peers have to be
+// established through the given host.
+func NewServiceWithHost(cfg *Config, h host.Host) (*Service, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cache, _ := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,
+		MaxCost:     1000,
+		BufferItems: 64,
+	})
+
+	cfg.NoDiscovery = true
+	s := &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		cfg:           cfg,
+		exclusionList: cache,
+		host:          h,
+	}
+
+	if err := s.startPubsub(); err != nil {
+		return nil, err
+	}
+
+	s.peers = peers.NewStatus(maxBadResponses)
+
+	return s, nil
+}
+
+// startPubsub registers gossipsub on the host of the service.
+func (s *Service) startPubsub() error {
 	// TODO(3147): Add gossip sub options
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
@@ -121,13 +161,10 @@ func NewService(cfg *Config) (*Service, error) {
 	gs, err := pubsub.NewGossipSub(s.ctx, s.host, psOpts...)
 	if err != nil {
 		log.WithError(err).Error("Failed to start pubsub")
-		return nil, err
+		return err
 	}
 	s.pubsub = gs
-
-	s.peers = peers.NewStatus(maxBadResponses)
-
-	return s, nil
+	return nil
 }
 
 // Start the p2p service.
@@ -11,8 +11,10 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	libp2p "github.com/libp2p/go-libp2p"
+	bh "github.com/libp2p/go-libp2p-blankhost"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
+	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
 	multiaddr "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -112,6 +114,28 @@ func TestService_Start_OnlyStartsOnce(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Attempted to start p2p service when it was already started")
 }
 
+func TestNewServiceWithHost(t *testing.T) {
+	h := bh.NewBlankHost(swarmt.GenSwarm(t, context.Background()))
+	s, err := NewServiceWithHost(&Config{Encoding: "ssz"}, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	if s.PeerID() != h.ID() {
+		t.Errorf("Expected peer ID %s, received %s", h.ID(), s.PeerID())
+	}
+	if s.PubSub() == nil {
+		t.Error("Expected pubsub to be set up")
+	}
+	if !s.cfg.NoDiscovery {
+		t.Error("Expected discovery to be disabled")
+	}
+	s.Start()
+	if !s.Started() {
+		t.Error("Expected service to be started")
+	}
+}
+
 func TestService_Status_NotRunning(t *testing.T) {
 	s := &Service{started: false}
 	s.dv5Listener = &mockListener{}
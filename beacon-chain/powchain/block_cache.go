@@ -23,6 +23,11 @@ var (
 	// Eth1FollowDistance.
 	maxCacheSize = int(2 * params.BeaconConfig().Eth1FollowDistance)
 
+	// maxTimeIndexRange is the range of heights below the highest block known for which block
+	// times are kept. It covers the blocks searched by timestamp for eth1 data votes, which are
+	// about Eth1FollowDistance blocks behind the head.
+	maxTimeIndexRange = 4 * params.BeaconConfig().Eth1FollowDistance
+
 	// Metrics
 	blockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_block_cache_miss",
@@ -74,11 +79,23 @@ func heightKeyFn(obj interface{}) (string, error) {
 	return bInfo.Number.String(), nil
 }
 
-// blockCache struct with two queues for looking up by hash or by block height.
+// blockTime is the time of a block in the time index, along with its hash to tell the block apart
+// from the other blocks of the same height.
+type blockTime struct {
+	hash common.Hash
+	time uint64
+}
+
+// blockCache struct with two queues for looking up by hash or by block height, and an index of
+// block times by height. Unlike the queues, the time index is not limited to recently added
+// blocks, so that block times requested out of order are kept across timestamp searches.
 type blockCache struct {
 	hashCache   *cache.FIFO
 	heightCache *cache.FIFO
-	lock        sync.RWMutex
+	timeIndex   map[uint64]blockTime
+	// maxHeight is the highest block height in the time index.
+	maxHeight uint64
+	lock      sync.RWMutex
 }
 
 // newBlockCache creates a new block cache for storing/accessing blockInfo from
@@ -87,6 +104,7 @@ func newBlockCache() *blockCache {
 	return &blockCache{
 		hashCache:   cache.NewFIFO(hashKeyFn),
 		heightCache: cache.NewFIFO(heightKeyFn),
+		timeIndex:   make(map[uint64]blockTime),
 	}
 }
 
@@ -162,12 +180,94 @@ func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
 
 	trim(b.hashCache, maxCacheSize)
 	trim(b.heightCache, maxCacheSize)
+	b.addTime(bInfo.Number.Uint64(), bInfo.Hash, bInfo.Time)
 
 	blockCacheSize.Set(float64(len(b.hashCache.ListKeys())))
 
 	return nil
 }
 
+// ReplaceBlock adds a blockInfo object to the cache, removing the block info of another block of
+// the same height, such as a block of the chain before a reorg.
+func (b *blockCache) ReplaceBlock(blk *gethTypes.Block) error {
+	if err := b.removeOtherBlock(blk.Number(), blk.Hash()); err != nil {
+		return err
+	}
+	return b.AddBlock(blk)
+}
+
+// removeOtherBlock removes the block info and block time of the given height if they have a
+// different hash.
+func (b *blockCache) removeOtherBlock(height *big.Int, hash common.Hash) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// The time index keeps block times beyond the queues, so it is purged on its own.
+	if t, ok := b.timeIndex[height.Uint64()]; ok && t.hash != hash {
+		delete(b.timeIndex, height.Uint64())
+	}
+	obj, exists, err := b.heightCache.GetByKey(height.String())
+	if err != nil || !exists {
+		return err
+	}
+	bInfo, ok := obj.(*blockInfo)
+	if !ok {
+		return ErrNotABlockInfo
+	}
+	if bInfo.Hash == hash {
+		return nil
+	}
+	if err := b.hashCache.Delete(bInfo); err != nil {
+		return err
+	}
+	return b.heightCache.Delete(bInfo)
+}
+
+// BlockTimeByHeight fetches the time of the block of the given height from the time index.
+// Returns true with the block time, if it exists. Otherwise returns false, 0.
+func (b *blockCache) BlockTimeByHeight(height uint64) (bool, uint64) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	t, ok := b.timeIndex[height]
+	if !ok {
+		blockCacheMiss.Inc()
+		return false, 0
+	}
+	blockCacheHit.Inc()
+	return true, t.time
+}
+
+// AddBlockTime adds the time of the block of the given height and hash to the time index, without
+// adding the block to the hash and height queues.
+func (b *blockCache) AddBlockTime(height uint64, hash common.Hash, time uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.addTime(height, hash, time)
+}
+
+// addTime adds a block time to the time index, removing the times of the blocks too far below the
+// highest block known. The lock must be held by the caller.
+func (b *blockCache) addTime(height uint64, hash common.Hash, time uint64) {
+	if height+maxTimeIndexRange < b.maxHeight {
+		return
+	}
+	b.timeIndex[height] = blockTime{hash: hash, time: time}
+	if height <= b.maxHeight {
+		return
+	}
+	b.maxHeight = height
+	// Only scan the index once it holds at least as many times out of range as in range.
+	if uint64(len(b.timeIndex)) <= 2*maxTimeIndexRange {
+		return
+	}
+	for h := range b.timeIndex {
+		if h+maxTimeIndexRange < b.maxHeight {
+			delete(b.timeIndex, h)
+		}
+	}
+}
+
 // trim the FIFO queue to the maxSize.
 func trim(queue *cache.FIFO, maxSize int) {
 	for s := len(queue.ListKeys()); s > maxSize; s-- {
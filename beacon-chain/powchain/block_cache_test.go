@@ -163,3 +163,84 @@ func TestBlockCache_maxSize(t *testing.T) {
 		)
 	}
 }
+
+func TestBlockCache_replaceBlock(t *testing.T) {
+	cache := newBlockCache()
+
+	header := &gethTypes.Header{
+		Number: big.NewInt(10),
+		Time:   100,
+	}
+	reorged := gethTypes.NewBlockWithHeader(header)
+	if err := cache.AddBlock(reorged); err != nil {
+		t.Fatal(err)
+	}
+	header.Time = 101
+	canonical := gethTypes.NewBlockWithHeader(header)
+	if err := cache.ReplaceBlock(canonical); err != nil {
+		t.Fatal(err)
+	}
+
+	exists, fetchedInfo, err := cache.BlockInfoByHeight(header.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("Expected block info to exist")
+	}
+	if fetchedInfo.Hash != canonical.Hash() {
+		t.Errorf("Expected hash of block at height %d to be %#x, got %#x", header.Number, canonical.Hash(), fetchedInfo.Hash)
+	}
+	exists, _, err = cache.BlockInfoByHash(reorged.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("Expected block info of replaced block to be removed")
+	}
+}
+
+func TestBlockCache_timeIndex(t *testing.T) {
+	cache := newBlockCache()
+
+	cache.AddBlockTime(10, common.Hash{}, 140)
+	exists, blockTime := cache.BlockTimeByHeight(10)
+	if !exists || blockTime != 140 {
+		t.Errorf("Expected time of block 10 to be 140, got %d", blockTime)
+	}
+
+	// Times of blocks far below the highest block are eventually removed.
+	for h := uint64(11); h <= 10+3*maxTimeIndexRange; h++ {
+		cache.AddBlockTime(h, common.Hash{}, h*14)
+	}
+	if exists, _ := cache.BlockTimeByHeight(10); exists {
+		t.Error("Expected time of block 10 to be removed")
+	}
+	if uint64(len(cache.timeIndex)) > 2*maxTimeIndexRange {
+		t.Errorf("Expected at most %d block times, got %d", 2*maxTimeIndexRange, len(cache.timeIndex))
+	}
+	exists, blockTime = cache.BlockTimeByHeight(10 + 3*maxTimeIndexRange)
+	if !exists || blockTime != (10+3*maxTimeIndexRange)*14 {
+		t.Error("Expected time of the highest block to exist")
+	}
+}
+
+func TestBlockCache_removeOtherBlockPurgesTimeIndex(t *testing.T) {
+	cache := newBlockCache()
+	// The time of a block beyond the queues, such as a block searched by timestamp.
+	cache.AddBlockTime(10, common.Hash{'a'}, 140)
+
+	if err := cache.removeOtherBlock(big.NewInt(10), common.Hash{'a'}); err != nil {
+		t.Fatal(err)
+	}
+	if exists, _ := cache.BlockTimeByHeight(10); !exists {
+		t.Error("Expected time of block kept at height 10 to exist")
+	}
+
+	if err := cache.removeOtherBlock(big.NewInt(10), common.Hash{'b'}); err != nil {
+		t.Fatal(err)
+	}
+	if exists, _ := cache.BlockTimeByHeight(10); exists {
+		t.Error("Expected time of replaced block to be removed")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -22,7 +23,7 @@ func (s *Service) BlockExists(ctx context.Context, hash common.Hash) (bool, *big
 		return true, blkInfo.Number, nil
 	}
 	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
-	block, err := s.blockFetcher.BlockByHash(ctx, hash)
+	block, err := s.fetcher().BlockByHash(ctx, hash)
 	if err != nil {
 		return false, big.NewInt(0), errors.Wrap(err, "could not query block with given hash")
 	}
@@ -47,7 +48,7 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 		return blkInfo.Hash, nil
 	}
 	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
-	block, err := s.blockFetcher.BlockByNumber(ctx, height)
+	block, err := s.fetcher().BlockByNumber(ctx, height)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not query block with given height")
 	}
@@ -61,7 +62,7 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 func (s *Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockTimeByHeight")
 	defer span.End()
-	block, err := s.blockFetcher.BlockByNumber(ctx, height)
+	block, err := s.fetcher().BlockByNumber(ctx, height)
 	if err != nil {
 		return 0, errors.Wrap(err, "could not query block with given height")
 	}
@@ -69,41 +70,85 @@ func (s *Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint6
 }
 
 // BlockNumberByTimestamp returns the most recent block number up to a given timestamp.
-// This is a naive implementation that will use O(ETH1_FOLLOW_DISTANCE) calls to cache
-// or ETH1. This is called for multiple times but only changes every
-// SlotsPerEth1VotingPeriod (1024 slots) so the whole method should be cached.
+// Only block headers are requested, starting with the head. The search steps back from the head
+// by the distance estimated with the average block time, doubling the step until reaching a block
+// no later than the timestamp, then narrows the range of heights by alternating interpolation of
+// the block times and bisection. Block times are kept in the block cache, so that the searches of
+// the same voting period mostly hit the cache.
 func (s *Service) BlockNumberByTimestamp(ctx context.Context, time uint64) (*big.Int, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockByTimestamp")
 	defer span.End()
 
-	head, err := s.blockFetcher.BlockByNumber(ctx, nil)
+	head, err := s.fetcher().HeaderByNumber(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "could not get latest block header")
 	}
+	if head.Time <= time {
+		return head.Number, nil
+	}
+	s.blockCache.AddBlockTime(head.Number.Uint64(), head.Hash(), head.Time)
 
-	for bn := head.Number(); ; bn = big.NewInt(0).Sub(bn, big.NewInt(1)) {
-		if ctx.Err() != nil {
-			return nil, ctx.Err()
+	// The block at height hi is always later than the timestamp, and the one at height lo is not.
+	hi, hiTime := head.Number.Uint64(), head.Time
+	step := (hiTime-time)/params.BeaconConfig().GoerliBlockTime + 1
+	var lo, loTime uint64
+	for {
+		if hi == 0 {
+			return nil, errors.Errorf("no block up to timestamp %d, the first block is at %d", time, hiTime)
 		}
-
-		exists, info, err := s.blockCache.BlockInfoByHeight(bn)
+		lo = 0
+		if step < hi {
+			lo = hi - step
+		}
+		loTime, err = s.blockTimeByHeight(ctx, lo)
 		if err != nil {
 			return nil, err
 		}
+		if loTime <= time {
+			break
+		}
+		hi, hiTime = lo, loTime
+		step *= 2
+	}
 
-		if !exists {
-			blk, err := s.blockFetcher.BlockByNumber(ctx, bn)
-			if err != nil {
-				return nil, err
-			}
-			if err := s.blockCache.AddBlock(blk); err != nil {
-				return nil, err
+	for i := 0; hi-lo > 1; i++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// Interpolation converges in a few steps when block times are regular, bisection bounds
+		// the number of steps otherwise.
+		mid := lo + (hi-lo)/2
+		if i%2 == 0 {
+			mid = lo + (time-loTime)*(hi-lo)/(hiTime-loTime)
+			if mid <= lo {
+				mid = lo + 1
+			} else if mid >= hi {
+				mid = hi - 1
 			}
-			info = blockToBlockInfo(blk)
 		}
-
-		if info.Time <= time {
-			return info.Number, nil
+		midTime, err := s.blockTimeByHeight(ctx, mid)
+		if err != nil {
+			return nil, err
+		}
+		if midTime <= time {
+			lo, loTime = mid, midTime
+		} else {
+			hi, hiTime = mid, midTime
 		}
 	}
+	return new(big.Int).SetUint64(lo), nil
+}
+
+// blockTimeByHeight returns the time of the block of the given height from the block cache,
+// requesting only its header on a cache miss.
+func (s *Service) blockTimeByHeight(ctx context.Context, height uint64) (uint64, error) {
+	if exists, t := s.blockCache.BlockTimeByHeight(height); exists {
+		return t, nil
+	}
+	header, err := s.fetcher().HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get header of block %d", height)
+	}
+	s.blockCache.AddBlockTime(height, header.Hash(), header.Time)
+	return header.Time, nil
 }
@@ -237,25 +237,108 @@ func TestBlockExists_UsesCachedBlockInfo(t *testing.T) {
 }
 
 func TestBlockNumberByTimestamp(t *testing.T) {
-	beaconDB := dbutil.SetupDB(t)
-	defer dbutil.TeardownDB(t, beaconDB)
-	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		ETH1Endpoint: endpoint,
-		BeaconDB:     beaconDB,
-	})
-	if err != nil {
-		t.Fatal(err)
+	// Blocks are 14 seconds apart, except for a gap of 10 minutes after block 5000.
+	times := make([]uint64, 10000)
+	for i := range times {
+		times[i] = 1000 + uint64(i)*14
+		if i > 5000 {
+			times[i] += 600
+		}
 	}
-	web3Service = setDefaultMocks(web3Service)
-	web3Service.client = nil
+	tests := []struct {
+		name    string
+		time    uint64
+		want    uint64
+		wantErr bool
+	}{
+		{
+			name: "exact block time",
+			time: times[8976],
+			want: 8976,
+		},
+		{
+			name: "between blocks",
+			time: times[8976] + 13,
+			want: 8976,
+		},
+		{
+			name: "during gap",
+			time: times[5000] + 300,
+			want: 5000,
+		},
+		{
+			name: "far from head",
+			time: times[42] + 1,
+			want: 42,
+		},
+		{
+			name: "first block",
+			time: times[0],
+			want: 0,
+		},
+		{
+			name: "after head",
+			time: times[9999] + 100,
+			want: 9999,
+		},
+		{
+			name:    "before first block",
+			time:    times[0] - 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockPOW.BlockFetcher{Times: times}
+			web3Service := &Service{
+				blockFetcher: fetcher,
+				blockCache:   newBlockCache(),
+			}
+			bn, err := web3Service.BlockNumberByTimestamp(context.Background(), tt.time)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error for a timestamp before the first block")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bn.Uint64() != tt.want {
+				t.Errorf("Wanted block %d, received %d", tt.want, bn)
+			}
+			if fetcher.Requests > 40 {
+				t.Errorf("Expected at most 40 requests, received %d", fetcher.Requests)
+			}
+		})
+	}
+}
 
+func TestBlockNumberByTimestamp_UsesCachedTimes(t *testing.T) {
+	times := make([]uint64, 5000)
+	for i := range times {
+		times[i] = 1000 + uint64(i)*14
+	}
+	fetcher := &mockPOW.BlockFetcher{Times: times}
+	web3Service := &Service{
+		blockFetcher: fetcher,
+		blockCache:   newBlockCache(),
+	}
 	ctx := context.Background()
-	bn, err := web3Service.BlockNumberByTimestamp(ctx, 150000 /* time */)
-	if err != nil {
+	if _, err := web3Service.BlockNumberByTimestamp(ctx, times[3976]+5); err != nil {
 		t.Fatal(err)
 	}
+	requests := fetcher.Requests
 
-	if bn.Cmp(big.NewInt(0)) == 0 {
-		t.Error("Returned a block with zero number, expected to be non zero")
+	// Only the head is requested again when searching for the same timestamp.
+	bn, err := web3Service.BlockNumberByTimestamp(ctx, times[3976]+5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bn.Uint64() != 3976 {
+		t.Errorf("Wanted block 3976, received %d", bn)
+	}
+	if fetcher.Requests != requests+1 {
+		t.Errorf("Wanted 1 request, received %d", fetcher.Requests-requests)
 	}
 }
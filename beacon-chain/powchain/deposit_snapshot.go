@@ -0,0 +1,129 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// saveDepositSnapshot persists the snapshot of the deposits processed by the finalized state of the
+// given checkpoint. These deposits are never included in a block again, so a node only needs their
+// finalized branch to resume processing the deposit logs from the snapshot.
+func (s *Service) saveDepositSnapshot(ctx context.Context, epoch uint64, blockRoot [32]byte) error {
+	finalizedState, err := s.beaconDB.State(ctx, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	if finalizedState == nil {
+		return errors.Errorf("no finalized state with block root %#x", blockRoot)
+	}
+	count := finalizedState.Eth1DepositIndex
+	if count == 0 || count > uint64(s.lastReceivedMerkleIndex+1) {
+		return nil
+	}
+
+	ctrs := s.depositCache.AllDepositContainers(ctx)
+	idx := sort.Search(len(ctrs), func(i int) bool { return uint64(ctrs[i].Index) >= count-1 })
+	if idx == len(ctrs) || uint64(ctrs[idx].Index) != count-1 {
+		return errors.Errorf("no deposit with index %d in the deposit cache", count-1)
+	}
+	lastDeposit := ctrs[idx]
+
+	branch, err := s.depositTrie.FinalizedBranch(int(count))
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized branch of the deposit trie")
+	}
+	root, err := trieutil.FinalizedBranchRoot(branch, count, uint(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root[:], lastDeposit.DepositRoot) {
+		return errors.Errorf("finalized branch root %#x does not match deposit root %#x", root, lastDeposit.DepositRoot)
+	}
+	blockHash, err := s.BlockHashByHeight(ctx, new(big.Int).SetUint64(lastDeposit.Eth1BlockHeight))
+	if err != nil {
+		return errors.Wrap(err, "could not get block of the last finalized deposit")
+	}
+
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:       branch,
+		DepositRoot:     root[:],
+		DepositCount:    count,
+		Eth1BlockHash:   blockHash.Bytes(),
+		Eth1BlockHeight: lastDeposit.Eth1BlockHeight,
+		Epoch:           epoch,
+	}
+	if err := s.beaconDB.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	s.depositCache.InsertDepositSnapshot(ctx, snapshot)
+	log.WithFields(logrus.Fields{
+		"epoch":        epoch,
+		"depositCount": count,
+		"blockNumber":  lastDeposit.Eth1BlockHeight,
+	}).Debug("Saved deposit snapshot")
+	return nil
+}
+
+// initFromDepositSnapshot sets up the deposit trie and the log processing of a node without any
+// powchain data from a deposit snapshot. The logs of the deposits following the snapshot are
+// requested from the block of the last deposit of the snapshot.
+func (s *Service) initFromDepositSnapshot(ctx context.Context, snapshot *protodb.DepositSnapshot) error {
+	if err := verifyDepositSnapshot(snapshot); err != nil {
+		return err
+	}
+	// The snapshot is taken from a finalized state, so the beacon chain has already started from
+	// the genesis state the node needs to resume from.
+	genesisState, err := s.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if genesisState == nil {
+		return errors.New("no genesis state in db, a deposit snapshot can only be imported along with the genesis state")
+	}
+	depositTrie, err := trieutil.GenerateTrieFromFinalizedBranch(
+		snapshot.Finalized,
+		int(snapshot.DepositCount),
+		int(params.BeaconConfig().DepositContractTreeDepth),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not generate deposit trie from snapshot")
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	if snapshot.Eth1BlockHeight > 0 {
+		s.latestEth1Data.LastRequestedBlock = snapshot.Eth1BlockHeight - 1
+	}
+
+	s.chainStartData.Chainstarted = true
+	s.chainStartData.GenesisTime = genesisState.GenesisTime
+	s.chainStartData.Eth1Data = genesisState.Eth1Data
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"blockNumber":  snapshot.Eth1BlockHeight,
+	}).Info("Resuming deposit log processing from deposit snapshot")
+	return nil
+}
+
+// verifyDepositSnapshot checks the finalized branch of the deposit snapshot against its deposit root.
+func verifyDepositSnapshot(snapshot *protodb.DepositSnapshot) error {
+	root, err := trieutil.FinalizedBranchRoot(
+		snapshot.Finalized,
+		snapshot.DepositCount,
+		uint(params.BeaconConfig().DepositContractTreeDepth),
+	)
+	if err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match its finalized branch root %#x", snapshot.DepositRoot, root)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestSaveDepositSnapshot(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{
+		beaconDB:                beaconDB,
+		depositCache:            depositcache.NewDepositCache(),
+		depositTrie:             depositTrie,
+		blockCache:              newBlockCache(),
+		lastReceivedMerkleIndex: -1,
+	}
+	for i, dep := range deposits {
+		leaf, err := ssz.HashTreeRoot(dep.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.depositTrie.Insert(leaf[:], i)
+		s.depositCache.InsertDeposit(ctx, dep, uint64(100+i), int64(i), s.depositTrie.Root())
+		s.lastReceivedMerkleIndex = int64(i)
+		header := &gethTypes.Header{Number: big.NewInt(int64(100 + i))}
+		if err := s.blockCache.AddBlock(gethTypes.NewBlockWithHeader(header)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		depositIndex uint64
+		wantSnapshot bool
+	}{
+		{
+			name:         "no processed deposits",
+			depositIndex: 0,
+		},
+		{
+			name:         "deposits not received yet",
+			depositIndex: 9,
+		},
+		{
+			name:         "processed deposits",
+			depositIndex: 5,
+			wantSnapshot: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blockRoot := [32]byte{byte(i)}
+			if err := beaconDB.SaveState(ctx, &pb.BeaconState{Eth1DepositIndex: tt.depositIndex}, blockRoot); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.saveDepositSnapshot(ctx, uint64(i), blockRoot); err != nil {
+				t.Fatal(err)
+			}
+			snapshot, err := beaconDB.DepositSnapshot(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.wantSnapshot {
+				if snapshot != nil {
+					t.Errorf("Expected no deposit snapshot, received %v", snapshot)
+				}
+				return
+			}
+			if snapshot == nil {
+				t.Fatal("Expected a deposit snapshot")
+			}
+			if snapshot.DepositCount != tt.depositIndex || snapshot.Epoch != uint64(i) {
+				t.Errorf("Wrong deposit count %d or epoch %d", snapshot.DepositCount, snapshot.Epoch)
+			}
+			if snapshot.Eth1BlockHeight != 100+tt.depositIndex-1 {
+				t.Errorf("Wanted eth1 block height %d, received %d", 100+tt.depositIndex-1, snapshot.Eth1BlockHeight)
+			}
+			if err := verifyDepositSnapshot(snapshot); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// depositSnapshot returns a valid snapshot of 10 deposits up to eth1 block 120.
+func depositSnapshot(t *testing.T) *protodb.DepositSnapshot {
+	if _, _, err := testutil.DeterministicDepositsAndKeys(10); err != nil {
+		t.Fatal(err)
+	}
+	depositTrie, _, err := testutil.DeterministicDepositTrie(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	branch, err := depositTrie.FinalizedBranch(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := depositTrie.Root()
+	return &protodb.DepositSnapshot{
+		Finalized:       branch,
+		DepositRoot:     root[:],
+		DepositCount:    10,
+		Eth1BlockHeight: 120,
+	}
+}
+
+func TestNewService_InitFromDepositSnapshot(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	snapshot := depositSnapshot(t)
+	root := bytesutil.ToBytes32(snapshot.DepositRoot)
+	if err := beaconDB.SaveDepositSnapshot(ctx, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot := [32]byte{'g'}
+	if err := beaconDB.SaveState(ctx, &pb.BeaconState{GenesisTime: 100}, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	depositCache := depositcache.NewDepositCache()
+	s, err := NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositCache,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.depositTrie.Root() != root {
+		t.Errorf("Wanted deposit root %#x, received %#x", root, s.depositTrie.Root())
+	}
+	if s.lastReceivedMerkleIndex != 9 {
+		t.Errorf("Wanted last received index 9, received %d", s.lastReceivedMerkleIndex)
+	}
+	if s.latestEth1Data.LastRequestedBlock != 119 {
+		t.Errorf("Wanted last requested block 119, received %d", s.latestEth1Data.LastRequestedBlock)
+	}
+	if !s.chainStartData.Chainstarted || s.chainStartData.GenesisTime != 100 {
+		t.Errorf("Expected chain to be started at genesis time 100, received %v", s.chainStartData)
+	}
+	count, cachedRoot := depositCache.DepositsNumberAndRootAtHeight(ctx, big.NewInt(120))
+	if count != 10 || cachedRoot != root {
+		t.Errorf("Wrong deposit count %d or root %#x in the deposit cache", count, cachedRoot)
+	}
+}
+
+func TestNewService_InvalidDepositSnapshot(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	if err := beaconDB.SaveDepositSnapshot(ctx, &protodb.DepositSnapshot{
+		Finalized:    [][]byte{make([]byte, 32)},
+		DepositRoot:  []byte("root"),
+		DepositCount: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositcache.NewDepositCache(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("Expected invalid deposit snapshot error, received %v", err)
+	}
+}
+
+func TestNewService_DepositSnapshotWithoutGenesisState(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	// A snapshot imported into an empty db.
+	if err := beaconDB.SaveDepositSnapshot(ctx, depositSnapshot(t)); err != nil {
+		t.Fatal(err)
+	}
+	_, err := NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositcache.NewDepositCache(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "no genesis state in db") {
+		t.Errorf("Expected missing genesis state error, received %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+var reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "powchain_reorgs",
+	Help: "The number of eth1 chain reorgs observed while polling for new blocks",
+})
+
+// time between two requests for the latest eth1 block in poll-only mode.
+var headerPollPeriod = 4 * time.Second
+
+// pollHeaders requests the latest block of the eth1 chain and processes the blocks observed since
+// the previous poll, as processSubscribedHeaders does for the blocks of a new head subscription.
+// Gaps between two polls are filled within the follow distance window, which is the range of
+// blocks relevant to the beacon chain, and reorgs of that window are reconciled.
+func (s *Service) pollHeaders(ctx context.Context) error {
+	latest, err := s.fetcher().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not get latest eth1 block")
+	}
+	if bytes.Equal(latest.Hash().Bytes(), s.latestEth1Data.BlockHash) {
+		return nil
+	}
+
+	number := latest.Number.Uint64()
+	start := s.latestEth1Data.BlockHeight + 1
+	if window := params.BeaconConfig().Eth1FollowDistance; number > window && start < number-window {
+		start = number - window
+	}
+	var headers []*gethTypes.Header
+	if start < number {
+		headers, err = s.batchRequestHeaders(start, number-1)
+		if err != nil {
+			return errors.Wrapf(err, "could not get eth1 blocks %d to %d", start, number-1)
+		}
+		for _, h := range headers {
+			if h.Number == nil {
+				return errors.Errorf("eth1 provider is missing blocks between %d and %d", start, number-1)
+			}
+		}
+	}
+	headers = append(headers, latest)
+
+	if err := s.reconcileReorg(ctx, headers[0]); err != nil {
+		return errors.Wrap(err, "could not reconcile eth1 reorg")
+	}
+	// Blocks of a previous, longer chain above the new head are removed.
+	for height := number + 1; height <= s.latestEth1Data.BlockHeight; height++ {
+		if err := s.blockCache.removeOtherBlock(new(big.Int).SetUint64(height), common.Hash{}); err != nil {
+			return err
+		}
+	}
+	for _, h := range headers {
+		s.processSubscribedHeaders(h)
+	}
+	return nil
+}
+
+// reconcileReorg walks back from the given header, the first new block of a poll, to the block it
+// has in common with the block cache. Blocks of the previous chain within the follow distance window
+// are replaced by the blocks of the new chain, and deposit logs that were processed from replaced
+// blocks are requested again from the new chain.
+func (s *Service) reconcileReorg(ctx context.Context, header *gethTypes.Header) error {
+	exists, info, err := s.blockCache.BlockInfoByHeight(header.Number)
+	if err != nil {
+		return err
+	}
+	reorged := exists && info.Hash != header.Hash()
+	window := params.BeaconConfig().Eth1FollowDistance
+	for depth := uint64(0); header.Number.Uint64() > 0; depth++ {
+		parentNumber := new(big.Int).Sub(header.Number, big.NewInt(1))
+		exists, info, err := s.blockCache.BlockInfoByHeight(parentNumber)
+		if err != nil {
+			return err
+		}
+		if !exists || info.Hash == header.ParentHash {
+			break
+		}
+		if depth == window {
+			log.WithField("blockNumber", parentNumber).Error("Eth1 reorg is deeper than the follow distance")
+			break
+		}
+		parent, err := s.fetcher().BlockByHash(ctx, header.ParentHash)
+		if err != nil {
+			return errors.Wrapf(err, "could not get eth1 block %#x", header.ParentHash)
+		}
+		if err := s.blockCache.ReplaceBlock(parent); err != nil {
+			return err
+		}
+		header = parent.Header()
+		reorged = true
+	}
+	if !reorged {
+		return nil
+	}
+
+	reorgCount.Inc()
+	ancestor := header.Number.Uint64() - 1
+	log.WithFields(logrus.Fields{
+		"commonAncestor": ancestor,
+		"depth":          s.latestEth1Data.BlockHeight - ancestor,
+	}).Warn("Eth1 chain reorg")
+	if s.latestEth1Data.LastRequestedBlock <= ancestor {
+		return nil
+	}
+	// The deposits of the replaced blocks are orphaned, they are removed and the logs of the new chain
+	// are processed from the common ancestor.
+	log.WithField("lastRequestedBlock", s.latestEth1Data.LastRequestedBlock).Warn(
+		"Eth1 reorg reached blocks with processed deposit logs, requesting them again",
+	)
+	if err := s.rollbackDeposits(ctx, ancestor); err != nil {
+		return errors.Wrap(err, "could not roll back deposits of reorged eth1 blocks")
+	}
+	return s.processBlksInRange(ctx, ancestor+1, s.latestEth1Data.LastRequestedBlock)
+}
+
+// rollbackDeposits removes the deposits included in the blocks above the given height, and brings
+// the deposit trie, the last received deposit index and, before chainstart, the chainstart deposits
+// and the pre-genesis state back to the deposits that remain.
+func (s *Service) rollbackDeposits(ctx context.Context, height uint64) error {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	removed := s.depositCache.PruneDepositsAfterHeight(ctx, height)
+	if removed == 0 {
+		return nil
+	}
+
+	count, _ := s.depositCache.DepositsNumberAndRootAtHeight(ctx, new(big.Int).SetUint64(height))
+	var depositTrie *trieutil.SparseMerkleTrie
+	var err error
+	if count == 0 {
+		depositTrie, err = trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	} else {
+		depositTrie, err = s.depositCache.DepositTrie(ctx, nil)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not rebuild deposit trie")
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(count) - 1
+
+	if !s.chainStartData.Chainstarted {
+		// The pre-genesis state is rebuilt from the remaining chainstart deposits, each processed
+		// against the deposit root at its inclusion.
+		if int(count) < len(s.chainStartData.ChainstartDeposits) {
+			s.chainStartData.ChainstartDeposits = s.chainStartData.ChainstartDeposits[:count]
+		}
+		s.preGenesisState = state.EmptyGenesisState()
+		for _, ctnr := range s.depositCache.AllDepositContainers(ctx) {
+			eth1Data := &ethpb.Eth1Data{
+				DepositRoot:  ctnr.DepositRoot,
+				DepositCount: uint64(ctnr.Index + 1),
+			}
+			if err := s.processDeposit(eth1Data, ctnr.Deposit); err != nil {
+				log.Errorf("Invalid deposit processed: %v", err)
+			}
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"removedDeposits": removed,
+		"depositCount":    count,
+	}).Warn("Removed deposits of reorged eth1 blocks")
+	return nil
+}
@@ -0,0 +1,223 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// pollChain serves the blocks of an eth1 chain to the block fetcher and batch calls of the service.
+type pollChain struct {
+	canonical []*gethTypes.Header
+	byHash    map[common.Hash]*gethTypes.Header
+}
+
+// extend adds blocks with the given times on top of the canonical block at the given height,
+// replacing the canonical blocks above it.
+func (c *pollChain) extend(height uint64, times ...uint64) {
+	if c.byHash == nil {
+		c.byHash = make(map[common.Hash]*gethTypes.Header)
+	}
+	c.canonical = c.canonical[:height+1]
+	for _, tm := range times {
+		parent := c.canonical[len(c.canonical)-1]
+		h := &gethTypes.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).Add(parent.Number, big.NewInt(1)),
+			Time:       tm,
+		}
+		c.canonical = append(c.canonical, h)
+		c.byHash[h.Hash()] = h
+	}
+}
+
+func (c *pollChain) HeaderByNumber(_ context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number == nil {
+		return c.canonical[len(c.canonical)-1], nil
+	}
+	return c.canonical[number.Uint64()], nil
+}
+
+func (c *pollChain) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	h, err := c.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return gethTypes.NewBlockWithHeader(h), nil
+}
+
+func (c *pollChain) BlockByHash(_ context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	h, ok := c.byHash[hash]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return gethTypes.NewBlockWithHeader(h), nil
+}
+
+func (c *pollChain) BatchCall(b []gethRPC.BatchElem) error {
+	for _, e := range b {
+		number, err := hexutil.DecodeBig(e.Args[0].(string))
+		if err != nil {
+			return err
+		}
+		*e.Result.(*gethTypes.Header) = *c.canonical[number.Uint64()]
+	}
+	return nil
+}
+
+func newPollingService(chain *pollChain, height uint64) *Service {
+	s := &Service{
+		blockFetcher: chain,
+		rpcClient:    chain,
+		blockCache:   newBlockCache(),
+		latestEth1Data: &protodb.LatestETH1Data{
+			BlockHash: []byte{},
+		},
+		pollOnly: true,
+	}
+	for _, h := range chain.canonical[:height+1] {
+		s.processSubscribedHeaders(h)
+	}
+	return s
+}
+
+func assertCanonicalCache(t *testing.T, s *Service, chain *pollChain) {
+	for _, h := range chain.canonical {
+		exists, info, err := s.blockCache.BlockInfoByHeight(h.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !exists || info.Hash != h.Hash() {
+			t.Errorf("Expected cached block at height %d to be %#x", h.Number, h.Hash())
+		}
+	}
+	head := chain.canonical[len(chain.canonical)-1]
+	if s.latestEth1Data.BlockHeight != head.Number.Uint64() {
+		t.Errorf("Wanted latest block height %d, received %d", head.Number, s.latestEth1Data.BlockHeight)
+	}
+	if common.BytesToHash(s.latestEth1Data.BlockHash) != head.Hash() {
+		t.Errorf("Wanted latest block hash %#x, received %#x", head.Hash(), s.latestEth1Data.BlockHash)
+	}
+}
+
+func TestPollHeaders_FillsGaps(t *testing.T) {
+	chain := &pollChain{canonical: []*gethTypes.Header{{Number: big.NewInt(0)}}}
+	chain.extend(0, 10, 20, 30)
+	s := newPollingService(chain, 3)
+
+	chain.extend(3, 40, 50, 60, 70)
+	if err := s.pollHeaders(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assertCanonicalCache(t, s, chain)
+
+	// Polling again without a new block is a no-op.
+	if err := s.pollHeaders(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assertCanonicalCache(t, s, chain)
+}
+
+func TestPollHeaders_Reorg(t *testing.T) {
+	tests := []struct {
+		name  string
+		times []uint64
+	}{
+		{
+			name:  "longer chain",
+			times: []uint64{61, 71, 81, 91},
+		},
+		{
+			name:  "same height",
+			times: []uint64{61, 71},
+		},
+		{
+			name:  "shorter chain",
+			times: []uint64{61},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &pollChain{canonical: []*gethTypes.Header{{Number: big.NewInt(0)}}}
+			chain.extend(0, 10, 20, 30, 40, 50, 60, 70)
+			s := newPollingService(chain, 7)
+
+			// The new chain forks from block 5.
+			chain.extend(5, tt.times...)
+			if err := s.pollHeaders(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			assertCanonicalCache(t, s, chain)
+			for height := int64(len(chain.canonical)); height <= 7; height++ {
+				exists, _, err := s.blockCache.BlockInfoByHeight(big.NewInt(height))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if exists {
+					t.Errorf("Expected block at height %d of the previous chain to be removed", height)
+				}
+			}
+		})
+	}
+}
+
+func TestPollHeaders_ReorgRemovesOrphanedDeposits(t *testing.T) {
+	chain := &pollChain{canonical: []*gethTypes.Header{{Number: big.NewInt(0)}}}
+	chain.extend(0, 10, 20, 30, 40, 50, 60, 70)
+	s := newPollingService(chain, 7)
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.depositTrie = depositTrie
+	s.depositCache = depositcache.NewDepositCache()
+	s.httpLogger = &goodLogger{}
+	s.chainStartData = &protodb.ChainStartData{Chainstarted: true}
+
+	// Deposits are included in blocks 2, 6 and 7, the last two being orphaned by a reorg from block 5.
+	var wantRoot [32]byte
+	for i, height := range []uint64{2, 6, 7} {
+		deposit := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}}}
+		depositHash, err := ssz.HashTreeRoot(deposit.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.depositTrie.Insert(depositHash[:], i)
+		s.depositCache.InsertDeposit(context.Background(), deposit, height, int64(i), s.depositTrie.Root())
+		s.depositCache.InsertPendingDeposit(context.Background(), deposit, height, int64(i), s.depositTrie.Root())
+		if i == 0 {
+			wantRoot = s.depositTrie.Root()
+		}
+	}
+	s.lastReceivedMerkleIndex = 2
+	s.latestEth1Data.LastRequestedBlock = 7
+
+	chain.extend(5, 61, 71)
+	if err := s.pollHeaders(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n, root := s.depositCache.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(7)); n != 1 || root != wantRoot {
+		t.Errorf("Wanted 1 deposit with root %#x, received %d deposits with root %#x", wantRoot, n, root)
+	}
+	if pending := s.depositCache.PendingContainers(context.Background(), nil); len(pending) != 1 {
+		t.Errorf("Wanted 1 pending deposit, received %d", len(pending))
+	}
+	if s.lastReceivedMerkleIndex != 0 {
+		t.Errorf("Wanted last received merkle index 0, received %d", s.lastReceivedMerkleIndex)
+	}
+	if s.depositTrie.Root() != wantRoot {
+		t.Errorf("Wanted deposit trie root %#x, received %#x", wantRoot, s.depositTrie.Root())
+	}
+}
@@ -15,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -52,7 +53,7 @@ func (s *Service) ProcessETH1Block(ctx context.Context, blkNum *big.Int) error {
 		FromBlock: blkNum,
 		ToBlock:   blkNum,
 	}
-	logs, err := s.httpLogger.FilterLogs(ctx, query)
+	logs, err := s.filterer().FilterLogs(ctx, query)
 	if err != nil {
 		return err
 	}
@@ -182,6 +183,17 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 	} else {
 		s.depositCache.InsertPendingDeposit(ctx, deposit, depositLog.BlockNumber, int64(index), s.depositTrie.Root())
 	}
+	if s.opNotifier != nil {
+		s.opNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.DepositProcessed,
+			Data: &opfeed.DepositProcessedData{
+				Deposit:         deposit,
+				MerkleTreeIndex: index,
+				Eth1BlockNumber: depositLog.BlockNumber,
+				Valid:           validData,
+			},
+		})
+	}
 	if validData {
 		log.WithFields(logrus.Fields{
 			"publicKey":       fmt.Sprintf("%#x", depositData.PublicKey),
@@ -258,7 +270,7 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 	}
 	// To store all blocks.
 	headersMap := make(map[uint64]*gethTypes.Header)
-	rawLogCount, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	rawLogCount, err := s.contractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return err
 	}
@@ -301,7 +313,7 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 			query.ToBlock = s.LatestBlockHeight()
 			end = s.LatestBlockHeight().Uint64()
 		}
-		logs, err := s.httpLogger.FilterLogs(ctx, query)
+		logs, err := s.filterer().FilterLogs(ctx, query)
 		if err != nil {
 			return err
 		}
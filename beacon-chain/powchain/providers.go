@@ -0,0 +1,235 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	activeProviderGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_active_provider",
+		Help: "The index of the eth1 provider in use, 0 being the primary provider",
+	})
+	providerHealthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_provider_healthy",
+		Help: "Whether the eth1 provider of the given index passed its last health check",
+	}, []string{"provider"})
+	providerFailoverCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_provider_failovers",
+		Help: "The number of times the eth1 provider in use was switched",
+	})
+)
+
+// time between two health checks of the eth1 providers.
+var healthCheckPeriod = 30 * time.Second
+
+// time allowed for all the requests of the health check of an eth1 provider.
+var healthCheckTimeout = 10 * time.Second
+
+// maximum age of the latest block of a healthy eth1 provider. The max mining time is 278 sec
+// (block 7208027), analyzed from 2018-09-01 to 2019-02-13.
+var maxLatestBlockAge = 5 * time.Minute
+
+// Endpoint of an eth1 provider.
+type Endpoint struct {
+	// ETH1 is the IPC or WebSocket endpoint used to subscribe to new blocks and logs.
+	ETH1 string
+	// HTTP is the HTTP endpoint used for requests.
+	HTTP string
+}
+
+// healthProber defines the requests made to an eth1 provider to check its health.
+type healthProber interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error)
+}
+
+// provider is an eth1 provider along with the result of its last health check.
+type provider struct {
+	endpoint Endpoint
+	prober   healthProber
+	// healthErr is the reason the last health check failed, nil if it passed.
+	healthErr error
+}
+
+func dialHealthProber(endpoint string) (healthProber, error) {
+	client, err := gethRPC.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(client), nil
+}
+
+// ActiveEndpoint returns the endpoint of the eth1 provider in use.
+func (s *Service) ActiveEndpoint() Endpoint {
+	s.providersLock.RLock()
+	defer s.providersLock.RUnlock()
+	if len(s.providers) == 0 {
+		return Endpoint{}
+	}
+	return s.providers[s.activeProvider].endpoint
+}
+
+// checkHealth probes the health of an eth1 provider, returning its chain ID along with the reason
+// it is unhealthy or nil. A healthy provider is synced, has a recent latest block and is on the
+// given chain, if known.
+func (s *Service) checkHealth(ctx context.Context, p *provider, chainID *big.Int) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	if p.prober == nil {
+		prober, err := s.dialProber(p.endpoint.HTTP)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not dial provider")
+		}
+		p.prober = prober
+	}
+
+	providerChainID, err := p.prober.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain ID")
+	}
+	if chainID != nil && providerChainID.Cmp(chainID) != 0 {
+		return providerChainID, errors.Errorf("provider is on chain %d instead of chain %d", providerChainID, chainID)
+	}
+
+	progress, err := p.prober.SyncProgress(ctx)
+	if err != nil {
+		return providerChainID, errors.Wrap(err, "could not get sync status")
+	}
+	if progress != nil {
+		return providerChainID, errors.Errorf("provider is syncing, at block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+	}
+
+	header, err := p.prober.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return providerChainID, errors.Wrap(err, "could not get latest block")
+	}
+	if age := time.Since(time.Unix(int64(header.Time), 0)); age > maxLatestBlockAge {
+		return providerChainID, errors.Errorf("latest block %d is %v old", header.Number.Uint64(), age.Round(time.Second))
+	}
+	return providerChainID, nil
+}
+
+// checkProviders checks the health of all the eth1 providers concurrently and returns the index of
+// the first healthy provider, which is the preferred provider to use, or -1 if none is healthy.
+// Providers must be on the same chain, which is the chain of the first healthy provider until
+// known. Only one check runs at a time, the lock guarding the results read by Status.
+func (s *Service) checkProviders(ctx context.Context) int {
+	s.providersLock.RLock()
+	chainID := s.eth1ChainID
+	s.providersLock.RUnlock()
+
+	chainIDs := make([]*big.Int, len(s.providers))
+	healthErrs := make([]error, len(s.providers))
+	var wg sync.WaitGroup
+	for i, p := range s.providers {
+		wg.Add(1)
+		go func(i int, p *provider) {
+			defer wg.Done()
+			chainIDs[i], healthErrs[i] = s.checkHealth(ctx, p, chainID)
+		}(i, p)
+	}
+	wg.Wait()
+
+	if chainID == nil {
+		for i, err := range healthErrs {
+			if err == nil {
+				chainID = chainIDs[i]
+				break
+			}
+		}
+		for i, id := range chainIDs {
+			if healthErrs[i] == nil && chainID.Cmp(id) != 0 {
+				healthErrs[i] = errors.Errorf("provider is on chain %d instead of chain %d", id, chainID)
+			}
+		}
+	}
+
+	s.providersLock.Lock()
+	defer s.providersLock.Unlock()
+	s.eth1ChainID = chainID
+	preferred := -1
+	for i, p := range s.providers {
+		err := healthErrs[i]
+		if err != nil && (p.healthErr == nil || p.healthErr.Error() != err.Error()) {
+			log.WithError(err).WithField("endpoint", p.endpoint.HTTP).Warn("Eth1 provider is unhealthy")
+		}
+		p.healthErr = err
+		healthy := 0.0
+		if err == nil {
+			healthy = 1
+			if preferred < 0 {
+				preferred = i
+			}
+		}
+		providerHealthGauge.WithLabelValues(strconv.Itoa(i)).Set(healthy)
+	}
+	return preferred
+}
+
+// failover switches to the preferred healthy eth1 provider found by the last health check, falling
+// back to the primary provider as soon as it is healthy again. Without a healthy provider, it only
+// tries to reconnect a disconnected service to the first reachable provider. It returns true if the
+// service connected to a new provider.
+func (s *Service) failover(preferred int) bool {
+	if preferred < 0 {
+		return !s.connectedETH1 && s.connectToProviders()
+	}
+	if preferred == s.activeProviderIndex() && s.connectedETH1 {
+		return false
+	}
+	endpoint := s.providers[preferred].endpoint
+	if err := s.connectToPowChain(endpoint); err != nil {
+		log.WithError(err).WithField("endpoint", endpoint.ETH1).Error("Could not connect to eth1 provider")
+		return false
+	}
+	previous := s.activeProviderIndex()
+	s.setActiveProvider(preferred)
+	s.connectedETH1 = true
+	if preferred == previous {
+		log.WithField("endpoint", endpoint.ETH1).Info("Reconnected to eth1 provider")
+		return true
+	}
+	providerFailoverCount.Inc()
+	log.WithFields(logrus.Fields{
+		"endpoint":         endpoint.ETH1,
+		"previousEndpoint": s.providers[previous].endpoint.ETH1,
+	}).Warn("Switched eth1 provider")
+	return true
+}
+
+func (s *Service) activeProviderIndex() int {
+	s.providersLock.RLock()
+	defer s.providersLock.RUnlock()
+	return s.activeProvider
+}
+
+func (s *Service) setActiveProvider(i int) {
+	s.providersLock.Lock()
+	defer s.providersLock.Unlock()
+	s.activeProvider = i
+	activeProviderGauge.Set(float64(i))
+}
+
+// activeProviderHealth returns the reason the provider in use failed its last health check, or nil.
+func (s *Service) activeProviderHealth() error {
+	s.providersLock.RLock()
+	defer s.providersLock.RUnlock()
+	if len(s.providers) == 0 {
+		return nil
+	}
+	return s.providers[s.activeProvider].healthErr
+}
@@ -0,0 +1,205 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+type fakeProber struct {
+	chainID   int64
+	syncing   bool
+	blockTime time.Time
+	delay     time.Duration
+	err       error
+}
+
+func (f *fakeProber) ChainID(ctx context.Context) (*big.Int, error) {
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return big.NewInt(f.chainID), nil
+}
+
+func (f *fakeProber) SyncProgress(_ context.Context) (*ethereum.SyncProgress, error) {
+	if f.syncing {
+		return &ethereum.SyncProgress{CurrentBlock: 10, HighestBlock: 20}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeProber) HeaderByNumber(_ context.Context, _ *big.Int) (*gethTypes.Header, error) {
+	return &gethTypes.Header{Number: big.NewInt(20), Time: uint64(f.blockTime.Unix())}, nil
+}
+
+func TestCheckHealth(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		prober  *fakeProber
+		wantErr string
+	}{
+		{
+			name:   "healthy",
+			prober: &fakeProber{chainID: 5, blockTime: now},
+		},
+		{
+			name:    "unreachable",
+			prober:  &fakeProber{err: errors.New("connection refused")},
+			wantErr: "connection refused",
+		},
+		{
+			name:    "other chain",
+			prober:  &fakeProber{chainID: 1, blockTime: now},
+			wantErr: "provider is on chain 1 instead of chain 5",
+		},
+		{
+			name:    "syncing",
+			prober:  &fakeProber{chainID: 5, syncing: true, blockTime: now},
+			wantErr: "provider is syncing",
+		},
+		{
+			name:    "stale",
+			prober:  &fakeProber{chainID: 5, blockTime: now.Add(-maxLatestBlockAge - time.Minute)},
+			wantErr: "latest block 20 is",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			_, err := s.checkHealth(context.Background(), &provider{prober: tt.prober}, big.NewInt(5))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected provider to be healthy, received %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error %q, received %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckProviders_PrefersFirstHealthy(t *testing.T) {
+	now := time.Now()
+	probers := map[string]*fakeProber{
+		"http://primary":    {chainID: 5, syncing: true, blockTime: now},
+		"http://secondary":  {chainID: 5, blockTime: now},
+		"http://additional": {chainID: 5, blockTime: now},
+	}
+	s := &Service{
+		providers: []*provider{
+			{endpoint: Endpoint{ETH1: "ws://primary", HTTP: "http://primary"}},
+			{endpoint: Endpoint{ETH1: "ws://secondary", HTTP: "http://secondary"}},
+			{endpoint: Endpoint{ETH1: "ws://additional", HTTP: "http://additional"}},
+		},
+		dialProber: func(endpoint string) (healthProber, error) {
+			return probers[endpoint], nil
+		},
+		eth1ChainID: big.NewInt(5),
+	}
+	if preferred := s.checkProviders(context.Background()); preferred != 1 {
+		t.Errorf("Wanted provider 1 to be preferred, received %d", preferred)
+	}
+	if s.providers[0].healthErr == nil {
+		t.Error("Expected primary provider to be unhealthy")
+	}
+
+	// Fall back to the primary provider once it is healthy again.
+	probers["http://primary"].syncing = false
+	if preferred := s.checkProviders(context.Background()); preferred != 0 {
+		t.Errorf("Wanted primary provider to be preferred, received %d", preferred)
+	}
+
+	for _, p := range probers {
+		p.err = errors.New("connection refused")
+	}
+	if preferred := s.checkProviders(context.Background()); preferred != -1 {
+		t.Errorf("Wanted no provider to be preferred, received %d", preferred)
+	}
+}
+
+func TestCheckProviders_ProbesConcurrently(t *testing.T) {
+	now := time.Now()
+	delay := 200 * time.Millisecond
+	probers := map[string]*fakeProber{
+		"http://primary":    {chainID: 5, blockTime: now, delay: delay},
+		"http://secondary":  {chainID: 1, blockTime: now, delay: delay},
+		"http://additional": {chainID: 5, blockTime: now, delay: delay},
+	}
+	s := &Service{
+		providers: []*provider{
+			{endpoint: Endpoint{ETH1: "ws://primary", HTTP: "http://primary"}},
+			{endpoint: Endpoint{ETH1: "ws://secondary", HTTP: "http://secondary"}},
+			{endpoint: Endpoint{ETH1: "ws://additional", HTTP: "http://additional"}},
+		},
+		dialProber: func(endpoint string) (healthProber, error) {
+			return probers[endpoint], nil
+		},
+	}
+	start := time.Now()
+	if preferred := s.checkProviders(context.Background()); preferred != 0 {
+		t.Errorf("Wanted primary provider to be preferred, received %d", preferred)
+	}
+	if elapsed := time.Since(start); elapsed >= 2*delay {
+		t.Errorf("Expected providers to be probed concurrently, took %v", elapsed)
+	}
+	// The chain of the first healthy provider is the chain of all the providers.
+	if s.eth1ChainID == nil || s.eth1ChainID.Int64() != 5 {
+		t.Errorf("Wanted chain 5, received %v", s.eth1ChainID)
+	}
+	if err := s.providers[1].healthErr; err == nil || !strings.Contains(err.Error(), "provider is on chain 1 instead of chain 5") {
+		t.Errorf("Expected provider on another chain to be unhealthy, received %v", err)
+	}
+	if s.providers[2].healthErr != nil {
+		t.Errorf("Expected additional provider to be healthy, received %v", s.providers[2].healthErr)
+	}
+}
+
+type fakeCloser struct {
+	closed int
+}
+
+func (f *fakeCloser) Close() {
+	f.closed++
+}
+
+func TestCloseClients_KeepsClientsInUse(t *testing.T) {
+	replaced := &fakeCloser{}
+	inUse := &fakeCloser{}
+	// A client used for several connections, such as an in-process backend, is closed once.
+	closeClients([]interface{}{inUse}, replaced, replaced, inUse, nil)
+	if replaced.closed != 1 {
+		t.Errorf("Expected replaced client to be closed once, closed %d times", replaced.closed)
+	}
+	if inUse.closed != 0 {
+		t.Errorf("Expected client in use not to be closed, closed %d times", inUse.closed)
+	}
+}
+
+func TestStatus_UnhealthyProvider(t *testing.T) {
+	s := &Service{
+		isRunning: true,
+		providers: []*provider{
+			{endpoint: Endpoint{HTTP: "http://primary"}},
+			{endpoint: Endpoint{HTTP: "http://secondary"}, healthErr: errors.New("provider is syncing")},
+		},
+		activeProvider: 1,
+	}
+	want := "eth1 provider http://secondary is unhealthy: provider is syncing"
+	if err := s.Status(); err == nil || err.Error() != want {
+		t.Errorf("Wanted status %q, received %v", want, err)
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -54,6 +56,9 @@ var (
 // time to wait before trying to reconnect with the eth1 node.
 var backOffPeriod = 6 * time.Second
 
+// endpoint reported for an in-process eth1 backend.
+const backendEndpoint = "backend"
+
 // Reader defines a struct that can fetch latest header events from a web3 endpoint.
 type Reader interface {
 	SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error)
@@ -111,6 +116,13 @@ type RPCClient interface {
 	BatchCall(b []gethRPC.BatchElem) error
 }
 
+// Backend defines an in-process ETH1.0 chain used instead of the eth1 providers, such as the
+// simulated chain of local devnets.
+type Backend interface {
+	Client
+	RPCClient
+}
+
 // Service fetches important information about the canonical
 // Ethereum ETH1.0 chain via a web3 endpoint using an ethclient. The Random
 // Beacon Chain requires synchronization with the ETH1.0 chain's current
@@ -122,18 +134,23 @@ type Service struct {
 	cancel                  context.CancelFunc
 	client                  Client
 	headerChan              chan *gethTypes.Header
-	eth1Endpoint            string
-	httpEndpoint            string
+	providers               []*provider
+	activeProvider          int
+	providersLock           sync.RWMutex
+	dialProber              func(endpoint string) (healthProber, error)
+	eth1ChainID             *big.Int
 	depositContractAddress  common.Address
 	stateNotifier           statefeed.Notifier
+	opNotifier              opfeed.Notifier
 	reader                  Reader
 	logger                  bind.ContractFilterer
 	httpLogger              bind.ContractFilterer
 	blockFetcher            RPCBlockFetcher
 	rpcClient               RPCClient
-	blockCache              *blockCache // cache to store block hash/block height.
+	connectionLock          sync.RWMutex // guards the clients of the eth1 provider in use, replaced on failover
+	blockCache              *blockCache  // cache to store block hash/block height.
 	latestEth1Data          *protodb.LatestETH1Data
-	depositContractCaller   *contracts.DepositContractCaller
+	depositContractCaller   *contracts.DepositContractCaller // guarded by connectionLock
 	depositRoot             []byte
 	depositTrie             *trieutil.SparseMerkleTrie
 	chainStartData          *protodb.ChainStartData
@@ -146,26 +163,43 @@ type Service struct {
 	processingLock          sync.RWMutex
 	requestingOldLogs       bool
 	connectedETH1           bool
+	pollOnly                bool
+	backend                 Backend
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	ETH1Endpoint    string
-	HTTPEndPoint    string
-	DepositContract common.Address
-	BeaconDB        db.HeadAccessDatabase
-	DepositCache    *depositcache.DepositCache
-	StateNotifier   statefeed.Notifier
+	ETH1Endpoint      string
+	HTTPEndPoint      string
+	FallbackEndpoints []Endpoint
+	PollOnly          bool    // PollOnly polls the HTTP endpoints for new blocks instead of subscribing to them.
+	Backend           Backend // Backend is used instead of the endpoints when set.
+	DepositContract   common.Address
+	BeaconDB          db.HeadAccessDatabase
+	DepositCache      *depositcache.DepositCache
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
 }
 
 // NewService sets up a new instance with an ethclient when
 // given a web3 endpoint as a string in the config.
 func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error) {
-	if !strings.HasPrefix(config.ETH1Endpoint, "ws") && !strings.HasPrefix(config.ETH1Endpoint, "ipc") {
-		return nil, fmt.Errorf(
-			"powchain service requires either an IPC or WebSocket endpoint, provided %s",
-			config.ETH1Endpoint,
-		)
+	endpoints := append([]Endpoint{{ETH1: config.ETH1Endpoint, HTTP: config.HTTPEndPoint}}, config.FallbackEndpoints...)
+	if config.Backend != nil {
+		endpoints = []Endpoint{{ETH1: backendEndpoint, HTTP: backendEndpoint}}
+	}
+	providers := make([]*provider, len(endpoints))
+	for i, endpoint := range endpoints {
+		if config.PollOnly {
+			// The HTTP endpoint is used for every request, no subscription being made.
+			endpoint.ETH1 = endpoint.HTTP
+		} else if config.Backend == nil && !strings.HasPrefix(endpoint.ETH1, "ws") && !strings.HasPrefix(endpoint.ETH1, "ipc") {
+			return nil, fmt.Errorf(
+				"powchain service requires either an IPC or WebSocket endpoint, provided %s",
+				endpoint.ETH1,
+			)
+		}
+		providers[i] = &provider{endpoint: endpoint}
 	}
 	ctx, cancel := context.WithCancel(ctx)
 	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
@@ -175,11 +209,13 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	}
 
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		headerChan:   make(chan *gethTypes.Header),
-		eth1Endpoint: config.ETH1Endpoint,
-		httpEndpoint: config.HTTPEndPoint,
+		ctx:        ctx,
+		cancel:     cancel,
+		headerChan: make(chan *gethTypes.Header),
+		providers:  providers,
+		dialProber: dialHealthProber,
+		pollOnly:   config.PollOnly,
+		backend:    config.Backend,
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
@@ -189,6 +225,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		blockCache:             newBlockCache(),
 		depositContractAddress: config.DepositContract,
 		stateNotifier:          config.StateNotifier,
+		opNotifier:             config.OperationNotifier,
 		depositTrie:            depositTrie,
 		chainStartData: &protodb.ChainStartData{
 			Eth1Data:           &ethpb.Eth1Data{},
@@ -214,6 +251,18 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			return nil, errors.Wrap(err, "could not initialize caches")
 		}
 	}
+	snapshot, err := config.BeaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve deposit snapshot")
+	}
+	if snapshot != nil {
+		s.depositCache.InsertDepositSnapshot(ctx, snapshot)
+		if eth1Data == nil {
+			if err := s.initFromDepositSnapshot(ctx, snapshot); err != nil {
+				return nil, errors.Wrap(err, "could not initialize from deposit snapshot")
+			}
+		}
+	}
 	return s, nil
 }
 
@@ -269,6 +318,9 @@ func (s *Service) Status() error {
 	if s.runError != nil {
 		return s.runError
 	}
+	if err := s.activeProviderHealth(); err != nil {
+		return errors.Wrapf(err, "eth1 provider %s is unhealthy", s.ActiveEndpoint().HTTP)
+	}
 	// use a 5 minutes timeout for block time, because the max mining time is 278 sec (block 7208027)
 	// (analyzed the time of the block from 2018-09-01 to 2019-02-13)
 	fiveMinutesTimeout := time.Now().Add(-5 * time.Minute)
@@ -308,6 +360,8 @@ func (s *Service) LatestBlockHash() common.Hash {
 
 // Client for interacting with the ETH1.0 chain.
 func (s *Service) Client() Client {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
 	return s.client
 }
 
@@ -316,26 +370,36 @@ func (s *Service) Client() Client {
 func (s *Service) AreAllDepositsProcessed() (bool, error) {
 	s.processingLock.RLock()
 	defer s.processingLock.RUnlock()
-	countByte, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	countByte, err := s.contractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
 	count := bytesutil.FromBytes8(countByte)
-	deposits := s.depositCache.AllDeposits(context.TODO(), nil)
-	if count != uint64(len(deposits)) {
+	// The deposits preceding a deposit snapshot are not in the cache, so the processed deposits are
+	// counted from the last received index.
+	if count != uint64(s.lastReceivedMerkleIndex+1) {
 		return false, nil
 	}
 	return true, nil
 }
 
-func (s *Service) connectToPowChain() error {
-	powClient, httpClient, rpcClient, err := s.dialETH1Nodes()
+func (s *Service) connectToPowChain(endpoint Endpoint) error {
+	if s.backend != nil {
+		depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, s.backend)
+		if err != nil {
+			return errors.Wrap(err, "could not create deposit contract caller")
+		}
+		s.initializeConnection(s.backend, s.backend, s.backend, depositContractCaller)
+		return nil
+	}
+	powClient, httpClient, rpcClient, err := dialETH1Nodes(endpoint)
 	if err != nil {
 		return errors.Wrap(err, "could not dial eth1 nodes")
 	}
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
 	if err != nil {
+		closeClients(nil, powClient, httpClient, rpcClient)
 		return errors.Wrap(err, "could not create deposit contract caller")
 	}
 
@@ -343,14 +407,17 @@ func (s *Service) connectToPowChain() error {
 	return nil
 }
 
-func (s *Service) dialETH1Nodes() (*ethclient.Client, *ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(s.httpEndpoint)
+func dialETH1Nodes(endpoint Endpoint) (*ethclient.Client, *ethclient.Client, *gethRPC.Client, error) {
+	httpRPCClient, err := gethRPC.Dial(endpoint.HTTP)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	httpClient := ethclient.NewClient(httpRPCClient)
+	if endpoint.ETH1 == endpoint.HTTP {
+		return httpClient, httpClient, httpRPCClient, nil
+	}
 
-	rpcClient, err := gethRPC.Dial(s.eth1Endpoint)
+	rpcClient, err := gethRPC.Dial(endpoint.ETH1)
 	if err != nil {
 		httpClient.Close()
 		return nil, nil, nil, err
@@ -360,9 +427,11 @@ func (s *Service) dialETH1Nodes() (*ethclient.Client, *ethclient.Client, *gethRP
 	return powClient, httpClient, httpRPCClient, nil
 }
 
-func (s *Service) initializeConnection(powClient *ethclient.Client,
-	httpClient *ethclient.Client, rpcClient *gethRPC.Client, contractCaller *contracts.DepositContractCaller) {
+func (s *Service) initializeConnection(powClient Client,
+	httpClient Client, rpcClient RPCClient, contractCaller *contracts.DepositContractCaller) {
 
+	s.connectionLock.Lock()
+	previous := []interface{}{s.reader, s.client, s.rpcClient}
 	s.reader = powClient
 	s.logger = powClient
 	s.client = httpClient
@@ -370,32 +439,78 @@ func (s *Service) initializeConnection(powClient *ethclient.Client,
 	s.blockFetcher = httpClient
 	s.depositContractCaller = contractCaller
 	s.rpcClient = rpcClient
+	s.connectionLock.Unlock()
+
+	// Requests still running on the previous clients fail once they are closed. The in-process
+	// backend is never closed by the service.
+	closeClients([]interface{}{powClient, httpClient, rpcClient, s.backend}, previous...)
+}
+
+// closeClients closes the given eth1 clients, except the ones still in use.
+func closeClients(inUse []interface{}, clients ...interface{}) {
+	closed := make(map[interface{}]bool)
+	for _, c := range inUse {
+		closed[c] = true
+	}
+	for _, c := range clients {
+		closer, ok := c.(interface{ Close() })
+		if !ok || closed[c] {
+			continue
+		}
+		closed[c] = true
+		closer.Close()
+	}
+}
+
+// headReader returns the client subscribing to the new heads of the eth1 provider in use.
+func (s *Service) headReader() Reader {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.reader
+}
+
+// fetcher returns the client fetching the blocks of the eth1 provider in use.
+func (s *Service) fetcher() RPCBlockFetcher {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.blockFetcher
+}
+
+// filterer returns the client filtering the logs of the eth1 provider in use.
+func (s *Service) filterer() bind.ContractFilterer {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.httpLogger
+}
+
+// batchClient returns the client making batch requests to the eth1 provider in use.
+func (s *Service) batchClient() RPCClient {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.rpcClient
+}
+
+// contractCaller returns the deposit contract caller of the eth1 provider in use.
+func (s *Service) contractCaller() *contracts.DepositContractCaller {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.depositContractCaller
 }
 
+// waitForConnection connects to the first eth1 provider available, trying them in order until one
+// of them is reachable.
 func (s *Service) waitForConnection() {
-	err := s.connectToPowChain()
-	if err == nil {
-		s.connectedETH1 = true
-		log.WithFields(logrus.Fields{
-			"endpoint": s.eth1Endpoint,
-		}).Info("Connected to eth1 proof-of-work chain")
+	if s.connectToProviders() {
 		return
 	}
-	log.WithError(err).Error("Could not connect to powchain endpoint")
 	ticker := time.NewTicker(backOffPeriod)
 	for {
 		select {
 		case <-ticker.C:
-			err := s.connectToPowChain()
-			if err == nil {
-				s.connectedETH1 = true
-				log.WithFields(logrus.Fields{
-					"endpoint": s.eth1Endpoint,
-				}).Info("Connected to eth1 proof-of-work chain")
+			if s.connectToProviders() {
 				ticker.Stop()
 				return
 			}
-			log.WithError(err).Error("Could not connect to powchain endpoint")
 		case <-s.ctx.Done():
 			ticker.Stop()
 			log.Debug("Received cancelled context,closing existing powchain service")
@@ -404,10 +519,27 @@ func (s *Service) waitForConnection() {
 	}
 }
 
+// connectToProviders connects to the first reachable eth1 provider, returning false if none is.
+func (s *Service) connectToProviders() bool {
+	for i, p := range s.providers {
+		if err := s.connectToPowChain(p.endpoint); err != nil {
+			log.WithError(err).WithField("endpoint", p.endpoint.ETH1).Error("Could not connect to powchain endpoint")
+			continue
+		}
+		s.setActiveProvider(i)
+		s.connectedETH1 = true
+		log.WithFields(logrus.Fields{
+			"endpoint": p.endpoint.ETH1,
+		}).Info("Connected to eth1 proof-of-work chain")
+		return true
+	}
+	return false
+}
+
 // initDataFromContract calls the deposit contract and finds the deposit count
 // and deposit root.
 func (s *Service) initDataFromContract() error {
-	root, err := s.depositContractCaller.GetDepositRoot(&bind.CallOpts{})
+	root, err := s.contractCaller().GetDepositRoot(&bind.CallOpts{})
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve deposit root")
 	}
@@ -453,7 +585,7 @@ func (s *Service) processSubscribedHeaders(header *gethTypes.Header) {
 		"blockHash":   hexutil.Encode(s.latestEth1Data.BlockHash),
 	}).Debug("Latest eth1 chain event")
 
-	if err := s.blockCache.AddBlock(gethTypes.NewBlockWithHeader(header)); err != nil {
+	if err := s.blockCache.ReplaceBlock(gethTypes.NewBlockWithHeader(header)); err != nil {
 		s.runError = err
 		log.Errorf("Unable to add block data to cache %v", err)
 	}
@@ -481,7 +613,7 @@ func (s *Service) batchRequestHeaders(startBlock uint64, endBlock uint64) ([]*ge
 		headers = append(headers, header)
 		errors = append(errors, err)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.batchClient().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -545,14 +677,14 @@ func (s *Service) run(done <-chan struct{}) {
 		return
 	}
 
-	headSub, err := s.reader.SubscribeNewHead(s.ctx, s.headerChan)
+	headSub, err := s.subscribeNewHead()
 	if err != nil {
 		log.Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
 		s.runError = err
 		return
 	}
 
-	header, err := s.blockFetcher.HeaderByNumber(context.Background(), nil)
+	header, err := s.fetcher().HeaderByNumber(context.Background(), nil)
 	if err != nil {
 		log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 		s.runError = err
@@ -569,8 +701,42 @@ func (s *Service) run(done <-chan struct{}) {
 	}
 
 	ticker := time.NewTicker(1 * time.Second)
-	defer headSub.Unsubscribe()
+	defer func() {
+		unsubscribe(headSub)
+	}()
 	defer ticker.Stop()
+	// An in-process backend is always available, its health is not checked.
+	var healthTick <-chan time.Time
+	if s.backend == nil {
+		healthTicker := time.NewTicker(healthCheckPeriod)
+		defer healthTicker.Stop()
+		healthTick = healthTicker.C
+	}
+	// Providers are probed off the main loop, which switches to the preferred provider once the
+	// results of a health check are in.
+	healthResults := make(chan int, 1)
+	checking := false
+	var retryCheck <-chan time.Time
+	checkProviders := func() {
+		if checking {
+			return
+		}
+		checking = true
+		go func() {
+			healthResults <- s.checkProviders(s.ctx)
+		}()
+	}
+	var pollTick <-chan time.Time
+	if s.pollOnly {
+		pollTicker := time.NewTicker(headerPollPeriod)
+		defer pollTicker.Stop()
+		pollTick = pollTicker.C
+	}
+	stateChannel := make(chan *feed.Event, 1)
+	if s.stateNotifier != nil {
+		stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+		defer stateSub.Unsubscribe()
+	}
 
 	for {
 		select {
@@ -580,15 +746,34 @@ func (s *Service) run(done <-chan struct{}) {
 			s.connectedETH1 = false
 			log.Debug("Context closed, exiting goroutine")
 			return
-		case s.runError = <-headSub.Err():
+		case s.runError = <-subscriptionErr(headSub):
 			log.WithError(s.runError).Error("Subscription to new head notifier failed")
 			s.connectedETH1 = false
-			s.waitForConnection()
-			headSub, err = s.reader.SubscribeNewHead(s.ctx, s.headerChan)
-			if err != nil {
-				log.WithError(err).Error("Unable to re-subscribe to incoming ETH1.0 chain headers")
+			headSub = nil
+			checkProviders()
+		case <-healthTick:
+			checkProviders()
+		case <-retryCheck:
+			retryCheck = nil
+			checkProviders()
+		case preferred := <-healthResults:
+			checking = false
+			if s.failover(preferred) {
+				unsubscribe(headSub)
+				headSub, err = s.subscribeNewHead()
+				if err != nil {
+					log.WithError(err).Error("Unable to subscribe to incoming ETH1.0 chain headers of new eth1 provider")
+					s.runError = err
+					return
+				}
+			}
+			if !s.connectedETH1 {
+				retryCheck = time.After(backOffPeriod)
+			}
+		case <-pollTick:
+			if err := s.pollHeaders(s.ctx); err != nil {
+				log.WithError(err).Error("Unable to poll for new ETH1.0 chain headers")
 				s.runError = err
-				return
 			}
 		case header, ok := <-s.headerChan:
 			if ok {
@@ -596,6 +781,35 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 		case <-ticker.C:
 			s.handleDelayTicker()
+		case event := <-stateChannel:
+			if data, ok := event.Data.(*statefeed.FinalizedCheckpointData); ok {
+				if err := s.saveDepositSnapshot(s.ctx, data.Epoch, data.BlockRoot); err != nil {
+					log.WithError(err).Error("Could not save deposit snapshot")
+				}
+			}
 		}
 	}
 }
+
+// subscribeNewHead subscribes to the new heads of the eth1 provider in use. No subscription is made
+// in poll-only mode, where new heads are polled instead.
+func (s *Service) subscribeNewHead() (ethereum.Subscription, error) {
+	if s.pollOnly {
+		return nil, nil
+	}
+	return s.headReader().SubscribeNewHead(s.ctx, s.headerChan)
+}
+
+// subscriptionErr returns the error channel of the subscription, which is nil without a subscription.
+func subscriptionErr(sub ethereum.Subscription) <-chan error {
+	if sub == nil {
+		return nil
+	}
+	return sub.Err()
+}
+
+func unsubscribe(sub ethereum.Subscription) {
+	if sub != nil {
+		sub.Unsubscribe()
+	}
+}
@@ -0,0 +1,198 @@
+// Package simulated defines an in-process ETH1.0 chain with the deposit contract deployed, which
+// the beacon node uses instead of an eth1 node in local devnets.
+package simulated
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "simulated-eth1")
+
+var _ = powchain.Backend(&Chain{})
+var _ = shared.Service(&Chain{})
+
+// gas limit of a deposit transaction.
+const depositGasLimit = 1000000
+
+// seconds between a block of the simulated backend and its parent unless its time is adjusted.
+const defaultBlockSpacing = 10
+
+// Chain is a simulated ETH1.0 chain built on the simulated backend of go-ethereum. A block is mined
+// every block period with the time of the wall clock, and the chain starts with the blocks of the
+// follow distance already mined so that the beacon chain can process its deposits right away.
+type Chain struct {
+	*backends.SimulatedBackend
+	ctx          context.Context
+	cancel       context.CancelFunc
+	contract     *contracts.DepositContract
+	contractAddr common.Address
+	txOpts       *bind.TransactOpts
+	blockPeriod  time.Duration
+	headFeed     event.Feed
+	lock         sync.Mutex
+}
+
+// NewChain deploys the deposit contract on a new simulated chain and mines the blocks of the
+// follow distance, the deposits of the given deposit data being included in the first block.
+func NewChain(ctx context.Context, deposits []*ethpb.Deposit_Data) (*Chain, error) {
+	account, err := contracts.Setup()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deploy deposit contract")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c := &Chain{
+		SimulatedBackend: account.Backend,
+		ctx:              ctx,
+		cancel:           cancel,
+		contract:         account.Contract,
+		contractAddr:     account.ContractAddr,
+		txOpts:           account.TxOpts,
+		blockPeriod:      time.Duration(params.BeaconConfig().GoerliBlockTime) * time.Second,
+	}
+
+	for _, data := range deposits {
+		if err := c.Deposit(data); err != nil {
+			return nil, err
+		}
+	}
+	// The blocks of the follow distance lead up to the current time.
+	distance := params.BeaconConfig().Eth1FollowDistance
+	start := uint64(time.Now().Unix()) - distance*params.BeaconConfig().GoerliBlockTime
+	for i := uint64(0); i <= distance; i++ {
+		if err := c.commitAt(start + i*params.BeaconConfig().GoerliBlockTime); err != nil {
+			return nil, err
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"depositContract": c.contractAddr.Hex(),
+		"deposits":        len(deposits),
+	}).Info("Started simulated eth1 chain")
+	return c, nil
+}
+
+// Start mining a block every block period.
+func (c *Chain) Start() {
+	go c.mine()
+}
+
+// Stop mining blocks.
+func (c *Chain) Stop() error {
+	c.cancel()
+	return nil
+}
+
+// Status always returns nil, the simulated chain being in-process.
+func (c *Chain) Status() error {
+	return nil
+}
+
+// ContractAddress returns the address of the deposit contract.
+func (c *Chain) ContractAddress() common.Address {
+	return c.contractAddr
+}
+
+// Deposit sends a deposit of the given data to the deposit contract, from an account funded at the
+// genesis of the simulated chain. The deposit is included in the next block.
+func (c *Chain) Deposit(data *ethpb.Deposit_Data) error {
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return errors.Wrap(err, "could not hash deposit data")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.txOpts.Value = new(big.Int).Mul(new(big.Int).SetUint64(data.Amount), big.NewInt(1e9))
+	c.txOpts.GasLimit = depositGasLimit
+	if _, err := c.contract.Deposit(c.txOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, root); err != nil {
+		return errors.Wrapf(err, "could not send deposit of %#x", data.PublicKey)
+	}
+	return nil
+}
+
+// SubscribeNewHead subscribes to the blocks mined by the simulated chain.
+func (c *Chain) SubscribeNewHead(_ context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return c.headFeed.Subscribe(ch), nil
+}
+
+// BatchCall serves the batched block requests of the powchain service.
+func (c *Chain) BatchCall(b []gethRPC.BatchElem) error {
+	for i := range b {
+		if b[i].Method != "eth_getBlockByNumber" {
+			b[i].Error = errors.Errorf("method %s is not supported by the simulated chain", b[i].Method)
+			continue
+		}
+		number, err := hexutil.DecodeBig(b[i].Args[0].(string))
+		if err != nil {
+			b[i].Error = err
+			continue
+		}
+		header, err := c.HeaderByNumber(c.ctx, number)
+		if err != nil {
+			b[i].Error = err
+			continue
+		}
+		*b[i].Result.(*gethTypes.Header) = *header
+	}
+	return nil
+}
+
+func (c *Chain) mine() {
+	ticker := time.NewTicker(c.blockPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := c.commitAt(uint64(now.Unix())); err != nil {
+				log.WithError(err).Error("Could not mine block")
+				continue
+			}
+			header, err := c.HeaderByNumber(c.ctx, nil)
+			if err != nil {
+				log.WithError(err).Error("Could not get mined block")
+				continue
+			}
+			c.headFeed.Send(header)
+		}
+	}
+}
+
+// commitAt mines the pending block with the given time, or one second after its parent if the
+// time is not after the parent.
+func (c *Chain) commitAt(blockTime uint64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	parent, err := c.HeaderByNumber(c.ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not get latest block")
+	}
+	if blockTime <= parent.Time {
+		blockTime = parent.Time + 1
+	}
+	adjustment := time.Duration(int64(blockTime)-int64(parent.Time+defaultBlockSpacing)) * time.Second
+	if err := c.AdjustTime(adjustment); err != nil {
+		return errors.Wrap(err, "could not adjust block time")
+	}
+	c.Commit()
+	return nil
+}
@@ -0,0 +1,101 @@
+package simulated
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestNewChain_MinesFollowDistanceWithDeposits(t *testing.T) {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposits, _, err := interop.DepositDataFromKeys(privKeys, pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c, err := NewChain(ctx, deposits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+
+	head, err := c.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.Number.Uint64() <= params.BeaconConfig().Eth1FollowDistance {
+		t.Errorf("Wanted more than %d blocks, received %d", params.BeaconConfig().Eth1FollowDistance, head.Number)
+	}
+	if age := time.Since(time.Unix(int64(head.Time), 0)); age > time.Minute || age < -time.Minute {
+		t.Errorf("Latest block is %v old, wanted the current time", age)
+	}
+
+	logs, err := c.FilterLogs(ctx, ethereum.FilterQuery{Addresses: []common.Address{c.ContractAddress()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != len(deposits) {
+		t.Fatalf("Wanted %d deposit logs, received %d", len(deposits), len(logs))
+	}
+	for i, l := range logs {
+		pubkey, _, _, _, _, err := contracts.UnpackDepositLogData(l.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if common.Bytes2Hex(pubkey) != common.Bytes2Hex(deposits[i].PublicKey) {
+			t.Errorf("Wrong public key in deposit log %d", i)
+		}
+	}
+}
+
+func TestChain_BatchCall(t *testing.T) {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	ctx := context.Background()
+	c, err := NewChain(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Stop()
+
+	var elems []gethRPC.BatchElem
+	for i := int64(1); i <= 3; i++ {
+		elems = append(elems, gethRPC.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeBig(big.NewInt(i)), true},
+			Result: &gethTypes.Header{},
+		})
+	}
+	if err := c.BatchCall(elems); err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range elems {
+		if e.Error != nil {
+			t.Fatal(e.Error)
+		}
+		want, err := c.HeaderByNumber(ctx, big.NewInt(int64(i+1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Result.(*gethTypes.Header).Hash() != want.Hash() {
+			t.Errorf("Wrong header for block %d", i+1)
+		}
+	}
+}
@@ -130,3 +130,49 @@ func (r *RPCClient) BatchCall(b []rpc.BatchElem) error {
 	}
 	return nil
 }
+
+// BlockFetcher defines a mock eth1 block fetcher serving a chain of empty blocks, the block of each
+// height having the time at the same index of Times. The number of requests is recorded.
+type BlockFetcher struct {
+	Times    []uint64
+	Requests int
+}
+
+// HeaderByNumber --
+func (f *BlockFetcher) HeaderByNumber(_ context.Context, number *big.Int) (*gethTypes.Header, error) {
+	f.Requests++
+	if number == nil {
+		number = big.NewInt(int64(len(f.Times) - 1))
+	}
+	if !number.IsUint64() || number.Uint64() >= uint64(len(f.Times)) {
+		return nil, fmt.Errorf("no block at height %v", number)
+	}
+	return &gethTypes.Header{
+		Number: number,
+		Time:   f.Times[number.Uint64()],
+	}, nil
+}
+
+// BlockByNumber --
+func (f *BlockFetcher) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	header, err := f.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return gethTypes.NewBlockWithHeader(header), nil
+}
+
+// BlockByHash --
+func (f *BlockFetcher) BlockByHash(_ context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	f.Requests++
+	for i, t := range f.Times {
+		block := gethTypes.NewBlockWithHeader(&gethTypes.Header{
+			Number: big.NewInt(int64(i)),
+			Time:   t,
+		})
+		if block.Hash() == hash {
+			return block, nil
+		}
+	}
+	return nil, fmt.Errorf("no block with hash %#x", hash)
+}
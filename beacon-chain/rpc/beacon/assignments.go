@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/dutycache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -19,6 +20,8 @@ import (
 
 // ListValidatorAssignments retrieves the validator assignments for a given epoch,
 // optional validator indices or public keys may be included to filter validator assignments.
+// The assignments of the current epoch of a historical state are retrieved when the state is
+// requested in the headers of the call.
 func (bs *Server) ListValidatorAssignments(
 	ctx context.Context, req *ethpb.ListValidatorAssignmentsRequest,
 ) (*ethpb.ValidatorAssignments, error) {
@@ -36,6 +39,16 @@ func (bs *Server) ListValidatorAssignments(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		if req.QueryFilter != nil {
+			return nil, status.Error(codes.InvalidArgument, "Cannot request an epoch along with a historical state")
+		}
+		headState = queriedState
+	}
 	filtered := map[uint64]bool{} // track filtered validators to prevent duplication in the response.
 	filteredIndices := make([]uint64, 0)
 	requestedEpoch := helpers.CurrentEpoch(headState)
@@ -99,7 +112,8 @@ func (bs *Server) ListValidatorAssignments(
 		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
 	}
 
-	shouldFetchFromArchive := requestedEpoch < bs.FinalizationFetcher.FinalizedCheckpt().Epoch
+	// Assignments of a queried state are computed from the state itself.
+	shouldFetchFromArchive := queriedState == nil && requestedEpoch < bs.FinalizationFetcher.FinalizedCheckpt().Epoch
 
 	// initialize all committee related data.
 	committeeAssignments := map[uint64]*helpers.CommitteeAssignmentContainer{}
@@ -107,6 +121,10 @@ func (bs *Server) ListValidatorAssignments(
 	archivedInfo := &pb.ArchivedCommitteeInfo{}
 	archivedBalances := []uint64{}
 	archivedAssignments := make(map[uint64]*ethpb.ValidatorAssignments_CommitteeAssignment)
+	var cachedDuties *dutycache.EpochDuties
+	if queriedState == nil {
+		cachedDuties = bs.DutiesCache.Duties(requestedEpoch)
+	}
 
 	if shouldFetchFromArchive {
 		archivedInfo, archivedBalances, err = bs.archivedCommitteeData(ctx, requestedEpoch)
@@ -122,6 +140,8 @@ func (bs *Server) ListValidatorAssignments(
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not retrieve archived assignment for epoch %d: %v", requestedEpoch, err)
 		}
+	} else if cachedDuties != nil {
+		committeeAssignments, proposerIndexToSlot = cachedDuties.Committees, cachedDuties.ProposerSlots
 	} else {
 		committeeAssignments, proposerIndexToSlot, err = helpers.CommitteeAssignments(headState, requestedEpoch)
 		if err != nil {
@@ -0,0 +1,43 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListBlocksWithArrivals retrieves blocks by root, slot, or epoch as ListBlocks does, along with
+// the arrival delays of the blocks recorded by this node. Blocks without a recorded arrival delay,
+// such as the ones from initial sync, have no arrival set.
+func (bs *Server) ListBlocksWithArrivals(
+	ctx context.Context, req *ethpb.ListBlocksRequest,
+) (*pb.ListBlocksWithArrivalsResponse, error) {
+	res, err := bs.ListBlocks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	containers := make([]*pb.BlockContainer, len(res.BlockContainers))
+	for i, c := range res.BlockContainers {
+		containers[i] = &pb.BlockContainer{
+			Block:     c.Block,
+			BlockRoot: c.BlockRoot,
+		}
+		delay, ok, err := bs.BeaconDB.BlockArrivalDelay(ctx, bytesutil.ToBytes32(c.BlockRoot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block arrival delay: %v", err)
+		}
+		if ok {
+			containers[i].Arrival = &pb.BlockArrival{DelayMs: int64(delay / time.Millisecond)}
+		}
+	}
+	return &pb.ListBlocksWithArrivalsResponse{
+		BlockContainers: containers,
+		NextPageToken:   res.NextPageToken,
+		TotalSize:       res.TotalSize,
+	}, nil
+}
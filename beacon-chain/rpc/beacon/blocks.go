@@ -184,7 +184,7 @@ func (bs *Server) StreamChainHead(_ *ptypes.Empty, stream ethpb.BeaconChain_Stre
 	for {
 		select {
 		case event := <-stateChannel:
-			if event.Type == statefeed.BlockProcessed {
+			if event.Type == statefeed.HeadChanged {
 				res, err := bs.chainHeadRetrieval(bs.Ctx)
 				if err != nil {
 					return status.Errorf(codes.Internal, "Could not retrieve chain head: %v", err)
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
@@ -141,6 +142,48 @@ func TestServer_ListBlocks_Genesis(t *testing.T) {
 	}
 }
 
+func TestServer_ListBlocksWithArrivals(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	ctx := context.Background()
+	bs := &Server{
+		BeaconDB: db,
+	}
+
+	timely := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5, ParentRoot: []byte{'A'}}}
+	synced := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5, ParentRoot: []byte{'B'}}}
+	if err := db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{timely, synced}); err != nil {
+		t.Fatal(err)
+	}
+	timelyRoot, err := ssz.HashTreeRoot(timely.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlockArrivalDelay(ctx, timelyRoot, 1500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := bs.ListBlocksWithArrivals(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.BlockContainers) != 2 {
+		t.Fatalf("Wanted 2 blocks, received %d", len(res.BlockContainers))
+	}
+	for _, c := range res.BlockContainers {
+		if bytes.Equal(c.BlockRoot, timelyRoot[:]) {
+			if c.Arrival == nil || c.Arrival.DelayMs != 1500 {
+				t.Errorf("Wanted arrival delay of 1500ms, received %v", c.Arrival)
+			}
+		} else if c.Arrival != nil {
+			t.Errorf("Wanted no arrival for a block without a recorded delay, received %v", c.Arrival)
+		}
+	}
+}
+
 func TestServer_ListBlocks_Pagination(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	defer dbTest.TeardownDB(t, db)
@@ -511,8 +554,8 @@ func TestServer_StreamChainHead_OnHeadUpdated(t *testing.T) {
 	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
 	for sent := 0; sent == 0; {
 		sent = server.StateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.BlockProcessed,
-			Data: &statefeed.BlockProcessedData{},
+			Type: statefeed.HeadChanged,
+			Data: &statefeed.HeadChangedData{},
 		})
 	}
 	<-exitRoutine
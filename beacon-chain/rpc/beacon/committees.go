@@ -5,6 +5,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
@@ -15,10 +16,22 @@ import (
 //
 // If no filter criteria is specified, the response returns
 // all beacon committees for the current epoch. The results are paginated by default.
+// The committees of the epoch of the state at any past slot, block root or state root are
+// retrieved when requested in the headers of the call.
 func (bs *Server) ListBeaconCommittees(
 	ctx context.Context,
 	req *ethpb.ListCommitteesRequest,
 ) (*ethpb.BeaconCommittees, error) {
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		if req.QueryFilter != nil {
+			return nil, status.Error(codes.InvalidArgument, "Cannot request an epoch along with a historical state")
+		}
+		return committeesFromState(queriedState)
+	}
 
 	var requestingGenesis bool
 	var startSlot uint64
@@ -37,7 +50,6 @@ func (bs *Server) ListBeaconCommittees(
 
 	var attesterSeed [32]byte
 	var activeIndices []uint64
-	var err error
 	// This is the archival condition, if the requested epoch is < previous epoch.
 	headEpoch := helpers.SlotToEpoch(headSlot)
 	// Adding 1 here to prevent underflow on headEpoch.
@@ -99,6 +111,25 @@ func (bs *Server) ListBeaconCommittees(
 		)
 	}
 
+	return beaconCommittees(startSlot, activeIndices, attesterSeed)
+}
+
+// committeesFromState computes the beacon committees of the current epoch of a state.
+func committeesFromState(st *pbp2p.BeaconState) (*ethpb.BeaconCommittees, error) {
+	epoch := helpers.CurrentEpoch(st)
+	activeIndices, err := helpers.ActiveValidatorIndices(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve active indices for epoch %d: %v", epoch, err)
+	}
+	attesterSeed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve attester seed for epoch %d: %v", epoch, err)
+	}
+	return beaconCommittees(helpers.StartSlot(epoch), activeIndices, attesterSeed)
+}
+
+// beaconCommittees computes the committees of the epoch starting at the given slot.
+func beaconCommittees(startSlot uint64, activeIndices []uint64, attesterSeed [32]byte) (*ethpb.BeaconCommittees, error) {
 	committeesList := make(map[uint64]*ethpb.BeaconCommittees_CommitteesList)
 	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
 		var countAtSlot = uint64(len(activeIndices)) / params.BeaconConfig().SlotsPerEpoch / params.BeaconConfig().TargetCommitteeSize
@@ -0,0 +1,95 @@
+package beacon
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// depositSignatureCacheSize is the number of deposits whose signature validity is cached.
+const depositSignatureCacheSize = 1 << 16
+
+// DepositInfoCache keeps the data the deposit RPCs derive from the deposits, which would otherwise
+// be recomputed for every request: the deposit trie of the received deposits, the validity of the
+// deposit signatures and the inclusion slots of the deposits included by finalized blocks. A nil
+// cache is valid and never contains anything.
+type DepositInfoCache struct {
+	lock    sync.Mutex
+	trie    *trieutil.SparseMerkleTrie
+	trieKey depositTrieKey
+	// signatures maps the hash tree root of deposit data to the validity of its signature.
+	signatures *lru.Cache
+	// inclusionSlots holds the slots of the finalized blocks which included the deposits, by
+	// deposit index, from the first deposit. It is only ever replaced by a longer slice.
+	inclusionSlots []uint64
+}
+
+// depositTrieKey identifies the deposits a deposit trie was built from. Deposits are appended in
+// order and each one carries the deposit root of the contract after it.
+type depositTrieKey struct {
+	count     int
+	lastIndex int64
+	lastRoot  [32]byte
+}
+
+// NewDepositInfoCache creates an empty deposit info cache.
+func NewDepositInfoCache() *DepositInfoCache {
+	signatures, err := lru.New(depositSignatureCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &DepositInfoCache{
+		signatures: signatures,
+	}
+}
+
+// newDepositTrieKey returns the key of the deposit trie built from all the given deposits.
+func newDepositTrieKey(ctrs []*dbpb.DepositContainer) depositTrieKey {
+	if len(ctrs) == 0 {
+		return depositTrieKey{}
+	}
+	last := ctrs[len(ctrs)-1]
+	return depositTrieKey{
+		count:     len(ctrs),
+		lastIndex: last.Index,
+		lastRoot:  bytesutil.ToBytes32(last.DepositRoot),
+	}
+}
+
+// depositTrie returns the cached deposit trie of the given deposits, or builds and caches it.
+func (c *DepositInfoCache) depositTrie(
+	key depositTrieKey,
+	build func() (*trieutil.SparseMerkleTrie, error),
+) (*trieutil.SparseMerkleTrie, error) {
+	if c == nil {
+		return build()
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.trie != nil && c.trieKey == key {
+		return c.trie, nil
+	}
+	trie, err := build()
+	if err != nil {
+		return nil, err
+	}
+	c.trie, c.trieKey = trie, key
+	return trie, nil
+}
+
+// signatureValid returns the cached validity of the signature of the deposit data with the given
+// hash tree root, or verifies and caches it.
+func (c *DepositInfoCache) signatureValid(root [32]byte, verify func() bool) bool {
+	if c == nil {
+		return verify()
+	}
+	if valid, ok := c.signatures.Get(root); ok {
+		return valid.(bool)
+	}
+	valid := verify()
+	c.signatures.Add(root, valid)
+	return valid
+}
@@ -0,0 +1,319 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strconv"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListDeposits retrieves the deposits received by the beacon node from the deposit contract, with
+// their merkle proofs against the deposit root of all received deposits and their status on the
+// canonical chain.
+func (bs *Server) ListDeposits(ctx context.Context, req *pb.ListDepositsRequest) (*pb.DepositsResponse, error) {
+	if int(req.PageSize) > flags.Get().MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, flags.Get().MaxPageSize)
+	}
+
+	allCtrs := bs.DepositFetcher.AllDepositContainers(ctx)
+	ctrs := allCtrs
+	if len(req.PublicKey) > 0 {
+		filtered := make([]*dbpb.DepositContainer, 0)
+		for _, ctr := range allCtrs {
+			if bytes.Equal(ctr.Deposit.Data.PublicKey, req.PublicKey) {
+				filtered = append(filtered, ctr)
+			}
+		}
+		ctrs = filtered
+	}
+
+	// If there are no deposits, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 deposits below would result in an error.
+	if len(ctrs) == 0 {
+		return &pb.DepositsResponse{
+			Deposits:      make([]*pb.DepositInfo, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(ctrs))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	depositTrie, err := bs.depositTrie(ctx, allCtrs)
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := bs.depositInfos(ctx, depositTrie, ctrs[start:end])
+	if err != nil {
+		return nil, err
+	}
+	root := depositTrie.Root()
+	return &pb.DepositsResponse{
+		Deposits:      deposits,
+		DepositRoot:   root[:],
+		DepositCount:  uint64(len(depositTrie.Items())),
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(ctrs)),
+	}, nil
+}
+
+// GetDeposit retrieves a deposit received by the beacon node by merkle tree index, or the latest
+// deposit of a validator public key, with its status on the canonical chain.
+func (bs *Server) GetDeposit(ctx context.Context, req *pb.GetDepositRequest) (*pb.DepositInfo, error) {
+	ctrs := bs.DepositFetcher.AllDepositContainers(ctx)
+	var ctr *dbpb.DepositContainer
+	switch q := req.QueryFilter.(type) {
+	case *pb.GetDepositRequest_Index:
+		// The deposit containers are sorted by index.
+		i := sort.Search(len(ctrs), func(i int) bool { return ctrs[i].Index >= int64(q.Index) })
+		if i < len(ctrs) && ctrs[i].Index == int64(q.Index) {
+			ctr = ctrs[i]
+		}
+	case *pb.GetDepositRequest_PublicKey:
+		for i := len(ctrs) - 1; i >= 0; i-- {
+			if bytes.Equal(ctrs[i].Deposit.Data.PublicKey, q.PublicKey) {
+				ctr = ctrs[i]
+				break
+			}
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Need to specify a deposit index or public key")
+	}
+	if ctr == nil {
+		return nil, status.Error(codes.NotFound, "Could not find deposit")
+	}
+
+	depositTrie, err := bs.depositTrie(ctx, ctrs)
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := bs.depositInfos(ctx, depositTrie, []*dbpb.DepositContainer{ctr})
+	if err != nil {
+		return nil, err
+	}
+	return deposits[0], nil
+}
+
+// depositTrie returns the deposit trie of all the received deposits, given as deposit containers,
+// which is only rebuilt when new deposits are received.
+func (bs *Server) depositTrie(ctx context.Context, ctrs []*dbpb.DepositContainer) (*trieutil.SparseMerkleTrie, error) {
+	depositTrie, err := bs.DepositInfoCache.depositTrie(newDepositTrieKey(ctrs), func() (*trieutil.SparseMerkleTrie, error) {
+		return bs.DepositFetcher.DepositTrie(ctx, nil)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate deposit trie: %v", err)
+	}
+	return depositTrie, nil
+}
+
+// depositInfos describes the given deposit containers, sorted by index, against the head state.
+// A proof is only returned when the deposit trie holds the deposit, which is not the case for the
+// deposits of a deposit snapshot the trie was rebuilt from.
+func (bs *Server) depositInfos(
+	ctx context.Context,
+	depositTrie *trieutil.SparseMerkleTrie,
+	ctrs []*dbpb.DepositContainer,
+) ([]*pb.DepositInfo, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not get head state")
+	}
+	if headState == nil {
+		// No deposit is processed before the chain starts.
+		headState = &pbp2p.BeaconState{}
+	}
+	indices := make([]uint64, len(ctrs))
+	for i, ctr := range ctrs {
+		indices[i] = uint64(ctr.Index)
+	}
+	inclusionSlots, err := bs.depositInclusionSlots(ctx, headState, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	root := depositTrie.Root()
+	leaves := depositTrie.Items()
+	res := make([]*pb.DepositInfo, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		data := ctr.Deposit.Data
+		index := uint64(ctr.Index)
+		leaf, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash deposit data: %v", err)
+		}
+		info := &pb.DepositInfo{
+			Index:                 index,
+			PublicKey:             data.PublicKey,
+			WithdrawalCredentials: data.WithdrawalCredentials,
+			Amount:                data.Amount,
+			SignatureValid: bs.DepositInfoCache.signatureValid(leaf, func() bool {
+				return blocks.VerifyDepositSignature(data) == nil
+			}),
+			Eth1BlockNumber: ctr.Eth1BlockHeight,
+			DepositRoot:     root[:],
+		}
+		switch {
+		case index < headState.Eth1DepositIndex:
+			info.Status = pb.DepositInfo_INCLUDED
+			info.InclusionSlot = inclusionSlots[index]
+		case headState.Eth1Data != nil && index < headState.Eth1Data.DepositCount:
+			info.Status = pb.DepositInfo_PENDING_INCLUSION
+		default:
+			info.Status = pb.DepositInfo_PENDING_ETH1_DATA
+		}
+
+		if index < uint64(len(leaves)) && bytes.Equal(leaves[index], leaf[:]) {
+			info.Proof, err = depositTrie.MerkleProof(int(index))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute deposit proof: %v", err)
+			}
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
+// depositInclusionSlots returns the slots of the canonical blocks which included the deposits with
+// the given sorted indices, for the deposits processed by the head state. Blocks include deposits in
+// order, so the canonical blocks are walked back from the head block, each block including the
+// deposits right below the number of deposits processed after it, until the lowest index is reached.
+// The inclusion slots of the deposits included by finalized blocks are cached, so that the walk is
+// bounded by the blocks since the finalized checkpoint once the finalized deposits are cached.
+func (bs *Server) depositInclusionSlots(
+	ctx context.Context,
+	headState *pbp2p.BeaconState,
+	indices []uint64,
+) (map[uint64]uint64, error) {
+	slots := make(map[uint64]uint64)
+	i := sort.Search(len(indices), func(i int) bool { return indices[i] >= headState.Eth1DepositIndex }) - 1
+	if i < 0 {
+		return slots, nil
+	}
+	var finalizedSlot uint64
+	if cp := bs.FinalizationFetcher.FinalizedCheckpt(); cp != nil {
+		finalizedSlot = helpers.StartSlot(cp.Epoch)
+	}
+
+	// The genesis block is always finalized, so the walk ends at a finalized block unless all the
+	// deposits were found before.
+	var finalizedBlk *ethpb.SignedBeaconBlock
+	var finalizedProcessed uint64
+	err := bs.walkDepositInclusions(ctx, bs.HeadFetcher.HeadBlock(), headState.Eth1DepositIndex,
+		func(blk *ethpb.SignedBeaconBlock, start uint64, end uint64) bool {
+			if blk.Block.Slot <= finalizedSlot {
+				finalizedBlk, finalizedProcessed = blk, end
+				return false
+			}
+			for ; i >= 0 && indices[i] >= start; i-- {
+				slots[indices[i]] = blk.Block.Slot
+			}
+			return i >= 0
+		})
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 {
+		return slots, nil
+	}
+	finalizedSlots, err := bs.finalizedInclusionSlots(ctx, finalizedBlk, finalizedProcessed)
+	if err != nil {
+		return nil, err
+	}
+	for ; i >= 0; i-- {
+		slots[indices[i]] = finalizedSlots[indices[i]]
+	}
+	return slots, nil
+}
+
+// finalizedInclusionSlots returns the inclusion slots of the deposits below the given number of
+// deposits processed by the given finalized block, by deposit index. Only the deposits which are
+// not cached yet are looked up, by walking the canonical blocks back from the finalized block.
+func (bs *Server) finalizedInclusionSlots(
+	ctx context.Context,
+	finalizedBlk *ethpb.SignedBeaconBlock,
+	processed uint64,
+) ([]uint64, error) {
+	c := bs.DepositInfoCache
+	var known []uint64
+	if c != nil {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		known = c.inclusionSlots
+	}
+	if uint64(len(known)) >= processed {
+		return known[:processed], nil
+	}
+	slots := make([]uint64, processed)
+	copy(slots, known)
+	err := bs.walkDepositInclusions(ctx, finalizedBlk, processed, func(blk *ethpb.SignedBeaconBlock, start uint64, end uint64) bool {
+		for j := start; j < end; j++ {
+			if j >= uint64(len(known)) {
+				slots[j] = blk.Block.Slot
+			}
+		}
+		return start > uint64(len(known))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		c.inclusionSlots = slots
+	}
+	return slots, nil
+}
+
+// walkDepositInclusions walks the canonical blocks back from the given block, after which the
+// given number of deposits were processed, calling fn with each block and the range of the indices
+// of the deposits it included, until fn returns false. The deposits of the genesis state are
+// included by the genesis block.
+func (bs *Server) walkDepositInclusions(
+	ctx context.Context,
+	blk *ethpb.SignedBeaconBlock,
+	processed uint64,
+	fn func(blk *ethpb.SignedBeaconBlock, start uint64, end uint64) bool,
+) error {
+	for {
+		if ctx.Err() != nil {
+			return status.Errorf(codes.Canceled, "Could not find deposit inclusion slots: %v", ctx.Err())
+		}
+		if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+			return status.Errorf(codes.Internal, "Could not find canonical block including deposit %d", processed-1)
+		}
+		included := uint64(len(blk.Block.Body.Deposits))
+		if blk.Block.Slot == 0 {
+			included = processed
+		}
+		if included > processed {
+			return status.Errorf(codes.Internal, "Block at slot %d includes more deposits than processed", blk.Block.Slot)
+		}
+		if !fn(blk, processed-included, processed) {
+			return nil
+		}
+		if blk.Block.Slot == 0 {
+			return status.Error(codes.Internal, "Could not find deposit inclusion slots before genesis")
+		}
+		processed -= included
+		parent, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot))
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		blk = parent
+	}
+}
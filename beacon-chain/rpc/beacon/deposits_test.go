@@ -0,0 +1,265 @@
+package beacon
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// setupDepositChain caches 8 deposits, the last one with an invalid signature, and saves a chain
+// of a block per slot up to slot 4 whose states processed 2, 2, 5, 5 and 6 deposits, the first 2
+// being the deposits of the genesis state. The eth1 data of the head state covers 7 deposits.
+func setupDepositChain(t *testing.T, beaconDB db.Database) (*Server, []*ethpb.Deposit) {
+	ctx := context.Background()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := proto.Clone(deposits[7]).(*ethpb.Deposit)
+	invalid.Data.Signature = deposits[6].Data.Signature
+	deposits = append(deposits[:7:7], invalid)
+
+	depositCache := depositcache.NewDepositCache()
+	for i, dep := range deposits {
+		depositCache.InsertDeposit(ctx, dep, uint64(100+i), int64(i), [32]byte{})
+	}
+
+	processed := []uint64{2, 2, 5, 5, 6}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = make([]byte, 32)
+	}
+	var parentRoot [32]byte
+	var headState *pbp2p.BeaconState
+	var headBlock *ethpb.SignedBeaconBlock
+	for slot, count := range processed {
+		body := &ethpb.BeaconBlockBody{}
+		if slot > 0 {
+			body.Deposits = deposits[processed[slot-1]:count]
+		}
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: uint64(slot), ParentRoot: parentRoot[:], Body: body}}
+		if err := beaconDB.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		headState = &pbp2p.BeaconState{
+			Slot:             uint64(slot),
+			Eth1DepositIndex: count,
+			Eth1Data:         &ethpb.Eth1Data{DepositCount: 7},
+			BlockRoots:       blockRoots,
+		}
+		if err := beaconDB.SaveState(ctx, headState, root); err != nil {
+			t.Fatal(err)
+		}
+		blockRoots[slot] = root[:]
+		parentRoot = root
+		headBlock = b
+	}
+
+	bs := &Server{
+		BeaconDB:            beaconDB,
+		DepositFetcher:      depositCache,
+		HeadFetcher:         &mock.ChainService{State: headState, Block: headBlock},
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 0}},
+		StateGen:            stategen.New(&stategen.Config{BeaconDB: beaconDB}),
+		DepositInfoCache:    NewDepositInfoCache(),
+	}
+	return bs, deposits
+}
+
+func TestServer_ListDeposits(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, deposits := setupDepositChain(t, beaconDB)
+	res, err := bs.ListDeposits(context.Background(), &pb.ListDepositsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 8 || len(res.Deposits) != 8 || res.DepositCount != 8 {
+		t.Fatalf("Wanted 8 deposits, received %d of %d in a trie of %d", len(res.Deposits), res.TotalSize, res.DepositCount)
+	}
+
+	wantSlots := []uint64{0, 0, 2, 2, 2, 4}
+	for i, d := range res.Deposits {
+		if d.Index != uint64(i) || d.Eth1BlockNumber != uint64(100+i) {
+			t.Errorf("Wrong index %d or eth1 block %d for deposit %d", d.Index, d.Eth1BlockNumber, i)
+		}
+		if !proto.Equal(&ethpb.Deposit_Data{
+			PublicKey:             d.PublicKey,
+			WithdrawalCredentials: d.WithdrawalCredentials,
+			Amount:                d.Amount,
+			Signature:             deposits[i].Data.Signature,
+		}, deposits[i].Data) {
+			t.Errorf("Wrong data for deposit %d", i)
+		}
+		if d.SignatureValid != (i != 7) {
+			t.Errorf("Wanted signature validity %v for deposit %d", i != 7, i)
+		}
+
+		switch {
+		case i < len(wantSlots):
+			if d.Status != pb.DepositInfo_INCLUDED || d.InclusionSlot != wantSlots[i] {
+				t.Errorf("Wanted deposit %d included at slot %d, received %v at slot %d", i, wantSlots[i], d.Status, d.InclusionSlot)
+			}
+		case i == 6:
+			if d.Status != pb.DepositInfo_PENDING_INCLUSION {
+				t.Errorf("Wanted deposit %d pending inclusion, received %v", i, d.Status)
+			}
+		default:
+			if d.Status != pb.DepositInfo_PENDING_ETH1_DATA {
+				t.Errorf("Wanted deposit %d pending eth1 data, received %v", i, d.Status)
+			}
+		}
+
+		leaf, err := ssz.HashTreeRoot(deposits[i].Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !trieutil.VerifyMerkleProof(res.DepositRoot, leaf[:], i, d.Proof) {
+			t.Errorf("Proof of deposit %d did not verify against deposit root %#x", i, res.DepositRoot)
+		}
+	}
+}
+
+func TestServer_ListDeposits_FilterAndPaginate(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, deposits := setupDepositChain(t, beaconDB)
+	ctx := context.Background()
+	res, err := bs.ListDeposits(ctx, &pb.ListDepositsRequest{PublicKey: deposits[3].Data.PublicKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 1 || res.Deposits[0].Index != 3 || res.Deposits[0].InclusionSlot != 2 {
+		t.Errorf("Wanted deposit 3 included at slot 2, received %v", res.Deposits)
+	}
+
+	res, err = bs.ListDeposits(ctx, &pb.ListDepositsRequest{PageSize: 3, PageToken: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Deposits) != 3 || res.Deposits[0].Index != 3 || res.NextPageToken != "2" {
+		t.Fatalf("Wanted deposits 3 to 5, received %v with next page token %q", res.Deposits, res.NextPageToken)
+	}
+	for i, wantSlot := range []uint64{2, 2, 4} {
+		if res.Deposits[i].InclusionSlot != wantSlot {
+			t.Errorf("Wanted deposit %d included at slot %d, received %d", res.Deposits[i].Index, wantSlot, res.Deposits[i].InclusionSlot)
+		}
+	}
+
+	exceedsMax := int32(flags.Get().MaxPageSize + 1)
+	wanted := "can not be greater than max size"
+	if _, err := bs.ListDeposits(ctx, &pb.ListDepositsRequest{PageSize: exceedsMax}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_ListDeposits_CachesFinalizedDeposits(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, _ := setupDepositChain(t, beaconDB)
+	bs.FinalizationFetcher = &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}}
+	ctx := context.Background()
+	res, err := bs.ListDeposits(ctx, &pb.ListDepositsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSlots := []uint64{0, 0, 2, 2, 2, 4}
+	if !reflect.DeepEqual(bs.DepositInfoCache.inclusionSlots, wantSlots) {
+		t.Errorf("Wanted cached inclusion slots %v, received %v", wantSlots, bs.DepositInfoCache.inclusionSlots)
+	}
+	depositTrie := bs.DepositInfoCache.trie
+
+	// The blocks are no longer needed once the inclusion slots of the finalized deposits are cached.
+	emptyDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, emptyDB)
+	bs.BeaconDB = emptyDB
+	cached, err := bs.ListDeposits(ctx, &pb.ListDepositsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(res, cached) {
+		t.Errorf("Wanted %v, received %v", res, cached)
+	}
+	if bs.DepositInfoCache.trie != depositTrie {
+		t.Error("Expected the deposit trie to be reused")
+	}
+}
+
+func TestServer_GetDeposit(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, deposits := setupDepositChain(t, beaconDB)
+	tests := []struct {
+		name      string
+		req       *pb.GetDepositRequest
+		wantIndex uint64
+		wantErr   string
+	}{
+		{
+			name:      "by index",
+			req:       &pb.GetDepositRequest{QueryFilter: &pb.GetDepositRequest_Index{Index: 4}},
+			wantIndex: 4,
+		},
+		{
+			name:      "by public key",
+			req:       &pb.GetDepositRequest{QueryFilter: &pb.GetDepositRequest_PublicKey{PublicKey: deposits[6].Data.PublicKey}},
+			wantIndex: 6,
+		},
+		{
+			name:    "unknown index",
+			req:     &pb.GetDepositRequest{QueryFilter: &pb.GetDepositRequest_Index{Index: 8}},
+			wantErr: "Could not find deposit",
+		},
+		{
+			name:    "unknown public key",
+			req:     &pb.GetDepositRequest{QueryFilter: &pb.GetDepositRequest_PublicKey{PublicKey: []byte("foo")}},
+			wantErr: "Could not find deposit",
+		},
+		{
+			name:    "no filter",
+			req:     &pb.GetDepositRequest{},
+			wantErr: "Need to specify a deposit index or public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := bs.GetDeposit(context.Background(), tt.req)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error %v, received %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Index != tt.wantIndex {
+				t.Errorf("Wanted deposit %d, received %d", tt.wantIndex, res.Index)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package beacon
+
+import (
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamHeadChanges to clients every time the head block of the chain changes. Head changes
+// caused by a reorg include the common ancestor of the previous and new chains, along with the
+// number of slots rolled back.
+func (bs *Server) StreamHeadChanges(_ *ptypes.Empty, stream pb.Chain_StreamHeadChangesServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	// A reorg event is always followed by the head change event it caused.
+	var reorg *statefeed.ReorgData
+	for {
+		select {
+		case event := <-stateChannel:
+			switch event.Type {
+			case statefeed.Reorg:
+				data, ok := event.Data.(*statefeed.ReorgData)
+				if !ok {
+					continue
+				}
+				reorg = data
+			case statefeed.HeadChanged:
+				data, ok := event.Data.(*statefeed.HeadChangedData)
+				if !ok {
+					continue
+				}
+				res := &pb.HeadChange{
+					Slot:              data.Slot,
+					BlockRoot:         data.BlockRoot[:],
+					PreviousSlot:      data.PreviousSlot,
+					PreviousBlockRoot: data.PreviousBlockRoot[:],
+				}
+				if reorg != nil && reorg.NewBlockRoot == data.BlockRoot && reorg.OldBlockRoot == data.PreviousBlockRoot {
+					res.Reorg = &pb.Reorg{
+						CommonAncestorSlot: reorg.CommonAncestorSlot,
+						CommonAncestorRoot: reorg.CommonAncestorRoot[:],
+						Depth:              reorg.Depth,
+					}
+				}
+				reorg = nil
+				if err := stream.Send(res); err != nil {
+					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+				}
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+type headChangesStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *pb.HeadChange
+}
+
+func (s *headChangesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *headChangesStream) Send(change *pb.HeadChange) error {
+	s.updates <- change
+	return nil
+}
+
+func TestServer_StreamHeadChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		StateNotifier: chainService.StateNotifier(),
+	}
+	stream := &headChangesStream{ctx: ctx, updates: make(chan *pb.HeadChange)}
+
+	go func(tt *testing.T) {
+		if err := server.StreamHeadChanges(&ptypes.Empty{}, stream); err == nil {
+			tt.Error("Expected stream to end with an error")
+		}
+	}(t)
+
+	root := func(b byte) [32]byte { return [32]byte{b} }
+	tests := []struct {
+		name   string
+		events []*feed.Event
+		want   *pb.HeadChange
+	}{
+		{
+			name: "head extended",
+			events: []*feed.Event{
+				{
+					Type: statefeed.HeadChanged,
+					Data: &statefeed.HeadChangedData{Slot: 2, BlockRoot: root(2), PreviousSlot: 1, PreviousBlockRoot: root(1)},
+				},
+			},
+			want: &pb.HeadChange{
+				Slot:              2,
+				BlockRoot:         []byte{2, 31: 0},
+				PreviousSlot:      1,
+				PreviousBlockRoot: []byte{1, 31: 0},
+			},
+		},
+		{
+			name: "reorg",
+			events: []*feed.Event{
+				{
+					Type: statefeed.Reorg,
+					Data: &statefeed.ReorgData{
+						OldSlot:            3,
+						OldBlockRoot:       root(2),
+						NewSlot:            4,
+						NewBlockRoot:       root(4),
+						CommonAncestorSlot: 1,
+						CommonAncestorRoot: root(1),
+						Depth:              2,
+					},
+				},
+				{
+					Type: statefeed.HeadChanged,
+					Data: &statefeed.HeadChangedData{Slot: 4, BlockRoot: root(4), PreviousSlot: 3, PreviousBlockRoot: root(2)},
+				},
+			},
+			want: &pb.HeadChange{
+				Slot:              4,
+				BlockRoot:         []byte{4, 31: 0},
+				PreviousSlot:      3,
+				PreviousBlockRoot: []byte{2, 31: 0},
+				Reorg: &pb.Reorg{
+					CommonAncestorSlot: 1,
+					CommonAncestorRoot: []byte{1, 31: 0},
+					Depth:              2,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, event := range tt.events {
+				// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+				for sent := 0; sent == 0; {
+					sent = server.StateNotifier.StateFeed().Send(event)
+				}
+			}
+			if got := <-stream.updates; !proto.Equal(got, tt.want) {
+				t.Errorf("Wanted %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStateProof retrieves a merkle proof of a field, a validator record or a validator balance of
+// the requested state against its hash tree root. The proof can be verified by light clients with
+// trieutil.VerifyGeneralizedMerkleProof.
+func (bs *Server) GetStateProof(ctx context.Context, req *pb.StateProofRequest) (*pb.StateProof, error) {
+	st, err := bs.proofState(ctx, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	root, err := stateutil.HashTreeRootState(st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	if len(req.StateRoot) > 0 && !bytes.Equal(root[:], req.StateRoot) {
+		return nil, status.Errorf(codes.Internal, "Regenerated state root %#x does not match requested root %#x", root, req.StateRoot)
+	}
+
+	var proof *stateutil.MerkleProof
+	switch req.Type {
+	case pb.StateProofRequest_FIELD:
+		proof, err = stateutil.StateFieldProof(st, req.Field)
+	case pb.StateProofRequest_VALIDATOR:
+		proof, err = stateutil.ValidatorProof(st, req.Index)
+	case pb.StateProofRequest_BALANCE:
+		proof, err = stateutil.BalanceProof(st, req.Index)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown proof type %v", req.Type)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not compute proof: %v", err)
+	}
+	return &pb.StateProof{
+		StateRoot:        root[:],
+		Slot:             st.Slot,
+		Leaf:             proof.Leaf[:],
+		GeneralizedIndex: proof.GeneralizedIndex,
+		Proof:            proof.Branch,
+	}, nil
+}
+
+// proofState returns the canonical state with the given root, or the state selected by the request
+// headers when no root is given, defaulting to the head state.
+func (bs *Server) proofState(ctx context.Context, stateRoot []byte) (*pbp2p.BeaconState, error) {
+	if len(stateRoot) == 0 {
+		st, err := bs.queriedState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if st != nil {
+			return st, nil
+		}
+		st, err = bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Could not get head state")
+		}
+		return st, nil
+	}
+	if len(stateRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid state root length %d, expected 32", len(stateRoot))
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not get head state")
+	}
+	slot, err := bs.stateRootSlot(ctx, headState, bytesutil.ToBytes32(stateRoot))
+	if err != nil {
+		return nil, err
+	}
+	return bs.stateAtSlot(ctx, headState, slot)
+}
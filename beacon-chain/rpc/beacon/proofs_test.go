@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestServer_GetStateProof(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, _, states := setupHistoricalChain(t, beaconDB, []uint64{1, 2, 4, 5})
+	headRoot, err := stateutil.HashTreeRootState(states[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		req      *pb.StateProofRequest
+		wantRoot []byte
+		wantSlot uint64
+		wantErr  string
+	}{
+		{
+			name:     "field",
+			req:      &pb.StateProofRequest{Type: pb.StateProofRequest_FIELD, Field: "finalized_checkpoint"},
+			wantRoot: headRoot[:],
+			wantSlot: 5,
+		},
+		{
+			name:     "validator",
+			req:      &pb.StateProofRequest{Type: pb.StateProofRequest_VALIDATOR, Index: 3},
+			wantRoot: headRoot[:],
+			wantSlot: 5,
+		},
+		{
+			name:     "balance",
+			req:      &pb.StateProofRequest{Type: pb.StateProofRequest_BALANCE, Index: 7},
+			wantRoot: headRoot[:],
+			wantSlot: 5,
+		},
+		{
+			name: "historical state root",
+			req: &pb.StateProofRequest{
+				Type:      pb.StateProofRequest_VALIDATOR,
+				Index:     10,
+				StateRoot: states[3].StateRoots[3],
+			},
+			wantRoot: states[3].StateRoots[3],
+			wantSlot: 3,
+		},
+		{
+			name:    "unknown field",
+			req:     &pb.StateProofRequest{Type: pb.StateProofRequest_FIELD, Field: "foo"},
+			wantErr: "unknown beacon state field",
+		},
+		{
+			name:    "validator out of range",
+			req:     &pb.StateProofRequest{Type: pb.StateProofRequest_VALIDATOR, Index: 64},
+			wantErr: "out of range",
+		},
+		{
+			name:    "unknown state root",
+			req:     &pb.StateProofRequest{Type: pb.StateProofRequest_BALANCE, StateRoot: make([]byte, 32)},
+			wantErr: "Could not find state root",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := bs.GetStateProof(context.Background(), tt.req)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error %v, received %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Slot != tt.wantSlot {
+				t.Errorf("Wanted slot %d, received %d", tt.wantSlot, res.Slot)
+			}
+			if string(res.StateRoot) != string(tt.wantRoot) {
+				t.Errorf("Wanted state root %#x, received %#x", tt.wantRoot, res.StateRoot)
+			}
+			if !trieutil.VerifyGeneralizedMerkleProof(res.StateRoot, res.Leaf, res.GeneralizedIndex, res.Proof) {
+				t.Error("Could not verify state proof")
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package beacon
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListValidatorRewards retrieves the breakdown of the rewards and penalties applied to the balances
+// of validators when processing the end of the requested epoch, as archived by the beacon node.
+func (bs *Server) ListValidatorRewards(
+	ctx context.Context,
+	req *pb.ListValidatorRewardsRequest,
+) (*pb.ValidatorRewardsResponse, error) {
+	if int(req.PageSize) > flags.Get().MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, flags.Get().MaxPageSize)
+	}
+
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not get head state")
+	}
+	if req.Epoch > helpers.CurrentEpoch(headState) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve information about an epoch in the future, current epoch %d, requesting %d",
+			helpers.CurrentEpoch(headState),
+			req.Epoch,
+		)
+	}
+
+	archived, err := bs.BeaconDB.ArchivedValidatorRewards(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validator rewards for epoch %d: %v", req.Epoch, err)
+	}
+	if archived == nil {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Could not retrieve validator rewards for epoch %d, perhaps --archive-validator-rewards in the running beacon node is disabled",
+			req.Epoch,
+		)
+	}
+
+	rewards := archived.Rewards
+	if len(req.Indices) > 0 {
+		requested := make(map[uint64]bool, len(req.Indices))
+		for _, index := range req.Indices {
+			requested[index] = true
+		}
+		rewards = make([]*dbpb.ValidatorRewards, 0, len(req.Indices))
+		for _, r := range archived.Rewards {
+			if requested[r.ValidatorIndex] {
+				rewards = append(rewards, r)
+			}
+		}
+	}
+
+	// If there are no rewards, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 rewards below would result in an error.
+	if len(rewards) == 0 {
+		return &pb.ValidatorRewardsResponse{
+			Epoch:         req.Epoch,
+			Rewards:       make([]*pb.ValidatorRewards, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(rewards))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+
+	res := make([]*pb.ValidatorRewards, 0, end-start)
+	for _, r := range rewards[start:end] {
+		res = append(res, &pb.ValidatorRewards{
+			ValidatorIndex:       r.ValidatorIndex,
+			SourceReward:         r.SourceReward,
+			SourcePenalty:        r.SourcePenalty,
+			TargetReward:         r.TargetReward,
+			TargetPenalty:        r.TargetPenalty,
+			HeadReward:           r.HeadReward,
+			HeadPenalty:          r.HeadPenalty,
+			InclusionDelayReward: r.InclusionDelayReward,
+			ProposerReward:       r.ProposerReward,
+			InactivityPenalty:    r.InactivityPenalty,
+			SlashingPenalty:      r.SlashingPenalty,
+		})
+	}
+	return &pb.ValidatorRewardsResponse{
+		Epoch:         req.Epoch,
+		Rewards:       res,
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(rewards)),
+	}, nil
+}
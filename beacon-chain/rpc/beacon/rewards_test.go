@@ -0,0 +1,150 @@
+package beacon
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_ListValidatorRewards_CannotRequestFutureEpoch(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{Slot: 0},
+		},
+	}
+
+	wanted := "Cannot retrieve information about an epoch in the future"
+	if _, err := bs.ListValidatorRewards(
+		context.Background(),
+		&pb.ListValidatorRewardsRequest{Epoch: 1},
+	); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_ListValidatorRewards_NotArchived(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{Slot: params.BeaconConfig().SlotsPerEpoch},
+		},
+	}
+
+	wanted := "perhaps --archive-validator-rewards in the running beacon node is disabled"
+	if _, err := bs.ListValidatorRewards(
+		context.Background(),
+		&pb.ListValidatorRewardsRequest{Epoch: 0},
+	); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_ListValidatorRewards_ExceedsMaxPageSize(t *testing.T) {
+	bs := &Server{}
+	exceedsMax := int32(flags.Get().MaxPageSize + 1)
+
+	wanted := "can not be greater than max size"
+	req := &pb.ListValidatorRewardsRequest{PageToken: strconv.Itoa(0), PageSize: exceedsMax}
+	if _, err := bs.ListValidatorRewards(context.Background(), req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_ListValidatorRewards_Pagination(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	archived := &dbpb.ArchivedValidatorRewards{}
+	for i := uint64(0); i < 10; i++ {
+		archived.Rewards = append(archived.Rewards, &dbpb.ValidatorRewards{
+			ValidatorIndex: i,
+			SourceReward:   i * 10,
+			TargetPenalty:  i,
+		})
+	}
+	if err := db.SaveArchivedValidatorRewards(ctx, 1, archived); err != nil {
+		t.Fatal(err)
+	}
+	bs := &Server{
+		BeaconDB: db,
+		HeadFetcher: &mock.ChainService{
+			State: &pbp2p.BeaconState{Slot: 2 * params.BeaconConfig().SlotsPerEpoch},
+		},
+	}
+
+	rewards := func(indices ...uint64) []*pb.ValidatorRewards {
+		res := make([]*pb.ValidatorRewards, 0, len(indices))
+		for _, i := range indices {
+			res = append(res, &pb.ValidatorRewards{ValidatorIndex: i, SourceReward: i * 10, TargetPenalty: i})
+		}
+		return res
+	}
+	tests := []struct {
+		req *pb.ListValidatorRewardsRequest
+		res *pb.ValidatorRewardsResponse
+	}{
+		{
+			req: &pb.ListValidatorRewardsRequest{Epoch: 1, PageSize: 3},
+			res: &pb.ValidatorRewardsResponse{
+				Epoch:         1,
+				Rewards:       rewards(0, 1, 2),
+				NextPageToken: strconv.Itoa(1),
+				TotalSize:     10,
+			},
+		},
+		{
+			req: &pb.ListValidatorRewardsRequest{Epoch: 1, PageSize: 3, PageToken: strconv.Itoa(3)},
+			res: &pb.ValidatorRewardsResponse{
+				Epoch:         1,
+				Rewards:       rewards(9),
+				NextPageToken: "",
+				TotalSize:     10,
+			},
+		},
+		{
+			req: &pb.ListValidatorRewardsRequest{Epoch: 1, Indices: []uint64{7, 2, 4, 2, 12}, PageSize: 2},
+			res: &pb.ValidatorRewardsResponse{
+				Epoch:         1,
+				Rewards:       rewards(2, 4),
+				NextPageToken: strconv.Itoa(1),
+				TotalSize:     3,
+			},
+		},
+		{
+			req: &pb.ListValidatorRewardsRequest{Epoch: 1, Indices: []uint64{12}},
+			res: &pb.ValidatorRewardsResponse{
+				Epoch:         1,
+				Rewards:       make([]*pb.ValidatorRewards, 0),
+				NextPageToken: strconv.Itoa(0),
+				TotalSize:     0,
+			},
+		},
+	}
+	for _, test := range tests {
+		res, err := bs.ListValidatorRewards(ctx, test.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(res, test.res) {
+			t.Errorf("Expected %v, received %v", test.res, res)
+		}
+	}
+}
@@ -6,10 +6,13 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/dutycache"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
@@ -21,6 +24,7 @@ type Server struct {
 	BeaconDB             db.ReadOnlyDatabase
 	Ctx                  context.Context
 	ChainStartFetcher    powchain.ChainStartFetcher
+	DepositFetcher       depositcache.DepositFetcher
 	HeadFetcher          blockchain.HeadFetcher
 	FinalizationFetcher  blockchain.FinalizationFetcher
 	ParticipationFetcher blockchain.ParticipationFetcher
@@ -30,4 +34,7 @@ type Server struct {
 	CanonicalStateChan   chan *pbp2p.BeaconState
 	ChainStartChan       chan time.Time
 	SlotTicker           slotutil.Ticker
+	StateGen             *stategen.Generator
+	DutiesCache          *dutycache.DutiesCache
+	DepositInfoCache     *DepositInfoCache
 }
@@ -0,0 +1,229 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Request header keys selecting the state read by an RPC instead of the head state. At most one
+// of them can be set. Block and state roots are hex encoded.
+const (
+	// stateSlotHeader selects the state of the canonical chain at a slot.
+	stateSlotHeader = "x-state-slot"
+	// stateBlockRootHeader selects the post state of a block, which does not need to be canonical.
+	stateBlockRootHeader = "x-state-block-root"
+	// stateRootHeader selects a state of the canonical chain by root, among the states of the
+	// slots covered by the state roots of the head state.
+	stateRootHeader = "x-state-root"
+)
+
+// queriedState returns the state selected by the request headers, or nil if the request reads the
+// head state. Historical states are regenerated from the nearest state stored in the database.
+func (bs *Server) queriedState(ctx context.Context) (*pbp2p.BeaconState, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	var key, value string
+	for _, k := range []string{stateSlotHeader, stateBlockRootHeader, stateRootHeader} {
+		values := md.Get(k)
+		if len(values) == 0 {
+			continue
+		}
+		if key != "" || len(values) > 1 {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Only one of %s, %s or %s can be requested",
+				stateSlotHeader,
+				stateBlockRootHeader,
+				stateRootHeader,
+			)
+		}
+		key, value = k, values[0]
+	}
+	if key == "" {
+		return nil, nil
+	}
+
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not get head state")
+	}
+	switch key {
+	case stateSlotHeader:
+		slot, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid slot %q: %v", value, err)
+		}
+		return bs.stateAtSlot(ctx, headState, slot)
+	case stateBlockRootHeader:
+		root, err := decodeRoot(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid block root %q: %v", value, err)
+		}
+		st, err := bs.StateGen.StateByBlockRoot(ctx, root)
+		if err != nil {
+			return nil, stateGenError(err)
+		}
+		return st, nil
+	case stateRootHeader:
+		root, err := decodeRoot(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid state root %q: %v", value, err)
+		}
+		slot, err := bs.stateRootSlot(ctx, headState, root)
+		if err != nil {
+			return nil, err
+		}
+		return bs.stateAtSlot(ctx, headState, slot)
+	}
+	return headState, nil
+}
+
+// hasStateQuery returns true if the request headers select a state other than the head state.
+func hasStateQuery(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, k := range []string{stateSlotHeader, stateBlockRootHeader, stateRootHeader} {
+		if len(md.Get(k)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// stateAtSlot returns the state of the canonical chain at the given slot.
+func (bs *Server) stateAtSlot(ctx context.Context, headState *pbp2p.BeaconState, slot uint64) (*pbp2p.BeaconState, error) {
+	if slot > headState.Slot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve information about a slot in the future, head slot %d, requesting %d",
+			headState.Slot,
+			slot,
+		)
+	}
+	if slot == headState.Slot {
+		return headState, nil
+	}
+	root, err := bs.canonicalBlockRoot(ctx, headState, slot)
+	if err != nil {
+		return nil, err
+	}
+	st, err := bs.StateGen.StateAtSlot(ctx, root, slot)
+	if err != nil {
+		return nil, stateGenError(err)
+	}
+	return st, nil
+}
+
+// canonicalBlockRoot returns the root of the latest canonical block at or before the given slot,
+// which is before the slot of the head state. The block roots of the head state cover the recent
+// slots, and older blocks are looked up in the finalized block index of the database.
+func (bs *Server) canonicalBlockRoot(ctx context.Context, headState *pbp2p.BeaconState, slot uint64) ([32]byte, error) {
+	if headState.Slot <= slot+params.BeaconConfig().SlotsPerHistoricalRoot {
+		root, err := helpers.BlockRootAtSlot(headState, slot)
+		if err != nil {
+			return [32]byte{}, status.Errorf(codes.Internal, "Could not get block root at slot %d: %v", slot, err)
+		}
+		return bytesutil.ToBytes32(root), nil
+	}
+
+	// Search the finalized blocks backwards, one epoch at a time.
+	for end := slot; ; end -= params.BeaconConfig().SlotsPerEpoch {
+		start := uint64(0)
+		if end > params.BeaconConfig().SlotsPerEpoch {
+			start = end - params.BeaconConfig().SlotsPerEpoch + 1
+		}
+		blks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+		if err != nil {
+			return [32]byte{}, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+		}
+		var found bool
+		var latest [32]byte
+		var latestSlot uint64
+		for _, b := range blks {
+			root, err := ssz.HashTreeRoot(b.Block)
+			if err != nil {
+				return [32]byte{}, status.Errorf(codes.Internal, "Could not compute block root: %v", err)
+			}
+			if !bs.BeaconDB.IsFinalizedBlock(ctx, root) {
+				continue
+			}
+			if !found || b.Block.Slot >= latestSlot {
+				found, latest, latestSlot = true, root, b.Block.Slot
+			}
+		}
+		if found {
+			return latest, nil
+		}
+		if start == 0 {
+			return [32]byte{}, status.Errorf(codes.NotFound, "Could not find a canonical block at or before slot %d", slot)
+		}
+	}
+}
+
+// stateRootSlot returns the slot of the canonical state with the given root, looking up the state
+// roots of the head state along with the root of the head state itself.
+func (bs *Server) stateRootSlot(ctx context.Context, headState *pbp2p.BeaconState, root [32]byte) (uint64, error) {
+	if headBlock := bs.HeadFetcher.HeadBlock(); headBlock != nil && headBlock.Block != nil &&
+		headBlock.Block.Slot == headState.Slot && bytes.Equal(headBlock.Block.StateRoot, root[:]) {
+		return headState.Slot, nil
+	}
+	historicalRoots := params.BeaconConfig().SlotsPerHistoricalRoot
+	for i := uint64(1); i <= historicalRoots && i <= headState.Slot; i++ {
+		slot := headState.Slot - i
+		if bytes.Equal(headState.StateRoots[slot%historicalRoots], root[:]) {
+			return slot, nil
+		}
+	}
+	return 0, status.Errorf(
+		codes.NotFound,
+		"Could not find state root %#x in the last %d slots",
+		root,
+		historicalRoots,
+	)
+}
+
+// decodeRoot decodes a hex encoded root, with or without 0x prefix.
+func decodeRoot(s string) ([32]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(b) != 32 {
+		return [32]byte{}, errors.Errorf("expected 32 bytes, received %d", len(b))
+	}
+	return bytesutil.ToBytes32(b), nil
+}
+
+// stateGenError converts an error regenerating a state to a gRPC status error.
+func stateGenError(err error) error {
+	switch errors.Cause(err) {
+	case stategen.ErrUnknownBlock:
+		return status.Errorf(codes.NotFound, "Could not regenerate state: %v", err)
+	case stategen.ErrReplayLimitExceeded:
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"Could not regenerate state, perhaps --historical-state-max-replay-slots is too low: %v",
+			err,
+		)
+	}
+	return status.Errorf(codes.Internal, "Could not regenerate state: %v", err)
+}
@@ -0,0 +1,300 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// setupHistoricalChain saves a chain of blocks at the given slots, only storing the state of the
+// first block. It returns a server with the post state of the last block as head state, along
+// with the roots and post states of the blocks.
+func setupHistoricalChain(t *testing.T, beaconDB db.Database, slots []uint64) (*Server, [][32]byte, []*pbp2p.BeaconState) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	roots := make([][32]byte, len(slots))
+	states := make([]*pbp2p.BeaconState, len(slots))
+	for i, slot := range slots {
+		b, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		roots[i], err = ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			if err := beaconDB.SaveState(ctx, beaconState, roots[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		states[i] = proto.Clone(beaconState).(*pbp2p.BeaconState)
+	}
+	bs := &Server{
+		BeaconDB:    beaconDB,
+		HeadFetcher: &mock.ChainService{State: beaconState},
+		StateGen:    stategen.New(&stategen.Config{BeaconDB: beaconDB}),
+	}
+	return bs, roots, states
+}
+
+func TestServer_QueriedState(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, roots, states := setupHistoricalChain(t, beaconDB, []uint64{1, 2, 4, 5})
+	stateAtSlot3, err := state.ProcessSlots(context.Background(), proto.Clone(states[1]).(*pbp2p.BeaconState), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		want    *pbp2p.BeaconState
+		wantErr string
+	}{
+		{
+			name: "no query",
+			md:   metadata.Pairs("x-other", "1"),
+		},
+		{
+			name: "head slot",
+			md:   metadata.Pairs(stateSlotHeader, "5"),
+			want: states[3],
+		},
+		{
+			name: "slot",
+			md:   metadata.Pairs(stateSlotHeader, "2"),
+			want: states[1],
+		},
+		{
+			name: "skipped slot",
+			md:   metadata.Pairs(stateSlotHeader, "3"),
+			want: stateAtSlot3,
+		},
+		{
+			name: "block root",
+			md:   metadata.Pairs(stateBlockRootHeader, fmt.Sprintf("%#x", roots[2])),
+			want: states[2],
+		},
+		{
+			name: "state root",
+			md:   metadata.Pairs(stateRootHeader, fmt.Sprintf("%x", states[3].StateRoots[3])),
+			want: stateAtSlot3,
+		},
+		{
+			name:    "future slot",
+			md:      metadata.Pairs(stateSlotHeader, "6"),
+			wantErr: "Cannot retrieve information about a slot in the future",
+		},
+		{
+			name:    "unknown block root",
+			md:      metadata.Pairs(stateBlockRootHeader, fmt.Sprintf("%#x", [32]byte{'a'})),
+			wantErr: "unknown block",
+		},
+		{
+			name:    "unknown state root",
+			md:      metadata.Pairs(stateRootHeader, fmt.Sprintf("%#x", [32]byte{'a'})),
+			wantErr: "Could not find state root",
+		},
+		{
+			name:    "invalid root",
+			md:      metadata.Pairs(stateBlockRootHeader, "0x01"),
+			wantErr: "expected 32 bytes",
+		},
+		{
+			name:    "several queries",
+			md:      metadata.Pairs(stateSlotHeader, "2", stateBlockRootHeader, fmt.Sprintf("%#x", roots[2])),
+			wantErr: "Only one of",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			st, err := bs.queriedState(ctx)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error %v, received %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == nil {
+				if st != nil {
+					t.Errorf("Expected no state, received state at slot %d", st.Slot)
+				}
+				return
+			}
+			if !proto.Equal(st, tt.want) {
+				t.Errorf("Wanted state at slot %d, received state at slot %d", tt.want.Slot, st.Slot)
+			}
+		})
+	}
+}
+
+func TestServer_ListValidatorBalances_HistoricalState(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, _, states := setupHistoricalChain(t, beaconDB, []uint64{1, 2, 3})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stateSlotHeader, "2"))
+	res, err := bs.ListValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{Indices: []uint64{0, 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, b := range res.Balances {
+		if b.Balance != states[1].Balances[i] {
+			t.Errorf("Wanted balance %d of validator %d, received %d", states[1].Balances[i], i, b.Balance)
+		}
+	}
+
+	req := &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+	}
+	wanted := "Cannot request an epoch along with a historical state"
+	if _, err := bs.ListValidatorBalances(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_ListBeaconCommittees_HistoricalState(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, roots, states := setupHistoricalChain(t, beaconDB, []uint64{1, 2, 3})
+	ctx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(stateBlockRootHeader, fmt.Sprintf("%#x", roots[1])),
+	)
+	res, err := bs.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted, err := committeesFromState(states[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted committees %v, received %v", wanted, res)
+	}
+	if res.ActiveValidatorCount != uint64(len(states[1].Validators)) {
+		t.Errorf("Wanted %d active validators, received %d", len(states[1].Validators), res.ActiveValidatorCount)
+	}
+	if res.Epoch != 0 {
+		t.Errorf("Wanted epoch 0, received %d", res.Epoch)
+	}
+}
+
+func TestServer_ListValidatorAssignments_HistoricalState(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, _, states := setupHistoricalChain(t, beaconDB, []uint64{1, params.BeaconConfig().SlotsPerEpoch + 1})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stateSlotHeader, "1"))
+	res, err := bs.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{Indices: []uint64{0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Epoch != 0 {
+		t.Errorf("Wanted assignments of epoch 0, received epoch %d", res.Epoch)
+	}
+	committees, _, err := helpers.CommitteeAssignments(states[0], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Assignments) != 1 || res.Assignments[0].AttesterSlot != committees[0].AttesterSlot {
+		t.Errorf("Wanted attester slot %d, received %v", committees[0].AttesterSlot, res.Assignments)
+	}
+
+	req := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0},
+	}
+	wanted := "Cannot request an epoch along with a historical state"
+	if _, err := bs.ListValidatorAssignments(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_GetValidatorActiveSetChanges_HistoricalState(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, _, _ := setupHistoricalChain(t, beaconDB, []uint64{1, params.BeaconConfig().SlotsPerEpoch + 1})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stateSlotHeader, "1"))
+	res, err := bs.GetValidatorActiveSetChanges(ctx, &ethpb.GetValidatorActiveSetChangesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Epoch != 0 {
+		t.Errorf("Wanted changes of epoch 0, received epoch %d", res.Epoch)
+	}
+
+	req := &ethpb.GetValidatorActiveSetChangesRequest{
+		QueryFilter: &ethpb.GetValidatorActiveSetChangesRequest_Epoch{Epoch: 0},
+	}
+	wanted := "Cannot request an epoch along with a historical state"
+	if _, err := bs.GetValidatorActiveSetChanges(ctx, req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestServer_GetValidatorParticipation_HistoricalStateNotSupported(t *testing.T) {
+	bs := &Server{}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stateSlotHeader, "1"))
+	_, err := bs.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, received %v", err)
+	}
+}
+
+func TestServer_GetValidatorPerformance_HistoricalState(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+
+	bs, _, states := setupHistoricalChain(t, beaconDB, []uint64{1, params.BeaconConfig().SlotsPerEpoch + 1})
+	ctx := context.Background()
+	pubKey := states[0].Validators[0].PublicKey
+	if err := beaconDB.SaveValidatorIndex(ctx, pubKey, 0); err != nil {
+		t.Fatal(err)
+	}
+	// The balances of the validators change at the epoch transition.
+	bs.HeadFetcher.(*mock.ChainService).State.Balances[0]++
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(stateSlotHeader, "1"))
+	res, err := bs.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
+		Slot:       1,
+		PublicKeys: [][]byte{pubKey},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Balances[0] != states[0].Balances[0] {
+		t.Errorf("Wanted balance %d, received %d", states[0].Balances[0], res.Balances[0])
+	}
+}
@@ -21,7 +21,8 @@ import (
 
 // ListValidatorBalances retrieves the validator balances for a given set of public keys.
 // An optional Epoch parameter is provided to request historical validator balances from
-// archived, persistent data.
+// archived, persistent data. The balances of the state at any past slot, block root or state
+// root are retrieved when requested in the headers of the call.
 func (bs *Server) ListValidatorBalances(
 	ctx context.Context,
 	req *ethpb.ListValidatorBalancesRequest) (*ethpb.ValidatorBalances, error) {
@@ -37,6 +38,16 @@ func (bs *Server) ListValidatorBalances(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		if req.QueryFilter != nil {
+			return nil, status.Error(codes.InvalidArgument, "Cannot request an epoch along with a historical state")
+		}
+		headState = queriedState
+	}
 
 	var requestingGenesis bool
 	var epoch uint64
@@ -171,7 +182,8 @@ func (bs *Server) ListValidatorBalances(
 }
 
 // ListValidators retrieves the current list of active validators with an optional historical epoch flag to
-// to retrieve validator set in time.
+// to retrieve validator set in time. The validators of the state at any past slot, block root or state
+// root are retrieved when requested in the headers of the call.
 func (bs *Server) ListValidators(
 	ctx context.Context,
 	req *ethpb.ListValidatorsRequest,
@@ -185,6 +197,16 @@ func (bs *Server) ListValidators(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		if req.QueryFilter != nil {
+			return nil, status.Error(codes.InvalidArgument, "Cannot request an epoch along with a historical state")
+		}
+		headState = queriedState
+	}
 	currentEpoch := helpers.CurrentEpoch(headState)
 	requestedEpoch := currentEpoch
 
@@ -264,7 +286,8 @@ func (bs *Server) ListValidators(
 	}, nil
 }
 
-// GetValidator information from any validator in the registry by index or public key.
+// GetValidator information from any validator in the registry by index or public key, optionally from
+// the historical state requested in the headers of the call.
 func (bs *Server) GetValidator(
 	ctx context.Context, req *ethpb.GetValidatorRequest,
 ) (*ethpb.Validator, error) {
@@ -287,6 +310,13 @@ func (bs *Server) GetValidator(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		headState = queriedState
+	}
 	if requestingIndex {
 		if index >= uint64(len(headState.Validators)) {
 			return nil, status.Errorf(
@@ -309,7 +339,8 @@ func (bs *Server) GetValidator(
 // GetValidatorActiveSetChanges retrieves the active set changes for a given epoch.
 //
 // This data includes any activations, voluntary exits, and involuntary
-// ejections.
+// ejections. The changes of the current epoch of a historical state are retrieved when the state
+// is requested in the headers of the call.
 func (bs *Server) GetValidatorActiveSetChanges(
 	ctx context.Context, req *ethpb.GetValidatorActiveSetChangesRequest,
 ) (*ethpb.ActiveSetChanges, error) {
@@ -317,6 +348,16 @@ func (bs *Server) GetValidatorActiveSetChanges(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		if req.QueryFilter != nil {
+			return nil, status.Error(codes.InvalidArgument, "Cannot request an epoch along with a historical state")
+		}
+		headState = queriedState
+	}
 	currentEpoch := helpers.CurrentEpoch(headState)
 	requestedEpoch := currentEpoch
 	requestingGenesis := false
@@ -391,10 +432,18 @@ func (bs *Server) GetValidatorActiveSetChanges(
 
 // GetValidatorParticipation retrieves the validator participation information for a given epoch,
 // it returns the information about validator's participation rate in voting on the proof of stake
-// rules based on their balance compared to the total active validator balance.
+// rules based on their balance compared to the total active validator balance. Participation is
+// tracked by epoch rather than by state, so historical states cannot be requested in the headers
+// of the call, the epoch of the request is used instead.
 func (bs *Server) GetValidatorParticipation(
 	ctx context.Context, req *ethpb.GetValidatorParticipationRequest,
 ) (*ethpb.ValidatorParticipationResponse, error) {
+	if hasStateQuery(ctx) {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"Cannot request participation from a historical state, request an epoch instead",
+		)
+	}
 	headState, err := bs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
@@ -472,7 +521,8 @@ func (bs *Server) GetValidatorParticipation(
 	}, nil
 }
 
-// GetValidatorQueue retrieves the current validator queue information.
+// GetValidatorQueue retrieves the current validator queue information, optionally from the
+// historical state requested in the headers of the call.
 func (bs *Server) GetValidatorQueue(
 	ctx context.Context, _ *ptypes.Empty,
 ) (*ethpb.ValidatorQueue, error) {
@@ -480,6 +530,13 @@ func (bs *Server) GetValidatorQueue(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		headState = queriedState
+	}
 	// Queue the validators whose eligible to activate and sort them by activation eligibility epoch number.
 	// Additionally, determine those validators queued to exit
 	awaitingExit := make([]uint64, 0)
@@ -563,7 +620,8 @@ func (bs *Server) GetValidatorQueue(
 }
 
 // GetValidatorPerformance reports the validator's latest balance along with other important metrics on
-// rewards and penalties throughout its lifecycle in the beacon chain.
+// rewards and penalties throughout its lifecycle in the beacon chain, optionally from the
+// historical state requested in the headers of the call.
 func (bs *Server) GetValidatorPerformance(
 	ctx context.Context, req *ethpb.ValidatorPerformanceRequest,
 ) (*ethpb.ValidatorPerformanceResponse, error) {
@@ -571,6 +629,13 @@ func (bs *Server) GetValidatorPerformance(
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
+	queriedState, err := bs.queriedState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queriedState != nil {
+		headState = queriedState
+	}
 
 	// Advance state with empty transitions up to the requested epoch start slot.
 	if req.Slot > headState.Slot {
@@ -0,0 +1,85 @@
+// Package debug defines a gRPC server implementation of the Prysm debug service, which exposes
+// the internal state of the beacon node, such as its fork choice store.
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Debug service, providing RPC endpoints
+// to inspect the fork choice store of the beacon node.
+type Server struct {
+	HeadFetcher       blockchain.HeadFetcher
+	ForkChoiceFetcher blockchain.ForkChoiceFetcher
+}
+
+// GetProtoArrayForkChoice retrieves the block nodes of the proto-array fork choice store, along
+// with their weights, best child and descendant, checkpoint epochs and viability for head.
+func (ds *Server) GetProtoArrayForkChoice(ctx context.Context, _ *ptypes.Empty) (*pb.ProtoArrayForkChoiceResponse, error) {
+	store, headRoot, err := ds.forkChoiceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := store.Nodes()
+	rootAt := func(index uint64) []byte {
+		if index >= uint64(len(nodes)) {
+			return nil
+		}
+		root := nodes[index].Root()
+		return root[:]
+	}
+	resp := &pb.ProtoArrayForkChoiceResponse{
+		JustifiedEpoch: store.JustifiedEpoch(),
+		FinalizedEpoch: store.FinalizedEpoch(),
+		HeadRoot:       headRoot[:],
+		Nodes:          make([]*pb.ProtoArrayNode, len(nodes)),
+	}
+	for i, n := range nodes {
+		resp.Nodes[i] = &pb.ProtoArrayNode{
+			Slot:               n.Slot,
+			Root:               rootAt(uint64(i)),
+			ParentRoot:         rootAt(n.Parent),
+			JustifiedEpoch:     n.JustifiedEpoch(),
+			FinalizedEpoch:     n.FinalizedEpoch(),
+			Weight:             n.Weight,
+			BestChildRoot:      rootAt(n.BestChild()),
+			BestDescendantRoot: rootAt(n.BestDescendent),
+			Viable:             store.IsViableForHead(n),
+		}
+	}
+	return resp, nil
+}
+
+// GetProtoArrayForkChoiceGraph retrieves the block tree of the proto-array fork choice store,
+// rendered in the Graphviz DOT format.
+func (ds *Server) GetProtoArrayForkChoiceGraph(ctx context.Context, _ *ptypes.Empty) (*pb.ProtoArrayForkChoiceGraph, error) {
+	store, headRoot, err := ds.forkChoiceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProtoArrayForkChoiceGraph{
+		Dot: forkchoice.Graph(store, headRoot),
+	}, nil
+}
+
+// forkChoiceStore returns the proto-array fork choice store and the current head root.
+func (ds *Server) forkChoiceStore(ctx context.Context) (forkchoice.ForkChoicer, [32]byte, error) {
+	store := ds.ForkChoiceFetcher.ForkChoiceStore()
+	if store == nil {
+		return nil, [32]byte{}, status.Error(codes.Unavailable, "Fork choice store is not initialized")
+	}
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	return store, bytesutil.ToBytes32(headRoot), nil
+}
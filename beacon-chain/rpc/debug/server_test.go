@@ -0,0 +1,80 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func setupForkChoice(t *testing.T) (*Server, [32]byte, [32]byte) {
+	ctx := context.Background()
+	genesisRoot := [32]byte{'a'}
+	headRoot := [32]byte{'b'}
+	store := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	if err := store.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ProcessBlock(ctx, 1, headRoot, genesisRoot, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	chain := &mock.ChainService{Root: headRoot[:], ForkChoice: store}
+	return &Server{HeadFetcher: chain, ForkChoiceFetcher: chain}, genesisRoot, headRoot
+}
+
+func TestServer_GetProtoArrayForkChoice(t *testing.T) {
+	ds, genesisRoot, headRoot := setupForkChoice(t)
+
+	res, err := ds.GetProtoArrayForkChoice(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.HeadRoot, headRoot[:]) {
+		t.Errorf("Wanted head root %#x, received %#x", headRoot, res.HeadRoot)
+	}
+	if len(res.Nodes) != 2 {
+		t.Fatalf("Wanted 2 nodes, received %d", len(res.Nodes))
+	}
+	genesis, head := res.Nodes[0], res.Nodes[1]
+	if len(genesis.ParentRoot) != 0 {
+		t.Errorf("Expected no parent root for genesis, received %#x", genesis.ParentRoot)
+	}
+	if !bytes.Equal(genesis.BestChildRoot, headRoot[:]) {
+		t.Errorf("Wanted best child %#x, received %#x", headRoot, genesis.BestChildRoot)
+	}
+	if !bytes.Equal(genesis.BestDescendantRoot, headRoot[:]) {
+		t.Errorf("Wanted best descendant %#x, received %#x", headRoot, genesis.BestDescendantRoot)
+	}
+	if !bytes.Equal(head.ParentRoot, genesisRoot[:]) {
+		t.Errorf("Wanted parent root %#x, received %#x", genesisRoot, head.ParentRoot)
+	}
+	if head.Slot != 1 || !head.Viable {
+		t.Errorf("Unexpected head node %v", head)
+	}
+}
+
+func TestServer_GetProtoArrayForkChoiceGraph(t *testing.T) {
+	ds, _, _ := setupForkChoice(t)
+
+	res, err := ds.GetProtoArrayForkChoiceGraph(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(res.Dot, "digraph") {
+		t.Errorf("Expected a DOT digraph, received %s", res.Dot)
+	}
+}
+
+func TestServer_GetProtoArrayForkChoice_NotInitialized(t *testing.T) {
+	chain := &mock.ChainService{}
+	ds := &Server{HeadFetcher: chain, ForkChoiceFetcher: chain}
+
+	if _, err := ds.GetProtoArrayForkChoice(context.Background(), &ptypes.Empty{}); err == nil {
+		t.Error("Expected an error when the fork choice store is not initialized")
+	}
+}
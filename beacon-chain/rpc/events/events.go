@@ -0,0 +1,232 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// Topics of the events which can be streamed.
+const (
+	headTopic                  = "head"
+	chainReorgTopic            = "chain_reorg"
+	blockTopic                 = "block"
+	finalizedCheckpointTopic   = "finalized_checkpoint"
+	attestationTopic           = "attestation"
+	aggregatedAttestationTopic = "aggregated_attestation"
+	voluntaryExitTopic         = "voluntary_exit"
+	proposerSlashingTopic      = "proposer_slashing"
+	attesterSlashingTopic      = "attester_slashing"
+	depositTopic               = "deposit"
+	blockOperationsTopic       = "block_operations"
+)
+
+var allTopics = []string{
+	headTopic,
+	chainReorgTopic,
+	blockTopic,
+	finalizedCheckpointTopic,
+	attestationTopic,
+	aggregatedAttestationTopic,
+	voluntaryExitTopic,
+	proposerSlashingTopic,
+	attesterSlashingTopic,
+	depositTopic,
+	blockOperationsTopic,
+}
+
+// Protobuf messages are encoded with the same JSON mapping as the gateway, which is also followed
+// by the other event payloads below.
+var marshaler = &jsonpb.Marshaler{EmitDefaults: true}
+
+type headData struct {
+	Slot              uint64 `json:"slot,string"`
+	BlockRoot         []byte `json:"blockRoot"`
+	PreviousSlot      uint64 `json:"previousSlot,string"`
+	PreviousBlockRoot []byte `json:"previousBlockRoot"`
+}
+
+type chainReorgData struct {
+	OldSlot            uint64 `json:"oldSlot,string"`
+	OldBlockRoot       []byte `json:"oldBlockRoot"`
+	NewSlot            uint64 `json:"newSlot,string"`
+	NewBlockRoot       []byte `json:"newBlockRoot"`
+	CommonAncestorSlot uint64 `json:"commonAncestorSlot,string"`
+	CommonAncestorRoot []byte `json:"commonAncestorRoot"`
+	Depth              uint64 `json:"depth,string"`
+}
+
+type blockData struct {
+	BlockRoot []byte `json:"blockRoot"`
+	Verified  bool   `json:"verified"`
+}
+
+type finalizedCheckpointData struct {
+	Epoch     uint64 `json:"epoch,string"`
+	BlockRoot []byte `json:"blockRoot"`
+}
+
+type depositData struct {
+	Deposit         protoJSON `json:"deposit"`
+	MerkleTreeIndex uint64    `json:"merkleTreeIndex,string"`
+	Eth1BlockNumber uint64    `json:"eth1BlockNumber,string"`
+	Valid           bool      `json:"valid"`
+}
+
+type blockOperationsData struct {
+	Slot              uint64      `json:"slot,string"`
+	BlockRoot         []byte      `json:"blockRoot"`
+	Attestations      []protoJSON `json:"attestations"`
+	Deposits          []protoJSON `json:"deposits"`
+	VoluntaryExits    []protoJSON `json:"voluntaryExits"`
+	ProposerSlashings []protoJSON `json:"proposerSlashings"`
+	AttesterSlashings []protoJSON `json:"attesterSlashings"`
+}
+
+// protoJSON wraps a protobuf message to encode it with the JSON mapping of protobuf.
+type protoJSON struct {
+	proto.Message
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p protoJSON) MarshalJSON() ([]byte, error) {
+	if p.Message == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, p.Message); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// protoList wraps the n messages returned by the given function, which allows slices of any
+// protobuf message type to be encoded.
+func protoList(n int, msg func(i int) proto.Message) []protoJSON {
+	list := make([]protoJSON, n)
+	for i := range list {
+		list[i] = protoJSON{msg(i)}
+	}
+	return list
+}
+
+// requestedTopics returns the set of topics to stream, which is all topics when none is requested.
+func requestedTopics(topics []string) (map[string]bool, error) {
+	if len(topics) == 0 {
+		topics = allTopics
+	}
+	requested := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		known := false
+		for _, t := range allTopics {
+			if t == topic {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown event topic %q", topic)
+		}
+		requested[topic] = true
+	}
+	return requested, nil
+}
+
+// newEvent encodes the data of an event of the given topic, or returns nil if the topic was not
+// requested.
+func newEvent(topics map[string]bool, topic string, data interface{}) (*pb.Event, error) {
+	if !topics[topic] {
+		return nil, nil
+	}
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Event{Topic: topic, Data: string(enc)}, nil
+}
+
+// stateEvent converts an event of the state feed, returning nil if it should not be streamed.
+func stateEvent(event *feed.Event, topics map[string]bool) (*pb.Event, error) {
+	switch data := event.Data.(type) {
+	case *statefeed.HeadChangedData:
+		return newEvent(topics, headTopic, &headData{
+			Slot:              data.Slot,
+			BlockRoot:         data.BlockRoot[:],
+			PreviousSlot:      data.PreviousSlot,
+			PreviousBlockRoot: data.PreviousBlockRoot[:],
+		})
+	case *statefeed.ReorgData:
+		return newEvent(topics, chainReorgTopic, &chainReorgData{
+			OldSlot:            data.OldSlot,
+			OldBlockRoot:       data.OldBlockRoot[:],
+			NewSlot:            data.NewSlot,
+			NewBlockRoot:       data.NewBlockRoot[:],
+			CommonAncestorSlot: data.CommonAncestorSlot,
+			CommonAncestorRoot: data.CommonAncestorRoot[:],
+			Depth:              data.Depth,
+		})
+	case *statefeed.BlockProcessedData:
+		return newEvent(topics, blockTopic, &blockData{
+			BlockRoot: data.BlockRoot[:],
+			Verified:  data.Verified,
+		})
+	case *statefeed.FinalizedCheckpointData:
+		return newEvent(topics, finalizedCheckpointTopic, &finalizedCheckpointData{
+			Epoch:     data.Epoch,
+			BlockRoot: data.BlockRoot[:],
+		})
+	}
+	return nil, nil
+}
+
+// operationEvent converts an event of the operation feed, returning nil if it should not be
+// streamed.
+func operationEvent(event *feed.Event, topics map[string]bool) (*pb.Event, error) {
+	switch data := event.Data.(type) {
+	case *opfeed.UnAggregatedAttReceivedData:
+		return newEvent(topics, attestationTopic, protoJSON{data.Attestation})
+	case *opfeed.AggregatedAttReceivedData:
+		return newEvent(topics, aggregatedAttestationTopic, protoJSON{data.Attestation})
+	case *opfeed.ExitReceivedData:
+		return newEvent(topics, voluntaryExitTopic, protoJSON{data.Exit})
+	case *opfeed.ProposerSlashingReceivedData:
+		return newEvent(topics, proposerSlashingTopic, protoJSON{data.ProposerSlashing})
+	case *opfeed.AttesterSlashingReceivedData:
+		return newEvent(topics, attesterSlashingTopic, protoJSON{data.AttesterSlashing})
+	case *opfeed.DepositProcessedData:
+		return newEvent(topics, depositTopic, &depositData{
+			Deposit:         protoJSON{data.Deposit},
+			MerkleTreeIndex: data.MerkleTreeIndex,
+			Eth1BlockNumber: data.Eth1BlockNumber,
+			Valid:           data.Valid,
+		})
+	case *opfeed.BlockOperationsIncludedData:
+		return newEvent(topics, blockOperationsTopic, &blockOperationsData{
+			Slot:      data.Slot,
+			BlockRoot: data.BlockRoot[:],
+			Attestations: protoList(len(data.Attestations), func(i int) proto.Message {
+				return data.Attestations[i]
+			}),
+			Deposits: protoList(len(data.Deposits), func(i int) proto.Message {
+				return data.Deposits[i]
+			}),
+			VoluntaryExits: protoList(len(data.VoluntaryExits), func(i int) proto.Message {
+				return data.VoluntaryExits[i]
+			}),
+			ProposerSlashings: protoList(len(data.ProposerSlashings), func(i int) proto.Message {
+				return data.ProposerSlashings[i]
+			}),
+			AttesterSlashings: protoList(len(data.AttesterSlashings), func(i int) proto.Message {
+				return data.AttesterSlashings[i]
+			}),
+		})
+	}
+	return nil, nil
+}
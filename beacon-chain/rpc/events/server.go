@@ -0,0 +1,65 @@
+// Package events defines a gRPC server implementation of the Prysm events service, which streams
+// the chain and operation events of the beacon node to clients filtering them by topic.
+package events
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Events service, providing a stream of the
+// events of the beacon node for monitoring purposes.
+type Server struct {
+	Ctx               context.Context
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
+}
+
+// StreamEvents to clients every time an event of one of the requested topics happens in the beacon
+// node. Events of all topics are streamed when the request does not specify any topic.
+func (es *Server) StreamEvents(req *pb.StreamEventsRequest, stream pb.Events_StreamEventsServer) error {
+	topics, err := requestedTopics(req.Topics)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := es.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := es.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	for {
+		var res *pb.Event
+		select {
+		case event := <-stateChannel:
+			res, err = stateEvent(event, topics)
+		case event := <-opChannel:
+			res, err = operationEvent(event, topics)
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-opSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-es.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not encode event: %v", err)
+		}
+		if res == nil {
+			continue
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+}
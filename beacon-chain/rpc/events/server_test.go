@@ -0,0 +1,277 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"google.golang.org/grpc"
+)
+
+type eventsStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *pb.Event
+}
+
+func (s *eventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventsStream) Send(event *pb.Event) error {
+	s.updates <- event
+	return nil
+}
+
+// startStream starts streaming the events of the given topics, and returns the server along with
+// the stream receiving its events.
+func startStream(t *testing.T, ctx context.Context, topics []string) (*Server, *eventsStream) {
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:               ctx,
+		StateNotifier:     chainService.StateNotifier(),
+		OperationNotifier: chainService.OperationNotifier(),
+	}
+	stream := &eventsStream{ctx: ctx, updates: make(chan *pb.Event)}
+
+	go func(tt *testing.T) {
+		if err := server.StreamEvents(&pb.StreamEventsRequest{Topics: topics}, stream); err == nil {
+			tt.Error("Expected stream to end with an error")
+		}
+	}(t)
+	return server, stream
+}
+
+// send the event in a loop to ensure it is delivered (busy wait for the service to subscribe to
+// the feed).
+func send(f *event.Feed, e *feed.Event) {
+	for sent := 0; sent == 0; {
+		sent = f.Send(e)
+	}
+}
+
+func TestServer_StreamEvents_State(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server, stream := startStream(t, ctx, nil)
+
+	root := func(b byte) [32]byte { return [32]byte{b} }
+	tests := []struct {
+		event     *feed.Event
+		wantTopic string
+		wantData  string
+	}{
+		{
+			event: &feed.Event{
+				Type: statefeed.HeadChanged,
+				Data: &statefeed.HeadChangedData{Slot: 2, BlockRoot: root(2), PreviousSlot: 1, PreviousBlockRoot: root(1)},
+			},
+			wantTopic: headTopic,
+			wantData: `{"slot":"2","blockRoot":"AgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",` +
+				`"previousSlot":"1","previousBlockRoot":"AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}`,
+		},
+		{
+			event: &feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{
+					OldSlot:            3,
+					OldBlockRoot:       root(3),
+					NewSlot:            4,
+					NewBlockRoot:       root(4),
+					CommonAncestorSlot: 1,
+					CommonAncestorRoot: root(1),
+					Depth:              2,
+				},
+			},
+			wantTopic: chainReorgTopic,
+			wantData: `{"oldSlot":"3","oldBlockRoot":"AwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",` +
+				`"newSlot":"4","newBlockRoot":"BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",` +
+				`"commonAncestorSlot":"1","commonAncestorRoot":"AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",` +
+				`"depth":"2"}`,
+		},
+		{
+			event: &feed.Event{
+				Type: statefeed.BlockProcessed,
+				Data: &statefeed.BlockProcessedData{BlockRoot: root(4), Verified: true},
+			},
+			wantTopic: blockTopic,
+			wantData:  `{"blockRoot":"BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","verified":true}`,
+		},
+		{
+			event: &feed.Event{
+				Type: statefeed.FinalizedCheckpoint,
+				Data: &statefeed.FinalizedCheckpointData{Epoch: 3, BlockRoot: root(1)},
+			},
+			wantTopic: finalizedCheckpointTopic,
+			wantData:  `{"epoch":"3","blockRoot":"AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantTopic, func(t *testing.T) {
+			send(server.StateNotifier.StateFeed(), tt.event)
+			got := <-stream.updates
+			if got.Topic != tt.wantTopic {
+				t.Errorf("Wanted topic %s, received %s", tt.wantTopic, got.Topic)
+			}
+			if got.Data != tt.wantData {
+				t.Errorf("Wanted data %s, received %s", tt.wantData, got.Data)
+			}
+		})
+	}
+}
+
+func TestServer_StreamEvents_Operations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server, stream := startStream(t, ctx, nil)
+
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 5},
+		Signature: []byte{'s'},
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{ProposerIndex: 3}
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{2}},
+	}
+	deposit := &ethpb.Deposit{
+		Proof: [][]byte{{'a'}},
+		Data:  &ethpb.Deposit_Data{PublicKey: []byte{'p'}, Amount: 32},
+	}
+	tests := []struct {
+		event     *feed.Event
+		wantTopic string
+		want      proto.Message
+		field     string
+	}{
+		{
+			event:     &feed.Event{Type: opfeed.ExitReceived, Data: &opfeed.ExitReceivedData{Exit: exit}},
+			wantTopic: voluntaryExitTopic,
+			want:      exit,
+		},
+		{
+			event: &feed.Event{
+				Type: opfeed.ProposerSlashingReceived,
+				Data: &opfeed.ProposerSlashingReceivedData{ProposerSlashing: proposerSlashing},
+			},
+			wantTopic: proposerSlashingTopic,
+			want:      proposerSlashing,
+		},
+		{
+			event: &feed.Event{
+				Type: opfeed.AttesterSlashingReceived,
+				Data: &opfeed.AttesterSlashingReceivedData{AttesterSlashing: attesterSlashing},
+			},
+			wantTopic: attesterSlashingTopic,
+			want:      attesterSlashing,
+		},
+		{
+			event: &feed.Event{
+				Type: opfeed.DepositProcessed,
+				Data: &opfeed.DepositProcessedData{Deposit: deposit, MerkleTreeIndex: 7, Eth1BlockNumber: 100, Valid: true},
+			},
+			wantTopic: depositTopic,
+			want:      deposit,
+			field:     "deposit",
+		},
+		{
+			event: &feed.Event{
+				Type: opfeed.BlockOperationsIncluded,
+				Data: &opfeed.BlockOperationsIncludedData{
+					Slot:              9,
+					AttesterSlashings: []*ethpb.AttesterSlashing{attesterSlashing},
+				},
+			},
+			wantTopic: blockOperationsTopic,
+			want:      attesterSlashing,
+			field:     "attesterSlashings",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantTopic, func(t *testing.T) {
+			send(server.OperationNotifier.OperationFeed(), tt.event)
+			got := <-stream.updates
+			if got.Topic != tt.wantTopic {
+				t.Errorf("Wanted topic %s, received %s", tt.wantTopic, got.Topic)
+			}
+			data := got.Data
+			if tt.field != "" {
+				fields := make(map[string]json.RawMessage)
+				if err := json.Unmarshal([]byte(data), &fields); err != nil {
+					t.Fatal(err)
+				}
+				data = string(fields[tt.field])
+				// Operations included in blocks are encoded as lists.
+				var list []json.RawMessage
+				if err := json.Unmarshal(fields[tt.field], &list); err == nil {
+					if len(list) != 1 {
+						t.Fatalf("Wanted 1 operation, received %d", len(list))
+					}
+					data = string(list[0])
+				}
+			}
+			received := proto.Clone(tt.want)
+			received.Reset()
+			if err := jsonpb.UnmarshalString(data, received); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(received, tt.want) {
+				t.Errorf("Wanted %v, received %v", tt.want, received)
+			}
+		})
+	}
+}
+
+func TestServer_StreamEvents_FiltersTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server, stream := startStream(t, ctx, []string{finalizedCheckpointTopic, proposerSlashingTopic})
+
+	send(server.StateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.HeadChanged,
+		Data: &statefeed.HeadChangedData{Slot: 2},
+	})
+	send(server.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{Exit: &ethpb.SignedVoluntaryExit{}},
+	})
+	send(server.StateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{Epoch: 1},
+	})
+	if got := <-stream.updates; got.Topic != finalizedCheckpointTopic {
+		t.Errorf("Wanted topic %s, received %s", finalizedCheckpointTopic, got.Topic)
+	}
+	send(server.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: opfeed.ProposerSlashingReceived,
+		Data: &opfeed.ProposerSlashingReceivedData{ProposerSlashing: &ethpb.ProposerSlashing{}},
+	})
+	if got := <-stream.updates; got.Topic != proposerSlashingTopic {
+		t.Errorf("Wanted topic %s, received %s", proposerSlashingTopic, got.Topic)
+	}
+}
+
+func TestServer_StreamEvents_UnknownTopic(t *testing.T) {
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:               context.Background(),
+		StateNotifier:     chainService.StateNotifier(),
+		OperationNotifier: chainService.OperationNotifier(),
+	}
+	stream := &eventsStream{ctx: context.Background()}
+
+	err := server.StreamEvents(&pb.StreamEventsRequest{Topics: []string{headTopic, "blob"}}, stream)
+	if err == nil || !strings.Contains(err.Error(), `unknown event topic "blob"`) {
+		t.Errorf("Expected unknown topic error, received %v", err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -22,11 +23,12 @@ import (
 // providing RPC endpoints for verifying a beacon node's sync status, genesis and
 // version information, and services the node implements and runs.
 type Server struct {
-	SyncChecker        sync.Checker
-	Server             *grpc.Server
-	BeaconDB           db.ReadOnlyDatabase
-	PeersFetcher       p2p.PeersProvider
-	GenesisTimeFetcher blockchain.GenesisTimeFetcher
+	SyncChecker         sync.Checker
+	SyncProgressFetcher sync.ProgressFetcher
+	Server              *grpc.Server
+	BeaconDB            db.ReadOnlyDatabase
+	PeersFetcher        p2p.PeersProvider
+	GenesisTimeFetcher  blockchain.GenesisTimeFetcher
 }
 
 // GetSyncStatus checks the current network sync status of the node.
@@ -36,6 +38,27 @@ func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.Sy
 	}, nil
 }
 
+// GetSyncProgress retrieves the progress of the ongoing initial sync, including the target and
+// the highest slot reported by peers, the block processing rate and the estimated time remaining.
+func (ns *Server) GetSyncProgress(ctx context.Context, _ *ptypes.Empty) (*pb.SyncProgress, error) {
+	if ns.SyncProgressFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Sync progress is not available")
+	}
+	progress := ns.SyncProgressFetcher.Progress()
+	return &pb.SyncProgress{
+		Syncing:              progress.Syncing,
+		StartSlot:            progress.StartSlot,
+		HeadSlot:             progress.HeadSlot,
+		TargetSlot:           progress.TargetSlot,
+		HighestFinalizedSlot: progress.HighestFinalizedSlot,
+		HighestPeerSlot:      progress.HighestPeerSlot,
+		BlocksPerSecond:      progress.BlocksPerSecond,
+		SecondsRemaining:     uint64(progress.TimeRemaining.Seconds()),
+		SyncingPeers:         uint64(progress.SyncingPeers),
+		ConnectedPeers:       uint64(progress.ConnectedPeers),
+	}, nil
+}
+
 // GetGenesis fetches genesis chain information of Ethereum 2.0.
 func (ns *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.Genesis, error) {
 	contractAddr, err := ns.BeaconDB.DepositContractAddress(ctx)
@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -122,3 +125,56 @@ func TestNodeServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2st peer to be an outbound (%d) connection, received %d", ethpb.PeerDirection_OUTBOUND, res.Peers[0].Direction)
 	}
 }
+
+type mockProgressFetcher struct {
+	progress *sync.Progress
+}
+
+func (m *mockProgressFetcher) Progress() *sync.Progress {
+	return m.progress
+}
+
+func TestNodeServer_GetSyncProgress(t *testing.T) {
+	ns := &Server{
+		SyncProgressFetcher: &mockProgressFetcher{
+			progress: &sync.Progress{
+				Syncing:              true,
+				StartSlot:            10,
+				HeadSlot:             100,
+				TargetSlot:           200,
+				HighestFinalizedSlot: 160,
+				HighestPeerSlot:      199,
+				BlocksPerSecond:      2.5,
+				TimeRemaining:        40 * time.Second,
+				SyncingPeers:         3,
+				ConnectedPeers:       5,
+			},
+		},
+	}
+	res, err := ns.GetSyncProgress(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.SyncProgress{
+		Syncing:              true,
+		StartSlot:            10,
+		HeadSlot:             100,
+		TargetSlot:           200,
+		HighestFinalizedSlot: 160,
+		HighestPeerSlot:      199,
+		BlocksPerSecond:      2.5,
+		SecondsRemaining:     40,
+		SyncingPeers:         3,
+		ConnectedPeers:       5,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted GetSyncProgress() = %v, received %v", want, res)
+	}
+}
+
+func TestNodeServer_GetSyncProgress_Unavailable(t *testing.T) {
+	ns := &Server{}
+	if _, err := ns.GetSyncProgress(context.Background(), &ptypes.Empty{}); err == nil {
+		t.Error("Expected error when sync progress fetcher is not set")
+	}
+}
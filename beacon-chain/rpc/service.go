@@ -16,17 +16,22 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/dutycache"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/aggregator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/events"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -59,6 +64,7 @@ type Service struct {
 	finalizationFetcher    blockchain.FinalizationFetcher
 	participationFetcher   blockchain.ParticipationFetcher
 	genesisTimeFetcher     blockchain.GenesisTimeFetcher
+	forkChoiceFetcher      blockchain.ForkChoiceFetcher
 	attestationReceiver    blockchain.AttestationReceiver
 	blockReceiver          blockchain.BlockReceiver
 	powChainService        powchain.Chain
@@ -67,6 +73,7 @@ type Service struct {
 	attestationsPool       attestations.Pool
 	exitPool               *voluntaryexits.Pool
 	syncService            sync.Checker
+	syncProgressFetcher    sync.ProgressFetcher
 	host                   string
 	port                   string
 	listener               net.Listener
@@ -105,10 +112,12 @@ type Config struct {
 	POWChainService       powchain.Chain
 	ChainStartFetcher     powchain.ChainStartFetcher
 	GenesisTimeFetcher    blockchain.GenesisTimeFetcher
+	ForkChoiceFetcher     blockchain.ForkChoiceFetcher
 	MockEth1Votes         bool
 	AttestationsPool      attestations.Pool
 	ExitPool              *voluntaryexits.Pool
 	SyncService           sync.Checker
+	SyncProgressFetcher   sync.ProgressFetcher
 	Broadcaster           p2p.Broadcaster
 	PeersFetcher          p2p.PeersProvider
 	DepositFetcher        depositcache.DepositFetcher
@@ -132,6 +141,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		finalizationFetcher:   cfg.FinalizationFetcher,
 		participationFetcher:  cfg.ParticipationFetcher,
 		genesisTimeFetcher:    cfg.GenesisTimeFetcher,
+		forkChoiceFetcher:     cfg.ForkChoiceFetcher,
 		attestationReceiver:   cfg.AttestationReceiver,
 		blockReceiver:         cfg.BlockReceiver,
 		p2p:                   cfg.Broadcaster,
@@ -142,6 +152,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		attestationsPool:      cfg.AttestationsPool,
 		exitPool:              cfg.ExitPool,
 		syncService:           cfg.SyncService,
+		syncProgressFetcher:   cfg.SyncProgressFetcher,
 		host:                  cfg.Host,
 		port:                  cfg.Port,
 		withCert:              cfg.CertFlag,
@@ -201,10 +212,13 @@ func (s *Service) Start() {
 
 	genesisTime := s.genesisTimeFetcher.GenesisTime()
 	ticker := slotutil.GetSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	dutiesCache := dutycache.NewDutiesCache()
+	go dutiesCache.Run(s.ctx, s.headFetcher, s.stateNotifier)
 	validatorServer := &validator.Server{
 		Ctx:                    s.ctx,
 		BeaconDB:               s.beaconDB,
 		AttestationCache:       cache.NewAttestationCache(),
+		DutiesCache:            dutiesCache,
 		AttPool:                s.attestationsPool,
 		ExitPool:               s.exitPool,
 		HeadFetcher:            s.headFetcher,
@@ -226,11 +240,12 @@ func (s *Service) Start() {
 		GenesisTime:            genesisTime,
 	}
 	nodeServer := &node.Server{
-		BeaconDB:           s.beaconDB,
-		Server:             s.grpcServer,
-		SyncChecker:        s.syncService,
-		GenesisTimeFetcher: s.genesisTimeFetcher,
-		PeersFetcher:       s.peersFetcher,
+		BeaconDB:            s.beaconDB,
+		Server:              s.grpcServer,
+		SyncChecker:         s.syncService,
+		SyncProgressFetcher: s.syncProgressFetcher,
+		GenesisTimeFetcher:  s.genesisTimeFetcher,
+		PeersFetcher:        s.peersFetcher,
 	}
 	beaconChainServer := &beacon.Server{
 		Ctx:                  s.ctx,
@@ -240,9 +255,26 @@ func (s *Service) Start() {
 		FinalizationFetcher:  s.finalizationFetcher,
 		ParticipationFetcher: s.participationFetcher,
 		ChainStartFetcher:    s.chainStartFetcher,
+		DepositFetcher:       s.depositFetcher,
 		CanonicalStateChan:   s.canonicalStateChan,
 		StateNotifier:        s.stateNotifier,
 		SlotTicker:           ticker,
+		DutiesCache:          dutiesCache,
+		DepositInfoCache:     beacon.NewDepositInfoCache(),
+		StateGen: stategen.New(&stategen.Config{
+			BeaconDB:       s.beaconDB,
+			CacheSize:      flags.Get().HistoricalStateCacheSize,
+			MaxReplaySlots: flags.Get().HistoricalStateMaxReplaySlots,
+		}),
+	}
+	debugServer := &debug.Server{
+		HeadFetcher:       s.headFetcher,
+		ForkChoiceFetcher: s.forkChoiceFetcher,
+	}
+	eventsServer := &events.Server{
+		Ctx:               s.ctx,
+		StateNotifier:     s.stateNotifier,
+		OperationNotifier: s.operationNotifier,
 	}
 	aggregatorServer := &aggregator.Server{
 		BeaconDB:    s.beaconDB,
@@ -253,6 +285,10 @@ func (s *Service) Start() {
 	}
 	pb.RegisterAggregatorServiceServer(s.grpcServer, aggregatorServer)
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
+	pb.RegisterNodeServer(s.grpcServer, nodeServer)
+	pb.RegisterDebugServer(s.grpcServer, debugServer)
+	pb.RegisterEventsServer(s.grpcServer, eventsServer)
+	pb.RegisterChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 
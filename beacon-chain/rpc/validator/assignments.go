@@ -21,22 +21,9 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
 	}
 
-	s, err := vs.HeadFetcher.HeadState(ctx)
+	committeeAssignments, proposerIndexToSlot, err := vs.duties(ctx, req.Epoch)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
-	}
-
-	// Advance state with empty transitions up to the requested epoch start slot.
-	if epochStartSlot := helpers.StartSlot(req.Epoch); s.Slot < epochStartSlot {
-		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
-		}
-	}
-
-	committeeAssignments, proposerIndexToSlot, err := helpers.CommitteeAssignments(s, req.Epoch)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+		return nil, err
 	}
 
 	var validatorAssignments []*ethpb.DutiesResponse_Duty
@@ -73,3 +60,33 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 		Duties: validatorAssignments,
 	}, nil
 }
+
+// duties returns the committee assignments and proposer slots of the validators for the given
+// epoch, served from the duties cache when they were precomputed for the head of the chain.
+func (vs *Server) duties(
+	ctx context.Context,
+	epoch uint64,
+) (map[uint64]*helpers.CommitteeAssignmentContainer, map[uint64]uint64, error) {
+	if d := vs.DutiesCache.Duties(epoch); d != nil {
+		return d.Committees, d.ProposerSlots, nil
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	// Advance state with empty transitions up to the requested epoch start slot.
+	if epochStartSlot := helpers.StartSlot(epoch); s.Slot < epochStartSlot {
+		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+		}
+	}
+
+	committeeAssignments, proposerIndexToSlot, err := helpers.CommitteeAssignments(s, epoch)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	return committeeAssignments, proposerIndexToSlot, nil
+}
@@ -10,6 +10,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/dutycache"
 	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -343,3 +344,52 @@ func BenchmarkCommitteeAssignment(b *testing.B) {
 		}
 	}
 }
+
+func TestGetDuties_FromDutiesCache(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	pubKeys := make([][]byte, len(beaconState.Validators))
+	indices := make([]uint64, len(beaconState.Validators))
+	for i, v := range beaconState.Validators {
+		pubKeys[i] = v.PublicKey
+		indices[i] = uint64(i)
+	}
+	if err := db.SaveValidatorIndices(ctx, pubKeys, indices); err != nil {
+		t.Fatal(err)
+	}
+	dutiesCache := dutycache.NewDutiesCache()
+	if err := dutiesCache.Update(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	// The head state is not needed when the duties are cached.
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		DutiesCache: dutiesCache,
+	}
+	for _, epoch := range []uint64{0, 1} {
+		res, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: pubKeys, Epoch: epoch})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := dutycache.ComputeDuties(ctx, beaconState, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, duty := range res.Duties {
+			if duty.AttesterSlot != want.Committees[uint64(i)].AttesterSlot {
+				t.Errorf("Wanted attester slot %d for validator %d, received %d",
+					want.Committees[uint64(i)].AttesterSlot, i, duty.AttesterSlot)
+			}
+			if duty.ProposerSlot != want.ProposerSlots[uint64(i)] {
+				t.Errorf("Wanted proposer slot %d for validator %d, received %d",
+					want.ProposerSlots[uint64(i)], i, duty.ProposerSlot)
+			}
+		}
+	}
+}
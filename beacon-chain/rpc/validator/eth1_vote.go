@@ -0,0 +1,213 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// eth1VoteTally counts the votes of the head state for an eth1data.
+type eth1VoteTally struct {
+	vote  *ethpb.Eth1Data
+	count int
+	// position of the first vote, ties being broken in favour of the earliest vote.
+	first int
+}
+
+// eth1DataMajorityVote selects the eth1data vote of a block proposal at the given slot, following
+// get_eth1_vote of the honest validator spec:
+//  - The candidates are the eth1data of the blocks whose timestamp is between ETH1_FOLLOW_DISTANCE
+//    and twice ETH1_FOLLOW_DISTANCE eth1 blocks before the start of the voting period, whose
+//    deposit count is not below the deposit count of the eth1data of the state.
+//  - The votes of the state for a candidate are tallied, and the most voted candidate is chosen,
+//    the candidate voted first winning ties.
+//  - Without any vote for a candidate, the candidate of the latest block is chosen, or the eth1data
+//    of the state if there is no candidate.
+func (vs *Server) eth1DataMajorityVote(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.eth1DataMajorityVote")
+	defer span.End()
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	earliest, latest, err := vs.eth1CandidateRange(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	// The blocks of the window are sorted by height, so the deposit count of their eth1data
+	// never decreases and the latest block is the only default candidate to check.
+	if latest.Cmp(earliest) < 0 {
+		return headState.Eth1Data, nil
+	}
+	defaultVote, err := vs.eth1DataAtHeight(ctx, latest)
+	if err != nil {
+		return nil, err
+	}
+	if defaultVote.DepositCount < headState.Eth1Data.DepositCount {
+		return headState.Eth1Data, nil
+	}
+
+	// The votes of the head state are reset at the start of a voting period.
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	var votes []*ethpb.Eth1Data
+	if headState.Slot/votingPeriod == slot/votingPeriod {
+		votes = headState.Eth1DataVotes
+	}
+	tallies, err := vs.tallyEth1DataVotes(ctx, headState, votes, earliest, latest)
+	if err != nil {
+		return nil, err
+	}
+	var best *eth1VoteTally
+	for _, t := range tallies {
+		if best == nil || t.count > best.count || (t.count == best.count && t.first < best.first) {
+			best = t
+		}
+	}
+	if best == nil {
+		return defaultVote, nil
+	}
+	log.WithFields(logrus.Fields{
+		"blockHash":    common.BytesToHash(best.vote.BlockHash).Hex(),
+		"depositCount": best.vote.DepositCount,
+		"votes":        best.count,
+	}).Debug("Voting for majority eth1data")
+	return best.vote, nil
+}
+
+// eth1CandidateRange returns the heights of the earliest and latest eth1 blocks whose eth1data are
+// candidates for the votes of the voting period of the given slot. The range is empty if the
+// latest height is below the earliest height.
+func (vs *Server) eth1CandidateRange(ctx context.Context, slot uint64) (*big.Int, *big.Int, error) {
+	genesisTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	periodStart := genesisTime + (slot-slot%votingPeriod)*params.BeaconConfig().SecondsPerSlot
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().GoerliBlockTime
+
+	latest := big.NewInt(-1)
+	if periodStart >= followTime {
+		number, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, periodStart-followTime)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get latest candidate block from timestamp")
+		}
+		latest = number
+	}
+	earliest := big.NewInt(0)
+	if periodStart >= 2*followTime {
+		earliestTime := periodStart - 2*followTime
+		number, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, earliestTime)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get earliest candidate block from timestamp")
+		}
+		// The block found is the latest block no later than the timestamp, which is only a
+		// candidate if its time is exactly the timestamp.
+		blockTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, number)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get time of earliest candidate block")
+		}
+		earliest = new(big.Int).Set(number)
+		if blockTime < earliestTime {
+			earliest.Add(earliest, big.NewInt(1))
+		}
+	}
+	return earliest, latest, nil
+}
+
+// tallyEth1DataVotes counts the votes for each candidate eth1data in the given range of heights,
+// in the order of their first vote. Votes for eth1 blocks unknown to the node or outside of the
+// range, or for an eth1data differing from the one of their block, are not counted.
+func (vs *Server) tallyEth1DataVotes(
+	ctx context.Context,
+	headState *pbp2p.BeaconState,
+	votes []*ethpb.Eth1Data,
+	earliest *big.Int,
+	latest *big.Int,
+) ([]*eth1VoteTally, error) {
+	tallies := make(map[[32]byte]*eth1VoteTally)
+	invalid := make(map[[32]byte]bool)
+	var ordered []*eth1VoteTally
+	for i, vote := range votes {
+		key, err := hashutil.HashProto(vote)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash eth1data vote")
+		}
+		if t, ok := tallies[key]; ok {
+			t.count++
+			continue
+		}
+		if invalid[key] {
+			continue
+		}
+		valid, err := vs.isCandidateEth1Data(ctx, headState, vote, earliest, latest)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			invalid[key] = true
+			continue
+		}
+		t := &eth1VoteTally{vote: vote, count: 1, first: i}
+		tallies[key] = t
+		ordered = append(ordered, t)
+	}
+	return ordered, nil
+}
+
+// isCandidateEth1Data checks that the vote is the eth1data of a block in the given range of
+// heights, and does not move the deposit count of the state backwards.
+func (vs *Server) isCandidateEth1Data(
+	ctx context.Context,
+	headState *pbp2p.BeaconState,
+	vote *ethpb.Eth1Data,
+	earliest *big.Int,
+	latest *big.Int,
+) (bool, error) {
+	if vote.DepositCount < headState.Eth1Data.DepositCount {
+		return false, nil
+	}
+	exists, height, err := vs.Eth1BlockFetcher.BlockExists(ctx, common.BytesToHash(vote.BlockHash))
+	if err != nil || !exists {
+		log.WithError(err).WithField(
+			"blockHash", common.BytesToHash(vote.BlockHash).Hex(),
+		).Debug("Ignoring eth1data vote for unknown block")
+		return false, nil
+	}
+	if height.Cmp(earliest) < 0 || height.Cmp(latest) > 0 {
+		return false, nil
+	}
+	candidate, err := vs.eth1DataAtHeight(ctx, height)
+	if err != nil {
+		return false, err
+	}
+	return candidate.DepositCount == vote.DepositCount &&
+		bytes.Equal(candidate.DepositRoot, vote.DepositRoot) &&
+		bytes.Equal(candidate.BlockHash, vote.BlockHash), nil
+}
+
+// eth1DataAtHeight returns the eth1data of the eth1 block at the given height, with the deposit
+// root and count of the deposits up to the block. The eth1data of the chain start is returned if
+// there are no deposits up to the block.
+func (vs *Server) eth1DataAtHeight(ctx context.Context, height *big.Int) (*ethpb.Eth1Data, error) {
+	blockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch eth1 block at height %d", height)
+	}
+	depositCount, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, height)
+	if depositCount == 0 {
+		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
+	}
+	return &ethpb.Eth1Data{
+		DepositRoot:  depositRoot[:],
+		BlockHash:    blockHash[:],
+		DepositCount: depositCount,
+	}, nil
+}
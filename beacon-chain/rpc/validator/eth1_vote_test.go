@@ -0,0 +1,207 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestEth1DataMajorityVote(t *testing.T) {
+	ctx := context.Background()
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().GoerliBlockTime
+	// Start slot of the first voting period starting at least twice the follow time after genesis.
+	slot := (2*followTime/(votingPeriod*params.BeaconConfig().SecondsPerSlot) + 1) * votingPeriod
+	periodStart := slot * params.BeaconConfig().SecondsPerSlot
+
+	// The candidate blocks are the blocks 100 to 200.
+	p := &mockPOW.POWChain{
+		BlockNumberByHeight: map[uint64]*big.Int{
+			periodStart - followTime:   big.NewInt(200),
+			periodStart - 2*followTime: big.NewInt(100),
+		},
+		TimesByHeight: map[int]uint64{
+			100: periodStart - 2*followTime,
+		},
+		HashesByHeight: map[int][]byte{
+			50:  []byte("50"),
+			100: []byte("100"),
+			150: []byte("150"),
+			200: []byte("200"),
+			250: []byte("250"),
+		},
+		Eth1Data: &ethpb.Eth1Data{},
+	}
+	// Deposits at heights 50, 120, 150, 190 and 240, so that the blocks 100, 150, 200 and 250 have
+	// 1, 3, 4 and 5 deposits.
+	depositCache := depositcache.NewDepositCache()
+	for i, height := range []uint64{50, 120, 150, 190, 240} {
+		deposit := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}}}
+		depositCache.InsertDeposit(ctx, deposit, height, int64(i), [32]byte{byte(i + 1)})
+	}
+	vs := &Server{
+		ChainStartFetcher: p,
+		Eth1InfoFetcher:   p,
+		Eth1BlockFetcher:  p,
+		DepositFetcher:    depositCache,
+	}
+	candidate := func(height int64) *ethpb.Eth1Data {
+		eth1Data, err := vs.eth1DataAtHeight(ctx, big.NewInt(height))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return eth1Data
+	}
+	wrongCount := candidate(150)
+	wrongCount.DepositCount = 2
+	unknownBlock := &ethpb.Eth1Data{DepositCount: 4, BlockHash: []byte("unknown")}
+	stateEth1Data := &ethpb.Eth1Data{DepositCount: 1, BlockHash: []byte("50")}
+
+	tests := []struct {
+		name          string
+		stateSlot     uint64
+		stateEth1Data *ethpb.Eth1Data
+		votes         []*ethpb.Eth1Data
+		want          *ethpb.Eth1Data
+	}{
+		{
+			name: "no votes defaults to latest candidate",
+			want: candidate(200),
+		},
+		{
+			name:  "most voted candidate",
+			votes: []*ethpb.Eth1Data{candidate(150), candidate(100), candidate(150)},
+			want:  candidate(150),
+		},
+		{
+			name:  "tie broken by earliest vote",
+			votes: []*ethpb.Eth1Data{candidate(100), candidate(150), candidate(150), candidate(100)},
+			want:  candidate(100),
+		},
+		{
+			name:  "votes for blocks outside the window are ignored",
+			votes: []*ethpb.Eth1Data{candidate(250), candidate(250), candidate(50), candidate(100)},
+			want:  candidate(100),
+		},
+		{
+			name:  "votes for unknown blocks are ignored",
+			votes: []*ethpb.Eth1Data{unknownBlock, unknownBlock, candidate(150)},
+			want:  candidate(150),
+		},
+		{
+			name:  "votes not matching the eth1data of their block are ignored",
+			votes: []*ethpb.Eth1Data{wrongCount, wrongCount, candidate(100)},
+			want:  candidate(100),
+		},
+		{
+			name:          "votes below the deposit count of the state are ignored",
+			stateEth1Data: &ethpb.Eth1Data{DepositCount: 3},
+			votes:         []*ethpb.Eth1Data{candidate(100), candidate(100), candidate(150)},
+			want:          candidate(150),
+		},
+		{
+			name:          "no candidate with the deposit count of the state",
+			stateEth1Data: &ethpb.Eth1Data{DepositCount: 5, BlockHash: []byte("250")},
+			votes:         []*ethpb.Eth1Data{candidate(200)},
+			want:          &ethpb.Eth1Data{DepositCount: 5, BlockHash: []byte("250")},
+		},
+		{
+			name:      "votes of the previous voting period are ignored",
+			stateSlot: slot - 1,
+			votes:     []*ethpb.Eth1Data{candidate(100), candidate(100)},
+			want:      candidate(200),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &pbp2p.BeaconState{
+				Slot:          slot,
+				Eth1Data:      stateEth1Data,
+				Eth1DataVotes: tt.votes,
+			}
+			if tt.stateSlot != 0 {
+				st.Slot = tt.stateSlot
+			}
+			if tt.stateEth1Data != nil {
+				st.Eth1Data = tt.stateEth1Data
+			}
+			vs.HeadFetcher = &mock.ChainService{State: st}
+			vote, err := vs.eth1DataMajorityVote(ctx, slot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(vote, tt.want) {
+				t.Errorf("Wanted vote %v, received %v", tt.want, vote)
+			}
+		})
+	}
+}
+
+func TestEth1CandidateRange(t *testing.T) {
+	ctx := context.Background()
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().GoerliBlockTime
+	slot := (2*followTime/(votingPeriod*params.BeaconConfig().SecondsPerSlot) + 1) * votingPeriod
+	periodStart := slot * params.BeaconConfig().SecondsPerSlot
+
+	tests := []struct {
+		name         string
+		slot         uint64
+		earliestTime uint64
+		wantEarliest int64
+		wantLatest   int64
+	}{
+		{
+			name:         "earliest block at the start of the window",
+			slot:         slot + 1,
+			earliestTime: periodStart - 2*followTime,
+			wantEarliest: 100,
+			wantLatest:   200,
+		},
+		{
+			name:         "earliest block before the start of the window",
+			slot:         slot,
+			earliestTime: periodStart - 2*followTime - 1,
+			wantEarliest: 101,
+			wantLatest:   200,
+		},
+		{
+			name:         "window starting before genesis",
+			slot:         votingPeriod - 1,
+			wantEarliest: 0,
+			wantLatest:   -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &mockPOW.POWChain{
+				BlockNumberByHeight: map[uint64]*big.Int{
+					periodStart - followTime:   big.NewInt(200),
+					periodStart - 2*followTime: big.NewInt(100),
+				},
+				TimesByHeight: map[int]uint64{
+					100: tt.earliestTime,
+				},
+			}
+			vs := &Server{
+				Eth1InfoFetcher:  p,
+				Eth1BlockFetcher: p,
+			}
+			earliest, latest, err := vs.eth1CandidateRange(ctx, tt.slot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if earliest.Int64() != tt.wantEarliest || latest.Int64() != tt.wantLatest {
+				t.Errorf("Wanted range %d to %d, received %d to %d", tt.wantEarliest, tt.wantLatest, earliest, latest)
+			}
+		})
+	}
+}
@@ -843,13 +843,17 @@ func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
 		BlockReceiver:     &mock.ChainService{State: beaconState},
 		HeadFetcher:       &mock.ChainService{State: beaconState},
 	}
-	want := "could not fetch ETH1_FOLLOW_DISTANCE ancestor"
-	if _, err := proposerServer.eth1Data(context.Background(), beaconState.Slot+1); !strings.Contains(err.Error(), want) {
+	// A voting period starting at least the follow time after genesis, so that it has candidates.
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().GoerliBlockTime
+	slot := (followTime/(votingPeriod*params.BeaconConfig().SecondsPerSlot) + 1) * votingPeriod
+	want := "could not fetch eth1 block at height"
+	if _, err := proposerServer.eth1Data(context.Background(), slot); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected error %v, received %v", want, err)
 	}
 }
 
-func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
+func TestEth1DataAtHeight_NoDeposits(t *testing.T) {
 	ctx := context.Background()
 
 	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
@@ -907,7 +911,7 @@ func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
 
 	p.Eth1Data = defEth1Data
 
-	result, err := proposerServer.defaultEth1DataResponse(ctx, big.NewInt(1500))
+	result, err := proposerServer.eth1DataAtHeight(ctx, big.NewInt(476))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -917,17 +921,22 @@ func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
 	}
 }
 
-// TODO(2312): Add more tests for edge cases and better coverage.
 func TestEth1Data(t *testing.T) {
-
-	slot := uint64(10000)
+	votingPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().GoerliBlockTime
+	slot := (2*followTime/(votingPeriod*params.BeaconConfig().SecondsPerSlot) + 1) * votingPeriod
+	periodStart := slot * params.BeaconConfig().SecondsPerSlot
 
 	p := &mockPOW.POWChain{
 		BlockNumberByHeight: map[uint64]*big.Int{
-			slot * params.BeaconConfig().SecondsPerSlot: big.NewInt(4096),
+			periodStart - followTime:   big.NewInt(4096),
+			periodStart - 2*followTime: big.NewInt(3072),
+		},
+		TimesByHeight: map[int]uint64{
+			3072: periodStart - 2*followTime,
 		},
 		HashesByHeight: map[int][]byte{
-			3072: []byte("3072"),
+			4096: []byte("4096"),
 		},
 		Eth1Data: &ethpb.Eth1Data{
 			DepositCount: 55,
@@ -938,6 +947,7 @@ func TestEth1Data(t *testing.T) {
 		Eth1InfoFetcher:   p,
 		Eth1BlockFetcher:  p,
 		DepositFetcher:    depositcache.NewDepositCache(),
+		HeadFetcher:       &mock.ChainService{State: &pbp2p.BeaconState{Eth1Data: &ethpb.Eth1Data{}}},
 	}
 
 	ctx := context.Background()
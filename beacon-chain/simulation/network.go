@@ -0,0 +1,387 @@
+// Package simulation runs a network of beacon nodes in a single process, on top of an in-memory
+// libp2p network. Every node runs the real p2p, blockchain, sync and optionally RPC services, while
+// the topology of the network, such as partitions, link latency and peer churn, as well as the
+// passing of slots, is scripted by the test. This allows exercising gossip propagation, sync and
+// fork choice in plain go tests, without waiting for slots to elapse.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// pollingInterval is the interval at which the state of the nodes is polled, while waiting for a
+// condition to be met.
+const pollingInterval = 100 * time.Millisecond
+
+// Config for a simulated network.
+type Config struct {
+	// NumNodes is the number of beacon nodes in the network.
+	NumNodes int
+	// NumValidators is the number of validators in the genesis state.
+	NumValidators uint64
+	// GenesisTime of the chain. Defaults to the current time. Regardless of the genesis time, the
+	// simulated clock of the network starts at the genesis slot.
+	GenesisTime time.Time
+	// Latency of the links between the nodes.
+	Latency time.Duration
+	// Encoding of the p2p messages. Defaults to ssz.
+	Encoding string
+	// EnableRPC runs the RPC service of every node on a free port of the loopback interface.
+	EnableRPC bool
+}
+
+// Network is a set of beacon nodes, connected over an in-memory libp2p network.
+type Network struct {
+	Nodes []*Node
+
+	cfg      *Config
+	mn       mocknet.Mocknet
+	privKeys []*bls.SecretKey
+	slot     uint64
+	lock     sync.Mutex
+}
+
+// NewNetwork creates the nodes of a simulated network, and links all of them to each other. The
+// nodes are neither started nor connected, until Start is called.
+func NewNetwork(t testing.TB, cfg *Config) (*Network, error) {
+	if cfg.NumNodes <= 0 {
+		return nil, errors.New("a network requires at least one node")
+	}
+	if cfg.NumValidators == 0 {
+		cfg.NumValidators = params.BeaconConfig().MinGenesisActiveValidatorCount
+	}
+	if cfg.GenesisTime.IsZero() {
+		cfg.GenesisTime = roughtime.Now()
+	}
+	// The genesis time of the state has a precision of a second.
+	cfg.GenesisTime = time.Unix(cfg.GenesisTime.Unix(), 0)
+	if cfg.Encoding == "" {
+		cfg.Encoding = encoder.SSZ
+	}
+
+	privKeys, _, err := interop.DeterministicallyGenerateKeys(0 /*startIndex*/, cfg.NumValidators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate validator keys")
+	}
+
+	mn := mocknet.New(context.Background())
+	mn.SetLinkDefaults(mocknet.LinkOptions{Latency: cfg.Latency})
+	n := &Network{
+		cfg:      cfg,
+		mn:       mn,
+		privKeys: privKeys,
+		Nodes:    make([]*Node, cfg.NumNodes),
+	}
+	for i := 0; i < cfg.NumNodes; i++ {
+		h, err := mn.GenPeer()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create host")
+		}
+		n.Nodes[i], err = newNode(t, i, h, cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create node %d", i)
+		}
+	}
+	if err := mn.LinkAll(); err != nil {
+		return nil, errors.Wrap(err, "could not link nodes")
+	}
+	n.setClock(0)
+	return n, nil
+}
+
+// Start runs the services of the given nodes, or of every node of the network if no node is given,
+// and connects every running node to every other running node. Nodes which are not started yet
+// stay offline, so that they can join the network later on, for example to exercise initial sync.
+func (n *Network) Start(indices ...int) error {
+	if len(indices) == 0 {
+		for i := range n.Nodes {
+			indices = append(indices, i)
+		}
+	}
+	n.lock.Lock()
+	for _, i := range indices {
+		if i < 0 || i >= len(n.Nodes) {
+			n.lock.Unlock()
+			return fmt.Errorf("node index %d out of range", i)
+		}
+		if !n.Nodes[i].started {
+			n.Nodes[i].start()
+		}
+	}
+	n.lock.Unlock()
+	return n.Heal()
+}
+
+// Stop terminates all the nodes of the network, and restores the clock of the process.
+func (n *Network) Stop() {
+	for _, node := range n.Nodes {
+		node.stop()
+	}
+	roughtime.SetOffset(0)
+}
+
+// Slot returns the current slot of the simulated clock of the network.
+func (n *Network) Slot() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.slot
+}
+
+// AdvanceSlots moves the simulated clock of the network forward by the given number of slots,
+// to the start of the resulting slot, without waiting for the slots to elapse.
+func (n *Network) AdvanceSlots(slots uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.setClock(n.slot + slots)
+}
+
+// setClock sets the clock shared by all the nodes to the start of the given slot. Time keeps
+// flowing from there, but a test is not expected to spend a whole slot before advancing the clock
+// again.
+func (n *Network) setClock(slot uint64) {
+	n.slot = slot
+	slotStart := n.cfg.GenesisTime.Add(time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	roughtime.SetOffset(slotStart.Sub(time.Now()))
+}
+
+// Partition splits the network into the given groups of node indices. Nodes in different groups
+// are disconnected and can no longer reach each other, while nodes in the same group are connected.
+// Nodes which are not part of any group are isolated from all the other nodes.
+func (n *Network) Partition(groups ...[]int) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	group := make(map[int]int)
+	for g, indices := range groups {
+		for _, i := range indices {
+			if i < 0 || i >= len(n.Nodes) {
+				return fmt.Errorf("node index %d out of range", i)
+			}
+			group[i] = g
+		}
+	}
+	for i := range n.Nodes {
+		for j := i + 1; j < len(n.Nodes); j++ {
+			gi, iOK := group[i]
+			gj, jOK := group[j]
+			var err error
+			if iOK && jOK && gi == gj {
+				err = n.connect(i, j)
+			} else {
+				err = n.disconnect(i, j)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Heal removes any partition, and connects every running node to every other running node.
+func (n *Network) Heal() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for i := range n.Nodes {
+		for j := i + 1; j < len(n.Nodes); j++ {
+			if err := n.connect(i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Isolate disconnects a node from all the other nodes, as if it went offline.
+func (n *Network) Isolate(index int) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for j := range n.Nodes {
+		if j == index {
+			continue
+		}
+		if err := n.disconnect(index, j); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rejoin connects a previously isolated node to all the other running nodes.
+func (n *Network) Rejoin(index int) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for j := range n.Nodes {
+		if j == index {
+			continue
+		}
+		if err := n.connect(index, j); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetLatency sets the latency of all the existing links between the nodes, as well as of the
+// links created later on.
+func (n *Network) SetLatency(latency time.Duration) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	opts := mocknet.LinkOptions{Latency: latency}
+	n.mn.SetLinkDefaults(opts)
+	for _, l := range n.links() {
+		l.SetOptions(opts)
+	}
+}
+
+// links returns all the links between the nodes.
+func (n *Network) links() []mocknet.Link {
+	var links []mocknet.Link
+	for i := range n.Nodes {
+		for j := i + 1; j < len(n.Nodes); j++ {
+			links = append(links, n.mn.LinksBetweenPeers(n.peerID(i), n.peerID(j))...)
+		}
+	}
+	return links
+}
+
+// connect links two nodes, and opens a connection between them, unless one exists already or one
+// of the nodes is not running.
+func (n *Network) connect(i, j int) error {
+	if !n.Nodes[i].started || !n.Nodes[j].started {
+		return nil
+	}
+	a, b := n.peerID(i), n.peerID(j)
+	if len(n.mn.LinksBetweenPeers(a, b)) == 0 {
+		if _, err := n.mn.LinkPeers(a, b); err != nil {
+			return errors.Wrapf(err, "could not link nodes %d and %d", i, j)
+		}
+	}
+	if len(n.mn.Net(a).ConnsToPeer(b)) > 0 {
+		return nil
+	}
+	if _, err := n.mn.ConnectPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not connect nodes %d and %d", i, j)
+	}
+	return nil
+}
+
+// disconnect closes the connections between two nodes, and unlinks them so that they cannot
+// reconnect to each other.
+func (n *Network) disconnect(i, j int) error {
+	a, b := n.peerID(i), n.peerID(j)
+	if len(n.mn.LinksBetweenPeers(a, b)) == 0 {
+		return nil
+	}
+	if err := n.mn.UnlinkPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not unlink nodes %d and %d", i, j)
+	}
+	if err := n.mn.DisconnectPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not disconnect nodes %d and %d", i, j)
+	}
+	return nil
+}
+
+func (n *Network) peerID(index int) peer.ID {
+	return n.Nodes[index].host.ID()
+}
+
+// ProposeBlock builds a block for the given slot on top of the head of a node, on behalf of the
+// expected proposer, and submits it to the node, which processes it and gossips it to its peers.
+// Unless the slot starts an epoch, the block includes the attestations of a committee of the
+// previous slot to the head of the node, so that the fork it extends gains weight in fork choice.
+func (n *Network) ProposeBlock(ctx context.Context, index int, slot uint64) (*ethpb.SignedBeaconBlock, error) {
+	node := n.Nodes[index]
+	if current := n.Slot(); slot > current {
+		return nil, fmt.Errorf("cannot propose a block for slot %d ahead of the current slot %d", slot, current)
+	}
+	headState, err := node.Chain.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	// Generated attestations use the epoch of the block as target, which does not match the slot
+	// of the attestations at the start of an epoch.
+	conf := testutil.DefaultBlockGenConfig()
+	if helpers.IsEpochStart(slot) {
+		conf.NumAttestations = 0
+	}
+	blk, err := testutil.GenerateFullBlock(headState, n.privKeys, conf, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate block")
+	}
+	if err := node.Chain.ReceiveBlock(ctx, blk); err != nil {
+		return nil, errors.Wrap(err, "could not process block")
+	}
+	return blk, nil
+}
+
+// WaitForBlock waits until the given block is the head of all the given nodes, or of every node
+// of the network if no node is given.
+func (n *Network) WaitForBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock, indices ...int) error {
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not get block root")
+	}
+	if len(indices) == 0 {
+		for i := range n.Nodes {
+			indices = append(indices, i)
+		}
+	}
+	for _, i := range indices {
+		node := n.Nodes[i]
+		if err := waitFor(ctx, func() (bool, error) {
+			head, err := node.HeadRoot(ctx)
+			if err != nil {
+				return false, err
+			}
+			return head == root, nil
+		}); err != nil {
+			return errors.Wrapf(err, "node %d did not reach head %#x", i, root)
+		}
+	}
+	return nil
+}
+
+// WaitForPeers waits until every running node is connected to the given number of peers, which
+// are subscribed to the block gossip topic, so that blocks can be propagated.
+func (n *Network) WaitForPeers(ctx context.Context, numPeers int) error {
+	for _, node := range n.Nodes {
+		node := node
+		if !node.started {
+			continue
+		}
+		topic := p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.SignedBeaconBlock{})] + node.P2P.Encoding().ProtocolSuffix()
+		if err := waitFor(ctx, func() (bool, error) {
+			if len(node.P2P.Peers().Connected()) < numPeers {
+				return false, nil
+			}
+			return len(node.P2P.PubSub().ListPeers(topic)) >= numPeers, nil
+		}); err != nil {
+			return errors.Wrapf(err, "node %d did not reach %d peers", node.Index, numPeers)
+		}
+	}
+	return nil
+}
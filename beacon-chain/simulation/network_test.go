@@ -0,0 +1,249 @@
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+const waitTimeout = 30 * time.Second
+
+func setupNetwork(t *testing.T, numNodes int) *Network {
+	params.UseMinimalConfig()
+	n, err := NewNetwork(t, &Config{
+		NumNodes:      numNodes,
+		NumValidators: params.BeaconConfig().MinGenesisActiveValidatorCount,
+		Latency:       10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Start(); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+	defer cancel()
+	if err := n.WaitForPeers(ctx, numNodes-1); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestNetwork_BlockPropagation(t *testing.T) {
+	n := setupNetwork(t, 3)
+	defer params.UseMainnetConfig()
+	defer n.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+	defer cancel()
+	n.AdvanceSlots(1)
+	blk, err := n.ProposeBlock(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetwork_PartitionAndHeal(t *testing.T) {
+	n := setupNetwork(t, 3)
+	defer params.UseMainnetConfig()
+	defer n.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*waitTimeout)
+	defer cancel()
+	genesisRoot, err := n.Nodes[2].HeadRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := n.Partition([]int{0, 1}, []int{2}); err != nil {
+		t.Fatal(err)
+	}
+	n.AdvanceSlots(1)
+	blk1, err := n.ProposeBlock(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForBlock(ctx, blk1, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	head, err := n.Nodes[2].HeadRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != genesisRoot {
+		t.Fatal("Expected partitioned node not to receive the block")
+	}
+
+	// Once healed, the partitioned node catches up by requesting the missing parent of the next
+	// block from its peers.
+	if err := n.Heal(); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForPeers(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	n.AdvanceSlots(1)
+	blk2, err := n.ProposeBlock(ctx, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForBlock(ctx, blk2); err != nil {
+		t.Fatal(err)
+	}
+	root1, err := ssz.HashTreeRoot(blk1.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !n.Nodes[2].DB.HasBlock(ctx, root1) {
+		t.Error("Expected partitioned node to fetch the missed block")
+	}
+}
+
+func TestNetwork_IsolateAndRejoin(t *testing.T) {
+	n := setupNetwork(t, 2)
+	defer params.UseMainnetConfig()
+	defer n.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+	defer cancel()
+	if err := n.Isolate(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitFor(ctx, func() (bool, error) {
+		return len(n.Nodes[0].P2P.Peers().Connected()) == 0, nil
+	}); err != nil {
+		t.Fatalf("Expected isolated node to be disconnected: %v", err)
+	}
+	if err := n.Rejoin(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForPeers(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetwork_Reorg(t *testing.T) {
+	n := setupNetwork(t, 3)
+	defer params.UseMainnetConfig()
+	defer n.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*waitTimeout)
+	defer cancel()
+	if err := n.Partition([]int{0, 1}, []int{2}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The majority side builds a single block, which attests to nothing but the genesis block,
+	// while the minority side builds a fork whose blocks attest to each other.
+	n.AdvanceSlots(1)
+	forkA, err := n.ProposeBlock(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForBlock(ctx, forkA, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	var forkB *ethpb.SignedBeaconBlock
+	for i := 0; i < 2; i++ {
+		n.AdvanceSlots(1)
+		forkB, err = n.ProposeBlock(ctx, 2, n.Slot())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := n.WaitForBlock(ctx, forkB, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once healed, the next block of the heavier fork reorgs the majority side onto it.
+	if err := n.Heal(); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForPeers(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	n.AdvanceSlots(1)
+	blk, err := n.ProposeBlock(ctx, 2, n.Slot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	rootA, err := ssz.HashTreeRoot(forkA.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range n.Nodes[:2] {
+		if !node.DB.HasBlock(ctx, rootA) {
+			t.Errorf("Expected node %d to keep the orphaned block", node.Index)
+		}
+	}
+}
+
+func TestNetwork_InitialSync(t *testing.T) {
+	params.UseMinimalConfig()
+	defer params.UseMainnetConfig()
+	flags.Init(&flags.GlobalFlags{MinimumSyncPeers: 1})
+	defer flags.Init(&flags.GlobalFlags{})
+
+	n, err := NewNetwork(t, &Config{
+		NumNodes:      2,
+		NumValidators: params.BeaconConfig().MinGenesisActiveValidatorCount,
+		Latency:       10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Stop()
+	if err := n.Start(0); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*waitTimeout)
+	defer cancel()
+	// The chain advances by a couple of epochs while the second node is offline.
+	var blk *ethpb.SignedBeaconBlock
+	for i := uint64(0); i < 2*params.BeaconConfig().SlotsPerEpoch; i++ {
+		n.AdvanceSlots(1)
+		blk, err = n.ProposeBlock(ctx, 0, n.Slot())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The second node starts epochs behind the current slot, so it catches up through initial
+	// sync rather than through gossip.
+	if err := n.Start(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.WaitForBlock(ctx, blk, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitFor(ctx, func() (bool, error) {
+		return !n.Nodes[1].InitialSync.Syncing(), nil
+	}); err != nil {
+		t.Fatalf("Expected node to complete initial sync: %v", err)
+	}
+}
+
+func TestNetwork_StopRestoresClock(t *testing.T) {
+	n := &Network{cfg: &Config{GenesisTime: time.Now()}}
+	n.AdvanceSlots(10)
+	if roughtime.Now().Sub(time.Now()) < time.Duration(9*params.BeaconConfig().SecondsPerSlot)*time.Second {
+		t.Fatal("Expected the clock to be advanced")
+	}
+	n.Stop()
+	if d := roughtime.Now().Sub(time.Now()); d > time.Second {
+		t.Errorf("Expected the clock to be restored, still %v ahead", d)
+	}
+}
@@ -0,0 +1,243 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxRoutines is the goroutine limit of the blockchain service of a simulated node.
+const maxRoutines = 10000
+
+// Node is a single beacon node of a simulated network, running the p2p, blockchain, sync and
+// optionally the RPC services in process.
+type Node struct {
+	Index       int
+	DB          db.Database
+	P2P         *p2p.Service
+	Chain       *blockchain.Service
+	InitialSync *initialsync.Service
+	Sync        *prysmsync.Service
+	RPC         *rpc.Service
+	// RPCAddress is the address the RPC service of the node listens on, if enabled.
+	RPCAddress string
+
+	t         testing.TB
+	host      host.Host
+	started   bool
+	services  *shared.ServiceRegistry
+	stateFeed *event.Feed
+	opFeed    *event.Feed
+}
+
+// StateFeed implements statefeed.Notifier.
+func (n *Node) StateFeed() *event.Feed {
+	return n.stateFeed
+}
+
+// OperationFeed implements opfeed.Notifier.
+func (n *Node) OperationFeed() *event.Feed {
+	return n.opFeed
+}
+
+// newNode wires the services of a beacon node on top of the given libp2p host. The database of
+// the node is seeded with the deterministic interop genesis state, shared by all the nodes of the
+// network.
+func newNode(t testing.TB, index int, h host.Host, cfg *Config) (*Node, error) {
+	n := &Node{
+		Index:     index,
+		DB:        dbtest.SetupDB(t),
+		t:         t,
+		host:      h,
+		services:  shared.NewServiceRegistry(),
+		stateFeed: new(event.Feed),
+		opFeed:    new(event.Feed),
+	}
+
+	ctx := context.Background()
+	depositCache := depositcache.NewDepositCache()
+	attPool := attestations.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	coldStart := interopcoldstart.NewColdStartService(ctx, &interopcoldstart.Config{
+		GenesisTime:   uint64(cfg.GenesisTime.Unix()),
+		NumValidators: cfg.NumValidators,
+		BeaconDB:      n.DB,
+		DepositCache:  depositCache,
+	})
+	if err := n.services.RegisterService(coldStart); err != nil {
+		return nil, err
+	}
+
+	p, err := p2p.NewServiceWithHost(&p2p.Config{
+		MaxPeers: uint(cfg.NumNodes),
+		Encoding: cfg.Encoding,
+	}, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create p2p service")
+	}
+	n.P2P = p
+	if err := n.services.RegisterService(p); err != nil {
+		return nil, err
+	}
+
+	n.Chain, err = blockchain.NewService(ctx, &blockchain.Config{
+		BeaconDB:          n.DB,
+		DepositCache:      depositCache,
+		ChainStartFetcher: coldStart,
+		AttPool:           attPool,
+		ExitPool:          exitPool,
+		P2p:               p,
+		MaxRoutines:       maxRoutines,
+		StateNotifier:     n,
+		ForkChoiceStore:   protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create blockchain service")
+	}
+	if err := n.services.RegisterService(n.Chain); err != nil {
+		return nil, err
+	}
+
+	n.InitialSync = initialsync.NewInitialSync(&initialsync.Config{
+		DB:            n.DB,
+		Chain:         n.Chain,
+		P2P:           p,
+		StateNotifier: n,
+	})
+	if err := n.services.RegisterService(n.InitialSync); err != nil {
+		return nil, err
+	}
+
+	n.Sync = prysmsync.NewRegularSync(&prysmsync.Config{
+		DB:            n.DB,
+		P2P:           p,
+		Chain:         n.Chain,
+		InitialSync:   n.InitialSync,
+		StateNotifier: n,
+		AttPool:       attPool,
+		ExitPool:      exitPool,
+	})
+	if err := n.services.RegisterService(n.Sync); err != nil {
+		return nil, err
+	}
+
+	if cfg.EnableRPC {
+		port, err := freePort()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not find a port for the RPC service")
+		}
+		n.RPCAddress = fmt.Sprintf("127.0.0.1:%d", port)
+		n.RPC = rpc.NewService(ctx, &rpc.Config{
+			Host:                  "127.0.0.1",
+			Port:                  strconv.Itoa(port),
+			BeaconDB:              n.DB,
+			Broadcaster:           p,
+			PeersFetcher:          p,
+			HeadFetcher:           n.Chain,
+			ForkFetcher:           n.Chain,
+			FinalizationFetcher:   n.Chain,
+			ParticipationFetcher:  n.Chain,
+			BlockReceiver:         n.Chain,
+			AttestationReceiver:   n.Chain,
+			GenesisTimeFetcher:    n.Chain,
+			ForkChoiceFetcher:     n.Chain,
+			AttestationsPool:      attPool,
+			ExitPool:              exitPool,
+			POWChainService:       &mockPOW.POWChain{},
+			ChainStartFetcher:     coldStart,
+			MockEth1Votes:         true,
+			SyncService:           n.InitialSync,
+			SyncProgressFetcher:   n.InitialSync,
+			DepositFetcher:        coldStart,
+			PendingDepositFetcher: depositCache,
+			StateNotifier:         n,
+			OperationNotifier:     n,
+		})
+		if err := n.services.RegisterService(n.RPC); err != nil {
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+// start runs all the services of the node, in the order they were registered.
+func (n *Node) start() {
+	n.services.StartAll()
+	n.started = true
+}
+
+// stop terminates all the services of the node, if it was started, closes its host and removes its
+// database.
+func (n *Node) stop() {
+	if n.started {
+		n.services.StopAll()
+	}
+	if err := n.host.Close(); err != nil {
+		log.WithError(err).Errorf("Could not close host of node %d", n.Index)
+	}
+	dbtest.TeardownDB(n.t, n.DB)
+}
+
+// HeadRoot returns the root of the current head block of the node.
+func (n *Node) HeadRoot(ctx context.Context) ([32]byte, error) {
+	root, err := n.Chain.HeadRoot(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	var r [32]byte
+	copy(r[:], root)
+	return r, nil
+}
+
+// waitFor polls the given condition until it is met, or the context is done.
+func waitFor(ctx context.Context, condition func() (bool, error)) error {
+	ticker := time.NewTicker(pollingInterval)
+	defer ticker.Stop()
+	for {
+		ok, err := condition()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// freePort returns a TCP port, which is currently not in use on the loopback interface.
+func freePort() (int, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port, nil
+}
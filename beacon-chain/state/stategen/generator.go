@@ -0,0 +1,162 @@
+// Package stategen regenerates historical beacon states which are no longer stored in the
+// database, by replaying the blocks processed since the nearest stored state.
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// ErrUnknownBlock is returned when a block needed to regenerate a state is not in the database.
+	ErrUnknownBlock = errors.New("unknown block")
+	// ErrReplayLimitExceeded is returned when regenerating a state requires replaying more slots
+	// than allowed.
+	ErrReplayLimitExceeded = errors.New("replay limit exceeded")
+)
+
+// DefaultCacheSize is the default number of regenerated states kept in memory.
+const DefaultCacheSize = 8
+
+// Generator regenerates the states of the chain at any slot from the nearest state stored in the
+// database, keeping the most recently regenerated states in an LRU cache.
+type Generator struct {
+	beaconDB       db.ReadOnlyDatabase
+	maxReplaySlots uint64
+	cache          *lru.Cache
+}
+
+// Config options for the state generator.
+type Config struct {
+	BeaconDB db.ReadOnlyDatabase
+	// CacheSize is the number of regenerated states kept in memory.
+	CacheSize int
+	// MaxReplaySlots is the maximum number of slots processed to regenerate a state, unlimited if 0.
+	MaxReplaySlots uint64
+}
+
+// cacheKey identifies a state by the root of its latest block and its slot, which differ when
+// the slots following the block were skipped.
+type cacheKey struct {
+	blockRoot [32]byte
+	slot      uint64
+}
+
+// New initializes a state generator from configuration options.
+func New(cfg *Config) *Generator {
+	size := cfg.CacheSize
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &Generator{
+		beaconDB:       cfg.BeaconDB,
+		maxReplaySlots: cfg.MaxReplaySlots,
+		cache:          cache,
+	}
+}
+
+// StateByBlockRoot returns the post state of the block with the given root.
+func (g *Generator) StateByBlockRoot(ctx context.Context, blockRoot [32]byte) (*pb.BeaconState, error) {
+	b, err := g.beaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve block")
+	}
+	if b == nil || b.Block == nil {
+		return nil, errors.Wrapf(ErrUnknownBlock, "no block with root %#x", blockRoot)
+	}
+	return g.StateAtSlot(ctx, blockRoot, b.Block.Slot)
+}
+
+// StateAtSlot returns the state at the given slot of the chain ending with the block of the given
+// root, the slots after the block being skipped. The slot must not be before the slot of the block.
+//
+// The state is loaded from the database when stored, and otherwise regenerated from the nearest
+// stored state of an ancestor block, replaying the blocks since then.
+func (g *Generator) StateAtSlot(ctx context.Context, blockRoot [32]byte, slot uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stategen.StateAtSlot")
+	defer span.End()
+
+	key := cacheKey{blockRoot: blockRoot, slot: slot}
+	if cached, ok := g.cache.Get(key); ok {
+		return proto.Clone(cached.(*pb.BeaconState)).(*pb.BeaconState), nil
+	}
+
+	// Walk back the chain of the block until a stored state is found, collecting the blocks
+	// to replay on top of it.
+	var blocks []*ethpb.SignedBeaconBlock
+	var st *pb.BeaconState
+	root := blockRoot
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if g.beaconDB.HasState(ctx, root) {
+			stored, err := g.beaconDB.State(ctx, root)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not retrieve state")
+			}
+			// States saved for checkpoints may already be advanced past the slot requested.
+			if stored != nil && stored.Slot <= slot {
+				st = stored
+				break
+			}
+		}
+		b, err := g.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve block")
+		}
+		if b == nil || b.Block == nil {
+			return nil, errors.Wrapf(ErrUnknownBlock, "no block with root %#x", root)
+		}
+		if b.Block.Slot > slot {
+			return nil, fmt.Errorf("block at slot %d is after requested slot %d", b.Block.Slot, slot)
+		}
+		if g.maxReplaySlots > 0 && slot-b.Block.Slot > g.maxReplaySlots {
+			return nil, errors.Wrapf(
+				ErrReplayLimitExceeded,
+				"no stored state within %d slots of slot %d",
+				g.maxReplaySlots,
+				slot,
+			)
+		}
+		blocks = append(blocks, b)
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+	if g.maxReplaySlots > 0 && slot-st.Slot > g.maxReplaySlots {
+		return nil, errors.Wrapf(
+			ErrReplayLimitExceeded,
+			"no stored state within %d slots of slot %d",
+			g.maxReplaySlots,
+			slot,
+		)
+	}
+
+	var err error
+	for i := len(blocks) - 1; i >= 0; i-- {
+		st, err = state.ExecuteStateTransitionNoVerifyAttSigs(ctx, st, blocks[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not replay block at slot %d", blocks[i].Block.Slot)
+		}
+	}
+	st, err = state.ProcessSlots(ctx, st, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+	}
+
+	g.cache.Add(key, proto.Clone(st))
+	return st, nil
+}
@@ -0,0 +1,132 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func init() {
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+}
+
+// setupChain saves a chain of blocks at the given slots, only storing the state of the first
+// block. It returns the roots of the blocks along with their post states.
+func setupChain(t *testing.T, beaconDB db.Database, slots []uint64) ([][32]byte, []*pb.BeaconState) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	roots := make([][32]byte, len(slots))
+	states := make([]*pb.BeaconState, len(slots))
+	for i, slot := range slots {
+		b, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		roots[i], err = ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			if err := beaconDB.SaveState(ctx, beaconState, roots[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		states[i] = proto.Clone(beaconState).(*pb.BeaconState)
+	}
+	return roots, states
+}
+
+func TestGenerator_StateByBlockRoot(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	roots, states := setupChain(t, beaconDB, []uint64{1, 2, 4, 5})
+	g := New(&Config{BeaconDB: beaconDB})
+	for i, root := range roots {
+		st, err := g.StateByBlockRoot(ctx, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(st, states[i]) {
+			t.Errorf("Wrong state regenerated for block at slot %d", states[i].Slot)
+		}
+	}
+
+	if _, err := g.StateByBlockRoot(ctx, [32]byte{'a'}); errors.Cause(err) != ErrUnknownBlock {
+		t.Errorf("Expected unknown block error, received %v", err)
+	}
+}
+
+func TestGenerator_StateAtSlot_SkipSlots(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	roots, states := setupChain(t, beaconDB, []uint64{1, 2, 4})
+	g := New(&Config{BeaconDB: beaconDB})
+
+	// Slot 3 was skipped, so its state is the state of the block at slot 2 processed up to slot 3.
+	wanted, err := state.ProcessSlots(ctx, proto.Clone(states[1]).(*pb.BeaconState), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := g.StateAtSlot(ctx, roots[1], 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(st, wanted) {
+		t.Error("Wrong state regenerated for skipped slot")
+	}
+
+	// Regenerated states are cached, and callers can not modify the cached copy.
+	st.Slot = 100
+	if g.cache.Len() != 1 {
+		t.Errorf("Wanted 1 cached state, received %d", g.cache.Len())
+	}
+	st, err = g.StateAtSlot(ctx, roots[1], 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(st, wanted) {
+		t.Error("Wrong state retrieved from cache")
+	}
+
+	if _, err := g.StateAtSlot(ctx, roots[2], 3); err == nil {
+		t.Error("Expected error requesting a slot before the block")
+	}
+}
+
+func TestGenerator_StateAtSlot_ReplayLimit(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	roots, _ := setupChain(t, beaconDB, []uint64{1, 2, 3, 4})
+	g := New(&Config{BeaconDB: beaconDB, MaxReplaySlots: 2})
+	if _, err := g.StateAtSlot(ctx, roots[2], 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.StateAtSlot(ctx, roots[3], 4); errors.Cause(err) != ErrReplayLimitExceeded {
+		t.Errorf("Expected replay limit error, received %v", err)
+	}
+	if _, err := g.StateAtSlot(ctx, roots[0], 4); errors.Cause(err) != ErrReplayLimitExceeded {
+		t.Errorf("Expected replay limit error, received %v", err)
+	}
+}
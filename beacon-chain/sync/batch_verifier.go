@@ -0,0 +1,94 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// signatureVerificationInterval is the longest time a gossiped signature is buffered, before
+	// it is verified along with all the other signatures buffered in the meantime.
+	signatureVerificationInterval = 50 * time.Millisecond
+	// maxBufferedSignatureSets caps the number of signature sets verified in a single batch.
+	maxBufferedSignatureSets = 1000
+)
+
+// signatureVerifier is a request to verify a signature set, and to report the result back.
+type signatureVerifier struct {
+	set     *bls.SignatureSet
+	resChan chan bool
+}
+
+// verifierRoutine buffers incoming signature sets, and verifies them in batches every
+// signatureVerificationInterval, or as soon as maxBufferedSignatureSets are collected.
+func (r *Service) verifierRoutine() {
+	ticker := time.NewTicker(signatureVerificationInterval)
+	defer ticker.Stop()
+
+	verifiers := make([]*signatureVerifier, 0, maxBufferedSignatureSets)
+	for {
+		select {
+		case <-r.ctx.Done():
+			log.Debug("Context closed, exiting goroutine (batch verifier)")
+			return
+		case v := <-r.signatureChan:
+			verifiers = append(verifiers, v)
+			if len(verifiers) >= maxBufferedSignatureSets {
+				verifyBatch(verifiers)
+				verifiers = verifiers[:0]
+			}
+		case <-ticker.C:
+			if len(verifiers) > 0 {
+				verifyBatch(verifiers)
+				verifiers = verifiers[:0]
+			}
+		}
+	}
+}
+
+// validateWithBatchVerifier submits a signature set to the batch verifier, and waits for the
+// result. If batch verification is disabled, the set is verified right away.
+func (r *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureSet) bool {
+	ctx, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
+	defer span.End()
+
+	if !featureconfig.Get().EnableBatchVerification {
+		return set.Verify()
+	}
+
+	verifier := &signatureVerifier{set: set, resChan: make(chan bool, 1)}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-r.ctx.Done():
+		return false
+	case r.signatureChan <- verifier:
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-r.ctx.Done():
+		return false
+	case valid := <-verifier.resChan:
+		if !valid {
+			log.WithField("message", message).Debug("Rejected message with invalid signature")
+		}
+		return valid
+	}
+}
+
+// verifyBatch verifies the signature sets of all the given verifiers, and reports the results.
+func verifyBatch(verifiers []*signatureVerifier) {
+	sets := make([]*bls.SignatureSet, len(verifiers))
+	for i, v := range verifiers {
+		sets[i] = v.set
+	}
+	for i, valid := range bls.VerifyBatch(sets) {
+		verifiers[i].resChan <- valid
+	}
+}
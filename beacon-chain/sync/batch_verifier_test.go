@@ -0,0 +1,99 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+func TestValidateWithBatchVerifier(t *testing.T) {
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	validSet := func() *bls.SignatureSet {
+		priv := bls.RandKey()
+		return bls.NewSet().Add(priv.Sign(msg[:], 0), priv.PublicKey(), msg, 0)
+	}
+	invalidSet := func() *bls.SignatureSet {
+		priv := bls.RandKey()
+		return bls.NewSet().Add(priv.Sign([]byte("world"), 0), priv.PublicKey(), msg, 0)
+	}
+
+	tests := []struct {
+		name        string
+		enableBatch bool
+		sets        []*bls.SignatureSet
+		want        []bool
+	}{
+		{
+			name:        "batch verification disabled",
+			enableBatch: false,
+			sets:        []*bls.SignatureSet{validSet(), invalidSet()},
+			want:        []bool{true, false},
+		},
+		{
+			name:        "all valid",
+			enableBatch: true,
+			sets:        []*bls.SignatureSet{validSet(), validSet(), validSet()},
+			want:        []bool{true, true, true},
+		},
+		{
+			name:        "invalid sets are rejected individually",
+			enableBatch: true,
+			sets:        []*bls.SignatureSet{validSet(), invalidSet(), validSet(), invalidSet()},
+			want:        []bool{true, false, true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureconfig.Init(&featureconfig.Flags{EnableBatchVerification: tt.enableBatch})
+			defer featureconfig.Init(nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			r := &Service{
+				ctx:           ctx,
+				signatureChan: make(chan *signatureVerifier, maxBufferedSignatureSets),
+			}
+			if tt.enableBatch {
+				go r.verifierRoutine()
+			}
+
+			got := make([]bool, len(tt.sets))
+			var wg sync.WaitGroup
+			for i, set := range tt.sets {
+				wg.Add(1)
+				go func(i int, set *bls.SignatureSet) {
+					defer wg.Done()
+					got[i] = r.validateWithBatchVerifier(ctx, "test", set)
+				}(i, set)
+			}
+			wg.Wait()
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Unexpected result for set %d, wanted %v, received %v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateWithBatchVerifier_ContextCanceled(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableBatchVerification: true})
+	defer featureconfig.Init(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Service{
+		ctx:           ctx,
+		signatureChan: make(chan *signatureVerifier, maxBufferedSignatureSets),
+	}
+	cancel()
+
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	priv := bls.RandKey()
+	set := bls.NewSet().Add(priv.Sign(msg[:], 0), priv.PublicKey(), msg, 0)
+	if r.validateWithBatchVerifier(context.Background(), "test", set) {
+		t.Error("Expected validation to fail once the service is stopped")
+	}
+}
@@ -0,0 +1,307 @@
+package initialsync
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxPendingRequestsPerPeer is the number of batch requests that may be in flight to a
+	// single peer at any given time.
+	maxPendingRequestsPerPeer = 2
+	// peerAvailabilityPollInterval defines how often the fetcher re-checks for an available
+	// peer when all suitable peers are busy or none are connected.
+	peerAvailabilityPollInterval = 100 * time.Millisecond
+)
+
+var (
+	errNoPeersAvailable   = errors.New("no peers available to request blocks")
+	errFetcherCtxIsDone   = errors.New("fetcher's context is done, reinitialize")
+	errInvalidFetchedData = errors.New("invalid data returned from peer")
+)
+
+// blocksFetcherConfig is a config to setup the block fetcher.
+type blocksFetcherConfig struct {
+	p2p         p2p.P2P
+	rateLimiter *leakybucket.Collector
+}
+
+// blocksFetcher is a service to fetch chain data from peers.
+// On an incoming request, the requested block range is assigned to the least loaded suitable
+// peer. Several requests may be outstanding against the same peer, and a failed request is
+// retried against the remaining peers before an error is returned.
+type blocksFetcher struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	p2p            p2p.P2P
+	rateLimiter    *leakybucket.Collector
+	rand           *rand.Rand // guarded by peerLoadLock
+	peerLoad       map[peer.ID]int
+	peerLoadLock   sync.Mutex
+	fetchRequests  chan *fetchRequestParams
+	fetchResponses chan *fetchRequestResponse
+	wg             sync.WaitGroup
+	quit           chan struct{} // termination notifier
+}
+
+// fetchRequestParams holds parameters necessary to schedule a fetch request.
+type fetchRequestParams struct {
+	ctx   context.Context // if provided, it is used instead of global fetcher's context
+	start uint64          // starting slot
+	count uint64          // how many slots to receive (fetcher may return fewer slots)
+}
+
+// fetchRequestResponse is a combined type to hold results of both successful executions and errors.
+// Valid usage pattern will be to check whether result's `err` is nil, before using `blocks`.
+type fetchRequestResponse struct {
+	start, count uint64
+	blocks       []*eth.SignedBeaconBlock
+	pid          peer.ID
+	err          error
+}
+
+// newBlocksFetcher creates ready to use fetcher.
+func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetcher {
+	ctx, cancel := context.WithCancel(ctx)
+	return &blocksFetcher{
+		ctx:            ctx,
+		cancel:         cancel,
+		p2p:            cfg.p2p,
+		rateLimiter:    cfg.rateLimiter,
+		rand:           rand.New(rand.NewSource(time.Now().Unix())),
+		peerLoad:       make(map[peer.ID]int),
+		fetchRequests:  make(chan *fetchRequestParams, queueMaxPendingRequests),
+		fetchResponses: make(chan *fetchRequestResponse, queueMaxPendingRequests),
+		quit:           make(chan struct{}),
+	}
+}
+
+// start boots up the fetcher, which starts listening for incoming fetch requests.
+func (f *blocksFetcher) start() error {
+	select {
+	case <-f.ctx.Done():
+		return errFetcherCtxIsDone
+	default:
+		go f.loop()
+		return nil
+	}
+}
+
+// stop terminates all fetcher operations.
+func (f *blocksFetcher) stop() {
+	f.cancel()
+	<-f.quit // make sure that loop() is done
+}
+
+// requestResponses exposes a channel into which fetcher pushes generated request responses.
+func (f *blocksFetcher) requestResponses() <-chan *fetchRequestResponse {
+	return f.fetchResponses
+}
+
+// loop is a main fetcher loop, listens for incoming requests/cancellations, forwards outgoing responses.
+func (f *blocksFetcher) loop() {
+	defer close(f.quit)
+
+	// Wait for all in-flight requests to complete before closing the response channel.
+	defer func() {
+		f.wg.Wait()
+		close(f.fetchResponses)
+	}()
+
+	for {
+		select {
+		case <-f.ctx.Done():
+			log.Debug("Context closed, exiting goroutine (blocks fetcher)")
+			return
+		case req := <-f.fetchRequests:
+			f.wg.Add(1)
+			go func() {
+				defer f.wg.Done()
+				resp := f.handleRequest(req.ctx, req.start, req.count)
+				select {
+				case <-f.ctx.Done():
+				case f.fetchResponses <- resp:
+				}
+			}()
+		}
+	}
+}
+
+// scheduleRequest adds request to incoming queue.
+func (f *blocksFetcher) scheduleRequest(ctx context.Context, start, count uint64) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	request := &fetchRequestParams{
+		ctx:   ctx,
+		start: start,
+		count: count,
+	}
+	select {
+	case <-f.ctx.Done():
+		return errFetcherCtxIsDone
+	case f.fetchRequests <- request:
+	}
+	return nil
+}
+
+// handleRequest parses fetch request and forwards it to the least loaded suitable peer. Should the
+// peer fail to deliver a valid response, the same range is requested from the remaining peers.
+func (f *blocksFetcher) handleRequest(ctx context.Context, start, count uint64) *fetchRequestResponse {
+	response := &fetchRequestResponse{
+		start: start,
+		count: count,
+	}
+
+	excluded := make(map[peer.ID]bool)
+	for {
+		if ctx.Err() != nil {
+			response.err = ctx.Err()
+			return response
+		}
+
+		root, pid, err := f.selectPeer(ctx, start, excluded)
+		if err != nil {
+			response.err = err
+			return response
+		}
+
+		blocks, err := f.requestBlocks(ctx, root, start, count, pid)
+		f.releasePeer(pid)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"peer":  pid,
+				"start": start,
+				"count": count,
+			}).Debug("Request failed, trying another peer")
+			excluded[pid] = true
+			continue
+		}
+		response.blocks = blocks
+		response.pid = pid
+		return response
+	}
+}
+
+// selectPeer returns the least loaded peer, that has finalized the epoch of a given start slot
+// and has not been excluded. Once a peer is selected, its load is incremented and must be
+// released by the caller. If every suitable peer is busy, the call blocks until one frees up.
+func (f *blocksFetcher) selectPeer(ctx context.Context, start uint64, excluded map[peer.ID]bool) ([]byte, peer.ID, error) {
+	for {
+		root, _, peers := f.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, helpers.SlotToEpoch(start))
+		candidates := make([]peer.ID, 0, len(peers))
+		for _, pid := range peers {
+			if !excluded[pid] {
+				candidates = append(candidates, pid)
+			}
+		}
+		if len(candidates) == 0 {
+			// Either there are no peers at all, or all suitable peers have already failed to
+			// serve this range. Back off, so that the request can be rescheduled.
+			wait := peerAvailabilityPollInterval
+			if len(peers) == 0 {
+				log.Warn("No peers; waiting for reconnect")
+				wait = refreshTime
+			}
+			if err := f.waitForPeers(ctx, wait); err != nil {
+				return nil, "", err
+			}
+			return nil, "", errNoPeersAvailable
+		}
+
+		// Shuffle to spread the load, and prevent a bad peer from stalling sync with invalid
+		// blocks, then prefer peers with the fewest requests in flight. The random source is
+		// shared by the request goroutines, so it is only used under the lock.
+		f.peerLoadLock.Lock()
+		f.rand.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return f.peerLoad[candidates[i]] < f.peerLoad[candidates[j]]
+		})
+		if pid := candidates[0]; f.peerLoad[pid] < maxPendingRequestsPerPeer {
+			f.peerLoad[pid]++
+			f.peerLoadLock.Unlock()
+			return root, pid, nil
+		}
+		f.peerLoadLock.Unlock()
+
+		if err := f.waitForPeers(ctx, peerAvailabilityPollInterval); err != nil {
+			return nil, "", err
+		}
+	}
+}
+
+// releasePeer decrements the number of requests in flight for a given peer.
+func (f *blocksFetcher) releasePeer(pid peer.ID) {
+	f.peerLoadLock.Lock()
+	defer f.peerLoadLock.Unlock()
+	f.peerLoad[pid]--
+	if f.peerLoad[pid] <= 0 {
+		delete(f.peerLoad, pid)
+	}
+}
+
+// waitForPeers blocks for a given duration, unless either of contexts is done.
+func (f *blocksFetcher) waitForPeers(ctx context.Context, d time.Duration) error {
+	select {
+	case <-f.ctx.Done():
+		return errFetcherCtxIsDone
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// requestBlocks requests a contiguous range of blocks from a given peer, and validates the response.
+func (f *blocksFetcher) requestBlocks(ctx context.Context, root []byte, start, count uint64, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+	req := &p2ppb.BeaconBlocksByRangeRequest{
+		HeadBlockRoot: root,
+		StartSlot:     start,
+		Count:         count,
+		Step:          1,
+	}
+	blocks, err := requestBlocks(ctx, f.p2p, f.rateLimiter, req, pid)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBlocksRange(blocks, start, count); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// validateBlocksRange ensures that returned blocks are within the requested range, there are no
+// more blocks than requested, and that they come in strictly increasing slot order.
+func validateBlocksRange(blocks []*eth.SignedBeaconBlock, start, count uint64) error {
+	if uint64(len(blocks)) > count {
+		return errors.Wrapf(errInvalidFetchedData, "requested %d blocks, received %d", count, len(blocks))
+	}
+	for i, blk := range blocks {
+		if blk == nil || blk.Block == nil {
+			return errors.Wrap(errInvalidFetchedData, "nil block")
+		}
+		if blk.Block.Slot < start || blk.Block.Slot >= start+count {
+			return errors.Wrapf(errInvalidFetchedData, "block slot %d is out of requested range [%d, %d)", blk.Block.Slot, start, start+count)
+		}
+		if i > 0 && blk.Block.Slot <= blocks[i-1].Block.Slot {
+			return errors.Wrapf(errInvalidFetchedData, "block slot %d is not in increasing order", blk.Block.Slot)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kevinms/leakybucket-go"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+)
+
+func TestBlocksFetcher_ValidateBlocksRange(t *testing.T) {
+	makeBlocks := func(slots ...uint64) []*eth.SignedBeaconBlock {
+		blocks := make([]*eth.SignedBeaconBlock, 0, len(slots))
+		for _, slot := range slots {
+			blocks = append(blocks, &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: slot}})
+		}
+		return blocks
+	}
+
+	tests := []struct {
+		name    string
+		blocks  []*eth.SignedBeaconBlock
+		start   uint64
+		count   uint64
+		wantErr bool
+	}{
+		{
+			name:   "empty response",
+			blocks: makeBlocks(),
+			start:  64,
+			count:  64,
+		},
+		{
+			name:   "valid range with skipped slots",
+			blocks: makeBlocks(64, 65, 70, 127),
+			start:  64,
+			count:  64,
+		},
+		{
+			name:    "too many blocks",
+			blocks:  makeBlocks(10, 11, 12),
+			start:   10,
+			count:   2,
+			wantErr: true,
+		},
+		{
+			name:    "block before start slot",
+			blocks:  makeBlocks(63, 64),
+			start:   64,
+			count:   64,
+			wantErr: true,
+		},
+		{
+			name:    "block after end slot",
+			blocks:  makeBlocks(64, 128),
+			start:   64,
+			count:   64,
+			wantErr: true,
+		},
+		{
+			name:    "blocks out of order",
+			blocks:  makeBlocks(66, 65),
+			start:   64,
+			count:   64,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate blocks",
+			blocks:  makeBlocks(65, 65),
+			start:   64,
+			count:   64,
+			wantErr: true,
+		},
+		{
+			name:    "nil block",
+			blocks:  []*eth.SignedBeaconBlock{{}},
+			start:   64,
+			count:   64,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlocksRange(tt.blocks, tt.start, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateBlocksRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBlocksFetcher_RoundTrip(t *testing.T) {
+	expectedBlockSlots := makeSequence(1, 320)
+	initializeRootCache(expectedBlockSlots, t)
+
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+			failureSlots:   makeSequence(1, 64),
+		},
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+	}, p.Peers())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+	})
+	if err := fetcher.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer fetcher.stop()
+
+	requests := 0
+	for start := uint64(1); start <= 256; start += blockBatchSize {
+		if err := fetcher.scheduleRequest(ctx, start, blockBatchSize); err != nil {
+			t.Fatal(err)
+		}
+		requests++
+	}
+
+	var receivedBlockSlots []uint64
+	for i := 0; i < requests; i++ {
+		resp := <-fetcher.requestResponses()
+		if resp.err != nil {
+			t.Fatalf("Unexpected error for range starting at %d: %v", resp.start, resp.err)
+		}
+		if err := validateBlocksRange(resp.blocks, resp.start, resp.count); err != nil {
+			t.Error(err)
+		}
+		for _, blk := range resp.blocks {
+			receivedBlockSlots = append(receivedBlockSlots, blk.Block.Slot)
+		}
+	}
+	if len(receivedBlockSlots) != len(expectedBlockSlots) {
+		t.Errorf("Received wrong number of blocks. Wanted %d got %d", len(expectedBlockSlots), len(receivedBlockSlots))
+	}
+}
@@ -0,0 +1,198 @@
+package initialsync
+
+import (
+	"context"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// queueMaxPendingRequests limits how many batches may be scheduled ahead of the one
+	// currently being processed.
+	queueMaxPendingRequests = 8
+)
+
+var (
+	errQueueCtxIsDone     = errors.New("queue's context is done, reinitialize")
+	errStartSlotIsTooHigh = errors.New("start slot is bigger than highest expected slot")
+)
+
+// blocksQueueConfig is a config to setup block queue service.
+type blocksQueueConfig struct {
+	p2p                 p2p.P2P
+	rateLimiter         *leakybucket.Collector
+	startSlot           uint64
+	highestExpectedSlot uint64
+}
+
+// blocksQueue is a priority queue that serves as a intermediary between block fetchers (producers)
+// and block processing goroutine (consumer). Consumer can rely on order of incoming blocks.
+//
+// The queue splits the [startSlot, highestExpectedSlot] range into batches of blockBatchSize
+// slots, and keeps up to queueMaxPendingRequests of them in flight. Responses may arrive in any
+// order: they are buffered until all preceding batches are in, and then released to the consumer.
+// Failed batches are rescheduled, so that the fetcher can assign them to other peers. Batches that
+// contain only skipped slots complete with no blocks, and simply move the queue forward.
+type blocksQueue struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	highestExpectedSlot uint64
+	blocksFetcher       *blocksFetcher
+	pendingBatches      map[uint64]*blocksBatch
+	nextScheduledSlot   uint64
+	nextEmittedSlot     uint64
+	fetchedBlocks       chan *fetchedBlock // output channel for ready blocks
+	quit                chan struct{}      // termination notifier
+}
+
+// fetchedBlock is a block released by the queue, with the peer which served it.
+type fetchedBlock struct {
+	block *eth.SignedBeaconBlock
+	pid   peer.ID
+}
+
+// blocksBatch holds the state of a single batch of slots.
+type blocksBatch struct {
+	start, count uint64
+	blocks       []*eth.SignedBeaconBlock
+	pid          peer.ID
+	fetched      bool
+}
+
+// newBlocksQueue creates initialized priority queue.
+func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
+	ctx, cancel := context.WithCancel(ctx)
+
+	blocksFetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		p2p:         cfg.p2p,
+		rateLimiter: cfg.rateLimiter,
+	})
+
+	return &blocksQueue{
+		ctx:                 ctx,
+		cancel:              cancel,
+		highestExpectedSlot: cfg.highestExpectedSlot,
+		blocksFetcher:       blocksFetcher,
+		pendingBatches:      make(map[uint64]*blocksBatch),
+		nextScheduledSlot:   cfg.startSlot,
+		nextEmittedSlot:     cfg.startSlot,
+		fetchedBlocks:       make(chan *fetchedBlock, blockBatchSize),
+		quit:                make(chan struct{}),
+	}
+}
+
+// start boots up the queue processing. The fetchedBlocks channel is closed once all the blocks
+// up to the highest expected slot are delivered, or once the queue is stopped.
+func (q *blocksQueue) start() error {
+	select {
+	case <-q.ctx.Done():
+		return errQueueCtxIsDone
+	default:
+	}
+	if q.nextScheduledSlot > q.highestExpectedSlot {
+		return errStartSlotIsTooHigh
+	}
+	if err := q.blocksFetcher.start(); err != nil {
+		return err
+	}
+	go q.loop()
+	return nil
+}
+
+// stop terminates all queue operations.
+func (q *blocksQueue) stop() {
+	q.cancel()
+	<-q.quit
+}
+
+// loop is a main queue loop. It schedules batches, collects fetcher responses and releases
+// blocks to the consumer in slot order.
+func (q *blocksQueue) loop() {
+	defer close(q.quit)
+	defer close(q.fetchedBlocks)
+	defer q.blocksFetcher.stop()
+
+	for {
+		if err := q.scheduleBatches(); err != nil {
+			return
+		}
+		if len(q.pendingBatches) == 0 {
+			log.WithField("highestExpectedSlot", q.highestExpectedSlot).Debug("All batches are processed, exiting queue")
+			return
+		}
+
+		select {
+		case <-q.ctx.Done():
+			log.Debug("Context closed, exiting goroutine (blocks queue)")
+			return
+		case resp, ok := <-q.blocksFetcher.requestResponses():
+			if !ok {
+				return
+			}
+			batch, ok := q.pendingBatches[resp.start]
+			if !ok || batch.fetched {
+				continue
+			}
+			if resp.err != nil {
+				log.WithError(resp.err).WithFields(logrus.Fields{
+					"start": resp.start,
+					"count": resp.count,
+				}).Debug("Batch request failed, rescheduling")
+				if err := q.blocksFetcher.scheduleRequest(q.ctx, batch.start, batch.count); err != nil {
+					return
+				}
+				continue
+			}
+			batch.blocks = resp.blocks
+			batch.pid = resp.pid
+			batch.fetched = true
+			if err := q.emitBlocks(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// scheduleBatches fills the queue with new batches, until either the queue is full or the
+// highest expected slot is covered.
+func (q *blocksQueue) scheduleBatches() error {
+	for len(q.pendingBatches) < queueMaxPendingRequests && q.nextScheduledSlot <= q.highestExpectedSlot {
+		count := mathutil.Min(blockBatchSize, q.highestExpectedSlot-q.nextScheduledSlot+1)
+		batch := &blocksBatch{
+			start: q.nextScheduledSlot,
+			count: count,
+		}
+		if err := q.blocksFetcher.scheduleRequest(q.ctx, batch.start, batch.count); err != nil {
+			return err
+		}
+		q.pendingBatches[batch.start] = batch
+		q.nextScheduledSlot += count
+	}
+	return nil
+}
+
+// emitBlocks releases blocks of all the consecutive fetched batches, starting from the earliest
+// batch not yet released.
+func (q *blocksQueue) emitBlocks() error {
+	for {
+		batch, ok := q.pendingBatches[q.nextEmittedSlot]
+		if !ok || !batch.fetched {
+			return nil
+		}
+		for _, blk := range batch.blocks {
+			select {
+			case <-q.ctx.Done():
+				return errQueueCtxIsDone
+			case q.fetchedBlocks <- &fetchedBlock{block: blk, pid: batch.pid}:
+			}
+		}
+		delete(q.pendingBatches, batch.start)
+		q.nextEmittedSlot += batch.count
+	}
+}
@@ -0,0 +1,117 @@
+package initialsync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kevinms/leakybucket-go"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+)
+
+func TestBlocksQueue_OrderedDelivery(t *testing.T) {
+	tests := []struct {
+		name                string
+		startSlot           uint64
+		highestExpectedSlot uint64
+		expectedBlockSlots  []uint64
+		peers               []*peerData
+	}{
+		{
+			name:                "Single peer with all blocks",
+			startSlot:           1,
+			highestExpectedSlot: 251,
+			expectedBlockSlots:  makeSequence(1, 251),
+			peers: []*peerData{
+				{
+					blocks:         makeSequence(1, 320),
+					finalizedEpoch: 8,
+					headSlot:       320,
+				},
+			},
+		},
+		{
+			name:                "Multiple peers with failures",
+			startSlot:           1,
+			highestExpectedSlot: 256,
+			expectedBlockSlots:  makeSequence(1, 256),
+			peers: []*peerData{
+				{
+					blocks:         makeSequence(1, 320),
+					finalizedEpoch: 8,
+					headSlot:       320,
+				},
+				{
+					blocks:         makeSequence(1, 320),
+					finalizedEpoch: 8,
+					headSlot:       320,
+					failureSlots:   makeSequence(1, 320),
+				},
+				{
+					blocks:         makeSequence(1, 320),
+					finalizedEpoch: 8,
+					headSlot:       320,
+				},
+			},
+		},
+		{
+			name:                "Multiple peers with many skipped slots",
+			startSlot:           1,
+			highestExpectedSlot: 608,
+			expectedBlockSlots:  append(makeSequence(1, 64), makeSequence(500, 608)...),
+			peers: []*peerData{
+				{
+					blocks:         append(makeSequence(1, 64), makeSequence(500, 640)...),
+					finalizedEpoch: 18,
+					headSlot:       640,
+				},
+				{
+					blocks:         append(makeSequence(1, 64), makeSequence(500, 640)...),
+					finalizedEpoch: 18,
+					headSlot:       640,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initializeRootCache(tt.expectedBlockSlots, t)
+
+			p := p2pt.NewTestP2P(t)
+			connectPeers(t, p, tt.peers, p.Peers())
+
+			queue := newBlocksQueue(context.Background(), &blocksQueueConfig{
+				p2p:                 p,
+				rateLimiter:         leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+				startSlot:           tt.startSlot,
+				highestExpectedSlot: tt.highestExpectedSlot,
+			})
+			if err := queue.start(); err != nil {
+				t.Fatal(err)
+			}
+
+			var receivedBlockSlots []uint64
+			for blk := range queue.fetchedBlocks {
+				receivedBlockSlots = append(receivedBlockSlots, blk.block.Block.Slot)
+			}
+			queue.stop()
+
+			if !reflect.DeepEqual(receivedBlockSlots, tt.expectedBlockSlots) {
+				t.Errorf("Unexpected block slots, wanted %v, got %v", tt.expectedBlockSlots, receivedBlockSlots)
+			}
+		})
+	}
+}
+
+func TestBlocksQueue_StartSlotTooHigh(t *testing.T) {
+	queue := newBlocksQueue(context.Background(), &blocksQueueConfig{
+		p2p:                 p2pt.NewTestP2P(t),
+		rateLimiter:         leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+		startSlot:           65,
+		highestExpectedSlot: 64,
+	})
+	if err := queue.start(); err != errStartSlotIsTooHigh {
+		t.Errorf("Expected error %v, got %v", errStartSlotIsTooHigh, err)
+	}
+}
@@ -0,0 +1,107 @@
+package initialsync
+
+import (
+	"sync"
+	"time"
+
+	"github.com/paulbellamy/ratecounter"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// progressSummaryInterval defines how often a structured summary of the sync progress is logged.
+const progressSummaryInterval = 30 * time.Second
+
+var _ = prysmsync.ProgressFetcher(&Service{})
+
+// syncProgress tracks the state of an ongoing sync, which can not be derived from the chain or
+// the peer statuses.
+type syncProgress struct {
+	lock         sync.RWMutex
+	genesis      time.Time
+	startSlot    uint64
+	syncingPeers int
+	counter      *ratecounter.RateCounter
+	lastSummary  time.Time
+}
+
+// start resets the progress at the beginning of a sync.
+func (p *syncProgress) start(genesis time.Time, startSlot uint64, counter *ratecounter.RateCounter) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.genesis = genesis
+	p.startSlot = startSlot
+	p.syncingPeers = 0
+	p.counter = counter
+	p.lastSummary = time.Now()
+}
+
+// update records the number of peers blocks are currently being requested from, and reports
+// whether a new summary is due.
+func (p *syncProgress) update(syncingPeers int) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncingPeers = syncingPeers
+	if time.Since(p.lastSummary) < progressSummaryInterval {
+		return false
+	}
+	p.lastSummary = time.Now()
+	return true
+}
+
+// blocksPerSecond returns the block processing rate over the last counterSeconds.
+func (p *syncProgress) blocksPerSecond() float64 {
+	if p.counter == nil {
+		return 0
+	}
+	return float64(p.counter.Rate()) / counterSeconds
+}
+
+// Progress returns a snapshot of the sync progress.
+func (s *Service) Progress() *prysmsync.Progress {
+	s.progress.lock.RLock()
+	defer s.progress.lock.RUnlock()
+
+	progress := &prysmsync.Progress{
+		Syncing:         s.Syncing(),
+		StartSlot:       s.progress.startSlot,
+		HeadSlot:        s.chain.HeadSlot(),
+		BlocksPerSecond: s.progress.blocksPerSecond(),
+		SyncingPeers:    s.progress.syncingPeers,
+		ConnectedPeers:  len(s.p2p.Peers().Connected()),
+	}
+	if !s.progress.genesis.IsZero() {
+		progress.TargetSlot = helpers.SlotsSince(s.progress.genesis)
+	}
+	_, finalizedEpoch, _ := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, helpers.SlotToEpoch(progress.HeadSlot))
+	progress.HighestFinalizedSlot = helpers.StartSlot(finalizedEpoch + 1)
+	for _, pid := range s.p2p.Peers().Connected() {
+		peerChainState, err := s.p2p.Peers().ChainState(pid)
+		if err == nil && peerChainState != nil && peerChainState.HeadSlot > progress.HighestPeerSlot {
+			progress.HighestPeerSlot = peerChainState.HeadSlot
+		}
+	}
+	if progress.Syncing && progress.BlocksPerSecond > 0 && progress.TargetSlot > progress.HeadSlot {
+		remaining := float64(progress.TargetSlot-progress.HeadSlot) / progress.BlocksPerSecond
+		progress.TimeRemaining = time.Duration(remaining) * time.Second
+	}
+	return progress
+}
+
+// logProgressSummary logs the sync progress as structured fields.
+func (s *Service) logProgressSummary() {
+	progress := s.Progress()
+	log.WithFields(logrus.Fields{
+		"startSlot":            progress.StartSlot,
+		"headSlot":             progress.HeadSlot,
+		"targetSlot":           progress.TargetSlot,
+		"highestFinalizedSlot": progress.HighestFinalizedSlot,
+		"highestPeerSlot":      progress.HighestPeerSlot,
+		"blocksPerSecond":      progress.BlocksPerSecond,
+		"timeRemaining":        progress.TimeRemaining,
+		"syncingPeers":         progress.SyncingPeers,
+		"connectedPeers":       progress.ConnectedPeers,
+	}).Info("Sync progress summary")
+}
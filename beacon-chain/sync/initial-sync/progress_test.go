@@ -0,0 +1,63 @@
+package initialsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/paulbellamy/ratecounter"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestProgress(t *testing.T) {
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{
+			finalizedEpoch: 4,
+			headSlot:       160,
+		},
+		{
+			finalizedEpoch: 4,
+			headSlot:       170,
+		},
+	}, p.Peers())
+
+	s := &Service{
+		chain: &mock.ChainService{State: &p2ppb.BeaconState{Slot: 64}},
+		p2p:   p,
+	}
+	counter := ratecounter.NewRateCounter(counterSeconds * time.Second)
+	s.progress.start(makeGenesisTime(200), 32, counter)
+	counter.Incr(counterSeconds * 4)
+	s.progress.update(1)
+
+	progress := s.Progress()
+	if !progress.Syncing {
+		t.Error("Expected node to be syncing")
+	}
+	if progress.StartSlot != 32 {
+		t.Errorf("Wanted start slot %d, got %d", 32, progress.StartSlot)
+	}
+	if progress.HeadSlot != 64 {
+		t.Errorf("Wanted head slot %d, got %d", 64, progress.HeadSlot)
+	}
+	if progress.TargetSlot != 200 {
+		t.Errorf("Wanted target slot %d, got %d", 200, progress.TargetSlot)
+	}
+	if progress.HighestFinalizedSlot != 160 {
+		t.Errorf("Wanted highest finalized slot %d, got %d", 160, progress.HighestFinalizedSlot)
+	}
+	if progress.HighestPeerSlot != 170 {
+		t.Errorf("Wanted highest peer slot %d, got %d", 170, progress.HighestPeerSlot)
+	}
+	if progress.BlocksPerSecond != 4 {
+		t.Errorf("Wanted %f blocks per second, got %f", 4.0, progress.BlocksPerSecond)
+	}
+	if progress.TimeRemaining != 34*time.Second {
+		t.Errorf("Wanted %v remaining, got %v", 34*time.Second, progress.TimeRemaining)
+	}
+	if progress.SyncingPeers != 1 || progress.ConnectedPeers != 2 {
+		t.Errorf("Wanted 1/2 peers, got %d/%d", progress.SyncingPeers, progress.ConnectedPeers)
+	}
+}
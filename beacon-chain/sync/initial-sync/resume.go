@@ -0,0 +1,46 @@
+package initialsync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// resumeFromDB processes blocks which were saved to the database by a previous run of the node,
+// but are not part of the chain anymore. On restart, the chain resumes from the last finalized
+// checkpoint, so any blocks received after it are replayed from disk rather than requested from
+// peers once again. Replay stops at the first fork, leaving it to be resolved by syncing with
+// peers.
+func (s *Service) resumeFromDB(ctx context.Context) (int, error) {
+	headRoot, err := s.chain.HeadRoot(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve head root")
+	}
+	root := bytesutil.ToBytes32(headRoot)
+
+	var processed int
+	for {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+		children, err := s.db.Blocks(ctx, filters.NewFilter().SetParentRoot(root[:]))
+		if err != nil {
+			return processed, errors.Wrap(err, "could not retrieve blocks from db")
+		}
+		if len(children) != 1 || children[0].Block.Slot <= s.chain.HeadSlot() {
+			return processed, nil
+		}
+		blk := children[0]
+		if err := s.processBlock(ctx, blk); err != nil {
+			return processed, errors.Wrapf(err, "could not process block at slot %d", blk.Block.Slot)
+		}
+		processed++
+		root, err = ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			return processed, errors.Wrap(err, "could not get block root")
+		}
+	}
+}
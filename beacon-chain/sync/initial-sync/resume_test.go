@@ -0,0 +1,67 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestResumeFromDB(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, beaconDB)
+
+	genesis := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 0}}
+	if err := beaconDB.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Blocks received by a previous run of the node: a chain of 10 blocks, forked after slot 8.
+	parentRoot := genesisRoot
+	var forkRoot [32]byte
+	for slot := uint64(1); slot <= 10; slot++ {
+		blk := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+		if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		if parentRoot, err = ssz.HashTreeRoot(blk.Block); err != nil {
+			t.Fatal(err)
+		}
+		if slot == 8 {
+			forkRoot = parentRoot
+		}
+	}
+	fork := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 10, ParentRoot: forkRoot[:], StateRoot: []byte("fork")}}
+	if err := beaconDB.SaveBlock(ctx, fork); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := &mock.ChainService{
+		State: &p2ppb.BeaconState{},
+		Root:  genesisRoot[:],
+		DB:    beaconDB,
+	}
+	s := &Service{
+		chain: mc,
+		db:    beaconDB,
+	}
+	processed, err := s.resumeFromDB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if processed != 8 {
+		t.Errorf("Wanted %d processed blocks, got %d", 8, processed)
+	}
+	if s.chain.HeadSlot() != 8 {
+		t.Errorf("Wanted head slot %d, got %d", 8, s.chain.HeadSlot())
+	}
+}
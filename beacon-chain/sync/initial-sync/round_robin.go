@@ -9,12 +9,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/paulbellamy/ratecounter"
 	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -28,6 +30,14 @@ const blockBatchSize = 64
 const counterSeconds = 20
 const refreshTime = 6 * time.Second
 
+// maxUnprocessableRangeRetries is the number of times a range whose blocks cannot be processed is
+// requested again, before syncing to the finalized epoch is given up.
+const maxUnprocessableRangeRetries = 5
+
+// unprocessableRangeBackoff is the delay before requesting again a range whose blocks cannot be
+// processed, multiplied by the number of attempts so far.
+var unprocessableRangeBackoff = time.Second
+
 // Round Robin sync looks at the latest peer statuses and syncs with the highest
 // finalized peer.
 //
@@ -43,6 +53,15 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	defer cancel()
 
 	counter := ratecounter.NewRateCounter(counterSeconds * time.Second)
+	s.progress.start(genesis, s.chain.HeadSlot(), counter)
+	if featureconfig.Get().EnableInitSyncQueue {
+		if err := s.syncToFinalizedEpoch(ctx, genesis, counter); err != nil {
+			return err
+		}
+		log.Debug("Synced to finalized epoch - now syncing blocks up to current head")
+		return s.syncToHead(ctx, genesis, counter)
+	}
+
 	randGenerator := rand.New(rand.NewSource(time.Now().Unix()))
 	var lastEmptyRequests int
 	highestFinalizedSlot := helpers.StartSlot(s.highestFinalizedEpoch() + 1)
@@ -200,14 +219,8 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 				log.Debugf("Beacon node doesn't have a block in db with root %#x", blk.Block.ParentRoot)
 				continue
 			}
-			if featureconfig.Get().InitSyncNoVerify {
-				if err := s.chain.ReceiveBlockNoVerify(ctx, blk); err != nil {
-					return err
-				}
-			} else {
-				if err := s.chain.ReceiveBlockNoPubsubForkchoice(ctx, blk); err != nil {
-					return err
-				}
+			if err := s.processBlock(ctx, blk); err != nil {
+				return err
 			}
 		}
 		// If there were no blocks in the last request range, increment the counter so the same
@@ -221,6 +234,113 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 
 	log.Debug("Synced to finalized epoch - now syncing blocks up to current head")
 
+	return s.syncToHead(ctx, genesis, counter)
+}
+
+// syncToFinalizedEpoch requests blocks up to the end of the highest finalized epoch using the
+// blocks queue. Batches are downloaded concurrently from several peers, while already received
+// blocks are being processed in slot order.
+func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time, counter *ratecounter.RateCounter) error {
+	highestFinalizedSlot := helpers.StartSlot(s.highestFinalizedEpoch() + 1)
+	var retries int
+	for s.chain.HeadSlot() < highestFinalizedSlot {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		_, finalizedEpoch, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, helpers.SlotToEpoch(s.chain.HeadSlot()))
+		if len(peers) == 0 {
+			log.Warn("No peers; waiting for reconnect")
+			time.Sleep(refreshTime)
+			continue
+		}
+		if len(peers) >= flags.Get().MinimumSyncPeers {
+			highestFinalizedSlot = helpers.StartSlot(finalizedEpoch + 1)
+		}
+		if s.chain.HeadSlot() >= highestFinalizedSlot {
+			break
+		}
+
+		headSlot := s.chain.HeadSlot()
+		queue := newBlocksQueue(ctx, &blocksQueueConfig{
+			p2p:                 s.p2p,
+			rateLimiter:         s.blocksRateLimiter,
+			startSlot:           headSlot + 1,
+			highestExpectedSlot: highestFinalizedSlot,
+		})
+		if err := queue.start(); err != nil {
+			return err
+		}
+		var receivedBlocks int
+		// Peers which served blocks that do not extend our chain.
+		unusablePeers := make(map[peer.ID]bool)
+		for fetched := range queue.fetchedBlocks {
+			blk := fetched.block
+			receivedBlocks++
+			s.logSyncStatus(genesis, blk.Block, peers, counter)
+			if blk.Block.Slot <= s.chain.HeadSlot() {
+				continue
+			}
+			if !s.db.HasBlock(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot)) {
+				log.Debugf("Beacon node doesn't have a block in db with root %#x", blk.Block.ParentRoot)
+				unusablePeers[fetched.pid] = true
+				continue
+			}
+			if err := s.processBlock(ctx, blk); err != nil {
+				queue.stop()
+				return err
+			}
+		}
+		queue.stop()
+
+		// If the whole range consists of skipped slots, there is nothing more to fetch. Otherwise,
+		// when blocks were received but none of them could be processed (i.e. some peer served
+		// blocks from a different fork), the peers which served them are downscored, and the range
+		// is requested once again from shuffled peers, backing off between attempts.
+		if s.chain.HeadSlot() != headSlot {
+			retries = 0
+			continue
+		}
+		if receivedBlocks == 0 {
+			log.WithField("finalizedEpoch", finalizedEpoch).Debug("No blocks left in the requested range")
+			break
+		}
+		for pid := range unusablePeers {
+			s.p2p.Peers().IncrementBadResponses(pid)
+		}
+		retries++
+		if retries > maxUnprocessableRangeRetries {
+			log.WithFields(logrus.Fields{
+				"headSlot": headSlot,
+				"retries":  maxUnprocessableRangeRetries,
+			}).Warn("Could not process blocks of the requested range, giving up syncing to the finalized epoch")
+			break
+		}
+		backoff := time.Duration(retries) * unprocessableRangeBackoff
+		log.WithFields(logrus.Fields{
+			"headSlot": headSlot,
+			"retry":    retries,
+			"backoff":  backoff,
+		}).Debug("No blocks were processed, re-requesting the range")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+	return nil
+}
+
+// processBlock passes a synced block to the blockchain service for processing.
+func (s *Service) processBlock(ctx context.Context, blk *eth.SignedBeaconBlock) error {
+	if featureconfig.Get().InitSyncNoVerify {
+		return s.chain.ReceiveBlockNoVerify(ctx, blk)
+	}
+	return s.chain.ReceiveBlockNoPubsubForkchoice(ctx, blk)
+}
+
+// syncToHead requests blocks from the peer reporting the highest head slot, until the node
+// reaches the current slot.
+func (s *Service) syncToHead(ctx context.Context, genesis time.Time, counter *ratecounter.RateCounter) error {
 	if s.chain.HeadSlot() == helpers.SlotsSince(genesis) {
 		return nil
 	}
@@ -274,11 +394,22 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 
 // requestBlocks by range to a specific peer.
 func (s *Service) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
-	if s.blocksRateLimiter.Remaining(pid.String()) < int64(req.Count) {
+	return requestBlocks(ctx, s.p2p, s.blocksRateLimiter, req, pid)
+}
+
+// requestBlocks by range to a specific peer, respecting the rate limit of that peer.
+func requestBlocks(
+	ctx context.Context,
+	p2pProvider p2p.P2P,
+	rateLimiter *leakybucket.Collector,
+	req *p2ppb.BeaconBlocksByRangeRequest,
+	pid peer.ID,
+) ([]*eth.SignedBeaconBlock, error) {
+	if rateLimiter.Remaining(pid.String()) < int64(req.Count) {
 		log.WithField("peer", pid).Debug("Slowing down for rate limit")
-		time.Sleep(s.blocksRateLimiter.TillEmpty(pid.String()))
+		time.Sleep(rateLimiter.TillEmpty(pid.String()))
 	}
-	s.blocksRateLimiter.Add(pid.String(), int64(req.Count))
+	rateLimiter.Add(pid.String(), int64(req.Count))
 	log.WithFields(logrus.Fields{
 		"peer":  pid,
 		"start": req.StartSlot,
@@ -286,7 +417,7 @@ func (s *Service) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRa
 		"step":  req.Step,
 		"head":  fmt.Sprintf("%#x", req.HeadBlockRoot),
 	}).Debug("Requesting blocks")
-	stream, err := s.p2p.Send(ctx, req, pid)
+	stream, err := p2pProvider.Send(ctx, req, pid)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to send request to peer")
 	}
@@ -294,7 +425,7 @@ func (s *Service) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRa
 
 	resp := make([]*eth.SignedBeaconBlock, 0, req.Count)
 	for {
-		blk, err := prysmsync.ReadChunkedBlock(stream, s.p2p)
+		blk, err := prysmsync.ReadChunkedBlock(stream, p2pProvider)
 		if err == io.EOF {
 			break
 		}
@@ -331,6 +462,9 @@ func (s *Service) bestPeer() peer.ID {
 // logSyncStatus and increment block processing counter.
 func (s *Service) logSyncStatus(genesis time.Time, blk *eth.BeaconBlock, syncingPeers []peer.ID, counter *ratecounter.RateCounter) {
 	counter.Incr(1)
+	if s.progress.update(len(syncingPeers)) {
+		s.logProgressSummary()
+	}
 	rate := float64(counter.Rate()) / counterSeconds
 	if rate == 0 {
 		rate = 1
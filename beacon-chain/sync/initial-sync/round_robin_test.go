@@ -9,6 +9,7 @@ import (
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/paulbellamy/ratecounter"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
@@ -18,6 +19,7 @@ import (
 	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
@@ -232,55 +234,122 @@ func TestRoundRobinSync(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			initializeRootCache(tt.expectedBlockSlots, t)
+	defer featureconfig.Init(nil)
+	for _, enableQueue := range []bool{false, true} {
+		featureconfig.Init(&featureconfig.Flags{
+			EnableInitSyncQueue: enableQueue,
+		})
+		for _, tt := range tests {
+			name := tt.name
+			if enableQueue {
+				name += " with blocks queue"
+			}
+			t.Run(name, func(t *testing.T) {
+				initializeRootCache(tt.expectedBlockSlots, t)
 
-			p := p2pt.NewTestP2P(t)
-			beaconDB := dbtest.SetupDB(t)
+				p := p2pt.NewTestP2P(t)
+				beaconDB := dbtest.SetupDB(t)
 
-			connectPeers(t, p, tt.peers, p.Peers())
-			genesisRoot := rootCache[0]
+				connectPeers(t, p, tt.peers, p.Peers())
+				genesisRoot := rootCache[0]
 
-			err := beaconDB.SaveBlock(context.Background(), &eth.SignedBeaconBlock{
-				Block: &eth.BeaconBlock{
-					Slot: 0,
-				}})
-			if err != nil {
-				t.Fatal(err)
-			}
+				err := beaconDB.SaveBlock(context.Background(), &eth.SignedBeaconBlock{
+					Block: &eth.BeaconBlock{
+						Slot: 0,
+					}})
+				if err != nil {
+					t.Fatal(err)
+				}
 
-			mc := &mock.ChainService{
-				State: &p2ppb.BeaconState{},
-				Root:  genesisRoot[:],
-				DB:    beaconDB,
-			} // no-op mock
-			s := &Service{
-				chain:             mc,
-				p2p:               p,
-				db:                beaconDB,
-				synced:            false,
-				chainStarted:      true,
-				blocksRateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
-			}
-			if err := s.roundRobinSync(makeGenesisTime(tt.currentSlot)); err != nil {
-				t.Error(err)
-			}
-			if s.chain.HeadSlot() != tt.currentSlot {
-				t.Errorf("Head slot (%d) is not currentSlot (%d)", s.chain.HeadSlot(), tt.currentSlot)
-			}
-			if len(mc.BlocksReceived) != len(tt.expectedBlockSlots) {
-				t.Errorf("Processes wrong number of blocks. Wanted %d got %d", len(tt.expectedBlockSlots), len(mc.BlocksReceived))
-			}
-			var receivedBlockSlots []uint64
-			for _, blk := range mc.BlocksReceived {
-				receivedBlockSlots = append(receivedBlockSlots, blk.Block.Slot)
-			}
-			if missing := sliceutil.NotUint64(sliceutil.IntersectionUint64(tt.expectedBlockSlots, receivedBlockSlots), tt.expectedBlockSlots); len(missing) > 0 {
-				t.Errorf("Missing blocks at slots %v", missing)
-			}
-			dbtest.TeardownDB(t, beaconDB)
-		})
+				mc := &mock.ChainService{
+					State: &p2ppb.BeaconState{},
+					Root:  genesisRoot[:],
+					DB:    beaconDB,
+				} // no-op mock
+				s := &Service{
+					chain:             mc,
+					p2p:               p,
+					db:                beaconDB,
+					synced:            false,
+					chainStarted:      true,
+					blocksRateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+				}
+				if err := s.roundRobinSync(makeGenesisTime(tt.currentSlot)); err != nil {
+					t.Error(err)
+				}
+				if s.chain.HeadSlot() != tt.currentSlot {
+					t.Errorf("Head slot (%d) is not currentSlot (%d)", s.chain.HeadSlot(), tt.currentSlot)
+				}
+				if len(mc.BlocksReceived) != len(tt.expectedBlockSlots) {
+					t.Errorf("Processes wrong number of blocks. Wanted %d got %d", len(tt.expectedBlockSlots), len(mc.BlocksReceived))
+				}
+				var receivedBlockSlots []uint64
+				for _, blk := range mc.BlocksReceived {
+					receivedBlockSlots = append(receivedBlockSlots, blk.Block.Slot)
+				}
+				if missing := sliceutil.NotUint64(sliceutil.IntersectionUint64(tt.expectedBlockSlots, receivedBlockSlots), tt.expectedBlockSlots); len(missing) > 0 {
+					t.Errorf("Missing blocks at slots %v", missing)
+				}
+				dbtest.TeardownDB(t, beaconDB)
+			})
+		}
+	}
+}
+
+func TestSyncToFinalizedEpoch_GivesUpOnUnprocessableBlocks(t *testing.T) {
+	defer func(backoff time.Duration) {
+		unprocessableRangeBackoff = backoff
+	}(unprocessableRangeBackoff)
+	unprocessableRangeBackoff = time.Millisecond
+
+	initializeRootCache(makeSequence(1, 64), t)
+	p := p2pt.NewTestP2P(t)
+	beaconDB := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, beaconDB)
+	// The only peer serves blocks of another fork, which never extend our chain.
+	connectPeers(t, p, []*peerData{
+		{
+			blocks:         makeSequence(1, 64),
+			finalizedEpoch: 1,
+			headSlot:       64,
+			forkedPeer:     true,
+		},
+	}, p.Peers())
+	if err := beaconDB.SaveBlock(context.Background(), &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{}}); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot := rootCache[0]
+	mc := &mock.ChainService{
+		State: &p2ppb.BeaconState{},
+		Root:  genesisRoot[:],
+		DB:    beaconDB,
+	}
+	s := &Service{
+		chain:             mc,
+		p2p:               p,
+		db:                beaconDB,
+		chainStarted:      true,
+		blocksRateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	genesis := makeGenesisTime(64)
+	counter := ratecounter.NewRateCounter(counterSeconds * time.Second)
+	s.progress.start(genesis, s.chain.HeadSlot(), counter)
+	if err := s.syncToFinalizedEpoch(ctx, genesis, counter); err != nil {
+		t.Fatal(err)
+	}
+	if len(mc.BlocksReceived) != 0 {
+		t.Errorf("Wanted no block processed, received %d", len(mc.BlocksReceived))
+	}
+	pid := p.Peers().Connected()[0]
+	badResponses, err := p.Peers().BadResponses(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if badResponses != maxUnprocessableRangeRetries+1 {
+		t.Errorf("Wanted peer to be downscored %d times, received %d", maxUnprocessableRangeRetries+1, badResponses)
 	}
 }
 
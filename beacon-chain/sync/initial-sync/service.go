@@ -50,6 +50,7 @@ type Service struct {
 	chainStarted      bool
 	stateNotifier     statefeed.Notifier
 	blocksRateLimiter *leakybucket.Collector
+	progress          syncProgress
 }
 
 // NewInitialSync configures the initial sync service responsible for bringing the node up to the
@@ -119,6 +120,16 @@ func (s *Service) Start() {
 		s.synced = true
 		return
 	}
+	processed, err := s.resumeFromDB(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not resume sync from blocks in database")
+	}
+	if processed > 0 {
+		log.WithFields(logrus.Fields{
+			"blocks":   processed,
+			"headSlot": s.chain.HeadSlot(),
+		}).Info("Resumed sync from blocks saved in database")
+	}
 	s.waitForMinimumPeers()
 	if err := s.roundRobinSync(genesis); err != nil {
 		panic(err)
@@ -20,6 +20,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageIgnoredValidationCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_ignored_validation_total",
+			Help: "Count of messages that were ignored during validation, i.e. since they were already seen.",
+		},
+		[]string{"topic"},
+	)
 	messageFailedProcessingCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_failed_processing_total",
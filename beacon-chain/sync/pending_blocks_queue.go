@@ -6,12 +6,14 @@ import (
 	"sort"
 	"time"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
@@ -21,6 +23,10 @@ import (
 
 var processPendingBlocksPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot/3) * time.Second
 
+// blockArrivalSize is the number of blocks received from the network, whose arrival time is kept
+// until they are processed.
+const blockArrivalSize = 1000
+
 // processes pending blocks queue on every processPendingBlocksPeriod
 func (r *Service) processPendingBlocksQueue() {
 	ctx := context.Background()
@@ -93,19 +99,19 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 			continue
 		}
 
-		if err := r.chain.ReceiveBlockNoPubsub(ctx, b); err != nil {
-			log.Errorf("Could not process block from slot %d: %v", b.Block.Slot, err)
-			traceutil.AnnotateError(span, err)
-		}
-
-		r.pendingQueueLock.Lock()
-		delete(r.slotToPendingBlocks, uint64(s))
 		blkRoot, err := ssz.HashTreeRoot(b.Block)
 		if err != nil {
 			traceutil.AnnotateError(span, err)
 			span.End()
 			return err
 		}
+		if err := r.chain.ReceiveBlockNoPubsub(ctx, b, r.blockArrival(blkRoot)); err != nil {
+			log.Errorf("Could not process block from slot %d: %v", b.Block.Slot, err)
+			traceutil.AnnotateError(span, err)
+		}
+
+		r.pendingQueueLock.Lock()
+		delete(r.slotToPendingBlocks, uint64(s))
 		delete(r.seenPendingBlocks, blkRoot)
 		r.pendingQueueLock.Unlock()
 
@@ -163,6 +169,36 @@ func (r *Service) validatePendingSlots() error {
 	return nil
 }
 
+// newBlockArrivalCache creates a cache of the arrival times of blocks received from the network.
+func newBlockArrivalCache() *lru.Cache {
+	cache, err := lru.New(blockArrivalSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// recordBlockArrival keeps the time at which a block was received from the network, so that the
+// block is processed with its actual arrival time, even after waiting in the pending queue.
+func (r *Service) recordBlockArrival(root [32]byte, arrival time.Time) {
+	if r.blockArrivals == nil {
+		return
+	}
+	r.blockArrivals.Add(root, arrival)
+}
+
+// blockArrival returns the time at which a block was received from the network and forgets it,
+// as the block is about to be processed. The current time is returned for unknown blocks.
+func (r *Service) blockArrival(root [32]byte) time.Time {
+	if r.blockArrivals != nil {
+		if arrival, ok := r.blockArrivals.Get(root); ok {
+			r.blockArrivals.Remove(root)
+			return arrival.(time.Time)
+		}
+	}
+	return roughtime.Now()
+}
+
 func (r *Service) clearPendingSlots() {
 	r.pendingQueueLock.Lock()
 	defer r.pendingQueueLock.Unlock()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/protocol"
@@ -268,3 +269,49 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
 	}
 }
+
+func TestProcessPendingBlocks_UsesArrivalTime(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+
+	chain := &mock.ChainService{
+		FinalizedCheckPoint: &ethpb.Checkpoint{
+			Epoch: 0,
+		},
+	}
+	r := &Service{
+		p2p:                 p2ptest.NewTestP2P(t),
+		db:                  db,
+		chain:               chain,
+		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
+		seenPendingBlocks:   make(map[[32]byte]bool),
+		blockArrivals:       newBlockArrivalCache(),
+	}
+
+	b0 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	if err := r.db.SaveBlock(context.Background(), b0); err != nil {
+		t.Fatal(err)
+	}
+	b0Root, _ := ssz.HashTreeRoot(b0.Block)
+	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: b0Root[:]}}
+	b1Root, _ := ssz.HashTreeRoot(b1.Block)
+
+	// The block waited in the pending queue since it was received from gossip.
+	arrival := time.Now().Add(-time.Minute)
+	r.recordBlockArrival(b1Root, arrival)
+	r.slotToPendingBlocks[b1.Block.Slot] = b1
+	r.seenPendingBlocks[b1Root] = true
+
+	if err := r.processPendingBlocks(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(chain.BlockArrivals) != 1 {
+		t.Fatalf("Expected 1 processed block, got %d", len(chain.BlockArrivals))
+	}
+	if !chain.BlockArrivals[0].Equal(arrival) {
+		t.Errorf("Expected block to be processed with arrival time %v, got %v", arrival, chain.BlockArrivals[0])
+	}
+	if r.blockArrivals.Contains(b1Root) {
+		t.Error("Expected arrival time of processed block to be removed")
+	}
+}
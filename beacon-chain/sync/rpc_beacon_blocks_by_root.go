@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 )
 
 // sendRecentBeaconBlocksRequest sends a recent beacon blocks request to a peer to get
@@ -38,6 +39,7 @@ func (r *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 		}
 		r.seenPendingBlocks[blkRoot] = true
 		r.pendingQueueLock.Unlock()
+		r.recordBlockArrival(blkRoot, roughtime.Now())
 
 	}
 	return nil
@@ -0,0 +1,116 @@
+package sync
+
+import (
+	"context"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	seenBlockSize            = 1000
+	seenExitSize             = 1000
+	seenProposerSlashingSize = 100
+	seenAttesterSlashingSize = 1000
+	// Unaggregated attestations are seen at most once per validator and epoch.
+	seenAttestationSize = 1 << 16
+)
+
+// seenCache keeps track of gossip messages, which have already passed validation, by the uniqueness
+// rules of their topic. A message colliding with one already seen is ignored, without running the
+// full validation again. A nil cache never reports a message as seen.
+type seenCache struct {
+	cache *lru.Cache
+}
+
+// newSeenCache creates a seen cache holding up to size keys.
+func newSeenCache(size int) *seenCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &seenCache{cache: cache}
+}
+
+// seen reports whether a message with the given key has already been validated.
+func (c *seenCache) seen(key interface{}) bool {
+	if c == nil {
+		return false
+	}
+	return c.cache.Contains(key)
+}
+
+// add marks a message with the given key as validated.
+func (c *seenCache) add(key interface{}) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(key, true)
+}
+
+// seenBlockKey identifies a block by its slot and proposer.
+type seenBlockKey struct {
+	slot          uint64
+	proposerIndex uint64
+}
+
+// seenAttestationKey identifies an unaggregated attestation. Each validator attests once per epoch,
+// in a single committee, so the slot, committee index and position of the single aggregation bit
+// uniquely identify the validator and the target epoch of the attestation, without having to
+// compute the committee.
+type seenAttestationKey struct {
+	slot           uint64
+	committeeIndex uint64
+	bit            uint64
+}
+
+// blockProposer returns the proposer index for a gossiped block slot, with the head state it was
+// computed from. The proposer can only be determined when the slot is in the same epoch as the
+// head, as the proposer selection depends on the effective balances at the start of the epoch.
+// The last return value reports whether the index could be determined.
+func (r *Service) blockProposer(ctx context.Context, slot uint64) (uint64, *pb.BeaconState, bool) {
+	s, err := r.chain.HeadState(ctx)
+	if err != nil || s == nil {
+		return 0, nil, false
+	}
+	if helpers.SlotToEpoch(slot) != helpers.CurrentEpoch(s) || slot < s.Slot {
+		return 0, nil, false
+	}
+	// Only the slot is moved forward, which is sufficient to compute the proposer of the epoch.
+	st := *s
+	st.Slot = slot
+	idx, err := helpers.BeaconProposerIndex(&st)
+	if err != nil || idx >= uint64(len(s.Validators)) {
+		return 0, nil, false
+	}
+	return idx, s, true
+}
+
+// verifyProposerSignature checks the signature of a gossiped block by its proposer. A block must
+// only be marked as seen once its proposer signature is verified, otherwise anyone could prevent
+// the block of the proposer from being relayed by gossiping a forged block for its slot first.
+func verifyProposerSignature(s *pb.BeaconState, proposerIndex uint64, blk *ethpb.SignedBeaconBlock) error {
+	pub, err := bls.PublicKeyFromBytes(s.Validators[proposerIndex].PublicKey)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to public key")
+	}
+	sig, err := bls.SignatureFromBytes(blk.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to signature")
+	}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not get signing root")
+	}
+	domain := helpers.Domain(s.Fork, helpers.SlotToEpoch(blk.Block.Slot), params.BeaconConfig().DomainBeaconProposer)
+	if !sig.Verify(root[:], pub, domain) {
+		return errors.New("invalid proposer signature")
+	}
+	return nil
+}
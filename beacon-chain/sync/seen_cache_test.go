@@ -0,0 +1,69 @@
+package sync
+
+import (
+	"testing"
+)
+
+func TestSeenCache(t *testing.T) {
+	c := newSeenCache(2)
+	key := seenBlockKey{slot: 1, proposerIndex: 2}
+	if c.seen(key) {
+		t.Error("Expected key not to be seen before it is added")
+	}
+	c.add(key)
+	if !c.seen(key) {
+		t.Error("Expected key to be seen after it is added")
+	}
+	if c.seen(seenBlockKey{slot: 1, proposerIndex: 3}) {
+		t.Error("Expected key with a different proposer not to be seen")
+	}
+	// Adding more keys than the cache size evicts the oldest key.
+	c.add(seenBlockKey{slot: 2})
+	c.add(seenBlockKey{slot: 3})
+	if c.seen(key) {
+		t.Error("Expected oldest key to be evicted")
+	}
+}
+
+func TestSeenCache_Nil(t *testing.T) {
+	var c *seenCache
+	c.add(uint64(1))
+	if c.seen(uint64(1)) {
+		t.Error("Expected nil cache to never report a key as seen")
+	}
+}
+
+func TestService_HasSeenAttesterSlashingIndices(t *testing.T) {
+	r := &Service{seenAttesterSlashingCache: newSeenCache(seenAttesterSlashingSize)}
+	r.seenAttesterSlashingCache.add(uint64(1))
+	r.seenAttesterSlashingCache.add(uint64(2))
+
+	tests := []struct {
+		name    string
+		indices []uint64
+		want    bool
+	}{
+		{
+			name:    "no indices",
+			indices: []uint64{},
+			want:    false,
+		},
+		{
+			name:    "all indices seen",
+			indices: []uint64{1, 2},
+			want:    true,
+		},
+		{
+			name:    "some indices not seen",
+			indices: []uint64{1, 2, 3},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.hasSeenAttesterSlashingIndices(tt.indices); got != tt.want {
+				t.Errorf("hasSeenAttesterSlashingIndices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,17 +3,21 @@ package sync
 import (
 	"context"
 	"sync"
+	"time"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/kevinms/leakybucket-go"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 )
 
 var _ = shared.Service(&Service{})
@@ -23,13 +27,14 @@ const allowedBlocksBurst = 10 * allowedBlocksPerSecond
 
 // Config to set up the regular sync service.
 type Config struct {
-	P2P           p2p.P2P
-	DB            db.NoHeadAccessDatabase
-	AttPool       attestations.Pool
-	ExitPool      *voluntaryexits.Pool
-	Chain         blockchainService
-	InitialSync   Checker
-	StateNotifier statefeed.Notifier
+	P2P               p2p.P2P
+	DB                db.NoHeadAccessDatabase
+	AttPool           attestations.Pool
+	ExitPool          *voluntaryexits.Pool
+	Chain             blockchainService
+	InitialSync       Checker
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
 }
 
 // This defines the interface for interacting with block chain service
@@ -46,18 +51,27 @@ type blockchainService interface {
 func NewRegularSync(cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &Service{
-		ctx:                 ctx,
-		cancel:              cancel,
-		db:                  cfg.DB,
-		p2p:                 cfg.P2P,
-		attPool:             cfg.AttPool,
-		exitPool:            cfg.ExitPool,
-		chain:               cfg.Chain,
-		initialSync:         cfg.InitialSync,
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		stateNotifier:       cfg.StateNotifier,
-		blocksRateLimiter:   leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+		ctx:                       ctx,
+		cancel:                    cancel,
+		db:                        cfg.DB,
+		p2p:                       cfg.P2P,
+		attPool:                   cfg.AttPool,
+		exitPool:                  cfg.ExitPool,
+		chain:                     cfg.Chain,
+		initialSync:               cfg.InitialSync,
+		slotToPendingBlocks:       make(map[uint64]*ethpb.SignedBeaconBlock),
+		seenPendingBlocks:         make(map[[32]byte]bool),
+		stateNotifier:             cfg.StateNotifier,
+		opNotifier:                cfg.OperationNotifier,
+		blocksRateLimiter:         leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+		signatureChan:             make(chan *signatureVerifier, maxBufferedSignatureSets),
+		seenBlockCache:            newSeenCache(seenBlockSize),
+		seenExitCache:             newSeenCache(seenExitSize),
+		seenProposerSlashingCache: newSeenCache(seenProposerSlashingSize),
+		seenAttesterSlashingCache: newSeenCache(seenAttesterSlashingSize),
+		seenAttestationCache:      newSeenCache(seenAttestationSize),
+		blockArrivals:             newBlockArrivalCache(),
+		attTargetStates:           newAttTargetStateCache(),
 	}
 
 	r.registerRPCHandlers()
@@ -69,21 +83,31 @@ func NewRegularSync(cfg *Config) *Service {
 // Service is responsible for handling all run time p2p related operations as the
 // main entry point for network messages.
 type Service struct {
-	ctx                 context.Context
-	cancel              context.CancelFunc
-	p2p                 p2p.P2P
-	db                  db.NoHeadAccessDatabase
-	attPool             attestations.Pool
-	exitPool            *voluntaryexits.Pool
-	chain               blockchainService
-	slotToPendingBlocks map[uint64]*ethpb.SignedBeaconBlock
-	seenPendingBlocks   map[[32]byte]bool
-	pendingQueueLock    sync.RWMutex
-	chainStarted        bool
-	initialSync         Checker
-	validateBlockLock   sync.RWMutex
-	stateNotifier       statefeed.Notifier
-	blocksRateLimiter   *leakybucket.Collector
+	ctx                       context.Context
+	cancel                    context.CancelFunc
+	p2p                       p2p.P2P
+	db                        db.NoHeadAccessDatabase
+	attPool                   attestations.Pool
+	exitPool                  *voluntaryexits.Pool
+	chain                     blockchainService
+	slotToPendingBlocks       map[uint64]*ethpb.SignedBeaconBlock
+	seenPendingBlocks         map[[32]byte]bool
+	pendingQueueLock          sync.RWMutex
+	chainStarted              bool
+	initialSync               Checker
+	validateBlockLock         sync.RWMutex
+	stateNotifier             statefeed.Notifier
+	opNotifier                opfeed.Notifier
+	blocksRateLimiter         *leakybucket.Collector
+	signatureChan             chan *signatureVerifier
+	seenBlockCache            *seenCache
+	seenExitCache             *seenCache
+	seenProposerSlashingCache *seenCache
+	seenAttesterSlashingCache *seenCache
+	seenAttestationCache      *seenCache
+	blockArrivals             *lru.Cache
+	attTargetStates           *lru.Cache
+	attTargetStateLock        sync.Mutex
 }
 
 // Start the regular sync service.
@@ -93,6 +117,9 @@ func (r *Service) Start() {
 	r.processPendingBlocksQueue()
 	r.maintainPeerStatuses()
 	r.resyncIfBehind()
+	if featureconfig.Get().EnableBatchVerification {
+		go r.verifierRoutine()
+	}
 }
 
 // Stop the regular sync service.
@@ -116,3 +143,23 @@ type Checker interface {
 	Status() error
 	Resync() error
 }
+
+// ProgressFetcher defines a struct which can report the progress of an ongoing chain
+// synchronization with the rest of peers in the network.
+type ProgressFetcher interface {
+	Progress() *Progress
+}
+
+// Progress is a snapshot of the chain synchronization state.
+type Progress struct {
+	Syncing              bool
+	StartSlot            uint64        // Head slot at the moment the sync has started.
+	HeadSlot             uint64        // Current head slot of the node.
+	TargetSlot           uint64        // Current slot, as derived from the genesis time.
+	HighestFinalizedSlot uint64        // Start slot of the epoch after the highest finalized epoch reported by peers.
+	HighestPeerSlot      uint64        // Highest head slot reported by connected peers.
+	BlocksPerSecond      float64       // Recent block processing rate.
+	TimeRemaining        time.Duration // Estimated time to reach the target slot.
+	SyncingPeers         int           // Number of peers blocks are being requested from.
+	ConnectedPeers       int
+}
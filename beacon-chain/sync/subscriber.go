@@ -23,15 +23,33 @@ const pubsubMessageTimeout = 30 * time.Second
 // subHandler represents handler for a given subscription.
 type subHandler func(context.Context, proto.Message) error
 
+// validationResult is the outcome of a gossip message validation.
+type validationResult int
+
+const (
+	// validationAccept marks a valid message, which is forwarded to peers and handled.
+	validationAccept validationResult = iota
+	// validationReject marks an invalid message.
+	validationReject
+	// validationIgnore marks a message which is neither forwarded nor handled, without being
+	// invalid, i.e. it has already been seen or it can not be validated at the moment. The
+	// pubsub router does not distinguish it from a rejection, but it is not reported as a
+	// failed validation.
+	validationIgnore
+)
+
+// gossipValidator validates a pubsub message of a given topic.
+type gossipValidator func(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult
+
 // noopValidator is a no-op that only decodes the message, but does not check its contents.
-func (r *Service) noopValidator(ctx context.Context, _ peer.ID, msg *pubsub.Message) bool {
+func (r *Service) noopValidator(ctx context.Context, _ peer.ID, msg *pubsub.Message) validationResult {
 	m, err := r.decodePubsubMessage(msg)
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
-		return false
+		return validationReject
 	}
 	msg.ValidatorData = m
-	return true
+	return validationAccept
 }
 
 // Register PubSub subscribers
@@ -97,7 +115,7 @@ func (r *Service) registerSubscribers() {
 
 // subscribe to a given topic with a given validator and subscription handler.
 // The base protobuf message is used to initialize new messages for decoding.
-func (r *Service) subscribe(topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
+func (r *Service) subscribe(topic string, validator gossipValidator, handle subHandler) *pubsub.Subscription {
 	base := p2p.GossipTopicMappings[topic]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
@@ -105,7 +123,7 @@ func (r *Service) subscribe(topic string, validator pubsub.Validator, handle sub
 	return r.subscribeWithBase(base, topic, validator, handle)
 }
 
-func (r *Service) subscribeWithBase(base proto.Message, topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
+func (r *Service) subscribeWithBase(base proto.Message, topic string, validator gossipValidator, handle subHandler) *pubsub.Subscription {
 	topic += r.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
@@ -174,18 +192,21 @@ func (r *Service) subscribeWithBase(base proto.Message, topic string, validator
 	return sub
 }
 
-// Wrap the pubsub validator with a metric monitoring function. This function increments the
-// appropriate counter if the particular message fails to validate.
-func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.Validator) {
+// Wrap the gossip validator into a pubsub validator with a metric monitoring function. This function
+// increments the appropriate counter if the particular message fails to validate or is ignored.
+func wrapAndReportValidation(topic string, v gossipValidator) (string, pubsub.Validator) {
 	return topic, func(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
 		defer messagehandler.HandlePanic(ctx, msg)
 		ctx, _ = context.WithTimeout(ctx, pubsubMessageTimeout)
 		messageReceivedCounter.WithLabelValues(topic).Inc()
-		b := v(ctx, pid, msg)
-		if !b {
+		res := v(ctx, pid, msg)
+		switch res {
+		case validationReject:
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
+		case validationIgnore:
+			messageIgnoredValidationCounter.WithLabelValues(topic).Inc()
 		}
-		return b
+		return res == validationAccept
 	}
 }
 
@@ -194,7 +215,7 @@ func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.V
 // maintained. As the state feed emits a newly updated state, the maxID function will be called to
 // determine the appropriate number of topics. This method supports only sequential number ranges
 // for topics.
-func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() int, validate pubsub.Validator, handle subHandler) {
+func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() int, validate gossipValidator, handle subHandler) {
 	base := p2p.GossipTopicMappings[topicFormat]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topicFormat))
@@ -48,7 +48,7 @@ func (r *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 		return nil
 	}
 
-	err = r.chain.ReceiveBlockNoPubsub(ctx, signed)
+	err = r.chain.ReceiveBlockNoPubsub(ctx, signed, r.blockArrival(blockRoot))
 	if err != nil {
 		interop.WriteBlockToDisk(signed, true /*failed*/)
 	}
@@ -58,14 +58,12 @@ func TestService_committeeIndexBeaconAttestationSubscriber_ValidMessage(t *testi
 		},
 	})
 
-	att := &eth.Attestation{
-		Data: &eth.AttestationData{
-			Slot:            0,
-			BeaconBlockRoot: root[:],
-		},
-		AggregationBits: bitfield.Bitlist{0b0101},
-		Signature:       sKeys[0].Sign([]byte("foo"), 0).Marshal(),
-	}
+	att := signedCommitteeAttestation(t, s, sKeys, &eth.AttestationData{
+		Slot:            0,
+		BeaconBlockRoot: root[:],
+		Source:          &eth.Checkpoint{Root: make([]byte, 32)},
+		Target:          &eth.Checkpoint{Root: root[:]},
+	})
 
 	p.ReceivePubSub("/eth2/committee_index0_beacon_attestation", att)
 
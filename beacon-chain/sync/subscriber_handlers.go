@@ -5,6 +5,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
 func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message) error {
@@ -18,10 +20,26 @@ func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message
 
 func (r *Service) attesterSlashingSubscriber(ctx context.Context, msg proto.Message) error {
 	// TODO(#3259): Requires handlers in operations service to be implemented.
+	if r.opNotifier != nil {
+		r.opNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.AttesterSlashingReceived,
+			Data: &opfeed.AttesterSlashingReceivedData{
+				AttesterSlashing: msg.(*ethpb.AttesterSlashing),
+			},
+		})
+	}
 	return nil
 }
 
 func (r *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Message) error {
 	// TODO(#3259): Requires handlers in operations service to be implemented.
+	if r.opNotifier != nil {
+		r.opNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.ProposerSlashingReceived,
+			Data: &opfeed.ProposerSlashingReceivedData{
+				ProposerSlashing: msg.(*ethpb.ProposerSlashing),
+			},
+		})
+	}
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -23,9 +24,9 @@ import (
 
 // validateAggregateAndProof verifies the aggregated signature and the selection proof is valid before forwarding to the
 // network and downstream services.
-func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateAggregateAndProof")
@@ -34,18 +35,18 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	// To process the following it requires the recent blocks to be present in the database, so we'll skip
 	// validating or processing aggregated attestations until fully synced.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnore
 	}
 
 	raw, err := r.decodePubsubMessage(msg)
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 	m, ok := raw.(*ethpb.AggregateAttestationAndProof)
 	if !ok {
-		return false
+		return validationReject
 	}
 
 	attSlot := m.Aggregate.Data.Slot
@@ -54,29 +55,31 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	seen, err := r.attPool.HasAggregatedAttestation(m.Aggregate)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationIgnore
 	}
 	if seen {
-		return false
+		return validationIgnore
 	}
 
 	// Verify the block being voted for passes validation. The block should have passed validation if it's in the DB.
+	// A block not yet received does not make the aggregate invalid, so the aggregate is only ignored.
 	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(m.Aggregate.Data.BeaconBlockRoot)) {
-		return false
+		return validationIgnore
 	}
 
 	// Verify attestation slot is within the last ATTESTATION_PROPAGATION_SLOT_RANGE slots.
 	currentSlot := uint64(roughtime.Now().Unix()-r.chain.GenesisTime().Unix()) / params.BeaconConfig().SecondsPerSlot
 	if attSlot > currentSlot || currentSlot > attSlot+params.BeaconConfig().AttestationPropagationSlotRange {
 		traceutil.AnnotateError(span, fmt.Errorf("attestation slot out of range %d <= %d <= %d", attSlot, currentSlot, attSlot+params.BeaconConfig().AttestationPropagationSlotRange))
-		return false
+		return validationReject
 
 	}
 
+	// Local errors do not make the aggregate invalid, so the aggregate is only ignored.
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationIgnore
 	}
 
 	// Only advance state if different epoch as the committee can only change on an epoch transition.
@@ -84,31 +87,49 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		s, err = state.ProcessSlots(ctx, s, helpers.StartSlot(helpers.SlotToEpoch(attSlot)))
 		if err != nil {
 			traceutil.AnnotateError(span, err)
-			return false
+			return validationIgnore
 		}
 	}
 
 	// Verify validator index is within the aggregate's committee.
 	if err := validateIndexInCommittee(ctx, s, m.Aggregate, m.AggregatorIndex); err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate index in committee"))
-		return false
+		return validationReject
 	}
 
-	// Verify selection proof reflects to the right validator and signature is valid.
-	if err := validateSelection(ctx, s, m.Aggregate.Data, m.AggregatorIndex, m.SelectionProof); err != nil {
-		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", m.AggregatorIndex))
-		return false
-	}
+	if featureconfig.Get().EnableBatchVerification {
+		// Verify selection proof reflects to the right validator, and verify both the selection proof
+		// and the aggregated attestation signatures in a batch.
+		set, err := selectionSignatureSet(ctx, s, m.Aggregate.Data, m.AggregatorIndex, m.SelectionProof)
+		if err != nil {
+			traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", m.AggregatorIndex))
+			return validationReject
+		}
+		attSet, err := blocks.AttestationSignatureSet(ctx, s, m.Aggregate)
+		if err != nil {
+			traceutil.AnnotateError(span, err)
+			return validationReject
+		}
+		if !r.validateWithBatchVerifier(ctx, "aggregate and proof", set.Join(attSet)) {
+			return validationReject
+		}
+	} else {
+		// Verify selection proof reflects to the right validator and signature is valid.
+		if err := validateSelection(ctx, s, m.Aggregate.Data, m.AggregatorIndex, m.SelectionProof); err != nil {
+			traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", m.AggregatorIndex))
+			return validationReject
+		}
 
-	// Verify aggregated attestation has a valid signature.
-	if err := blocks.VerifyAttestation(ctx, s, m.Aggregate); err != nil {
-		traceutil.AnnotateError(span, err)
-		return false
+		// Verify aggregated attestation has a valid signature.
+		if err := blocks.VerifyAttestation(ctx, s, m.Aggregate); err != nil {
+			traceutil.AnnotateError(span, err)
+			return validationReject
+		}
 	}
 
 	msg.ValidatorData = m
 
-	return true
+	return validationAccept
 }
 
 // This validates the aggregator's index in state is within the attesting indices of the attestation.
@@ -141,37 +162,50 @@ func validateIndexInCommittee(ctx context.Context, s *pb.BeaconState, a *ethpb.A
 // This validates selection proof by validating it's from the correct validator index of the slot and selection
 // proof is a valid signature.
 func validateSelection(ctx context.Context, s *pb.BeaconState, data *ethpb.AttestationData, validatorIndex uint64, proof []byte) error {
-	_, span := trace.StartSpan(ctx, "sync.validateSelection")
+	ctx, span := trace.StartSpan(ctx, "sync.validateSelection")
 	defer span.End()
 
-	committee, err := helpers.BeaconCommitteeFromState(s, data.Slot, data.CommitteeIndex)
+	set, err := selectionSignatureSet(ctx, s, data, validatorIndex, proof)
 	if err != nil {
 		return err
 	}
+	if !set.Verify() {
+		return errors.New("could not validate slot signature")
+	}
+
+	return nil
+}
+
+// This validates the selection proof is from an aggregator of the slot, and retrieves the selection
+// proof signature, so that it can be verified together with other signatures.
+func selectionSignatureSet(ctx context.Context, s *pb.BeaconState, data *ethpb.AttestationData, validatorIndex uint64, proof []byte) (*bls.SignatureSet, error) {
+	_, span := trace.StartSpan(ctx, "sync.selectionSignatureSet")
+	defer span.End()
+
+	committee, err := helpers.BeaconCommitteeFromState(s, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
 	aggregator, err := helpers.IsAggregator(uint64(len(committee)), data.Slot, data.CommitteeIndex, proof)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !aggregator {
-		return fmt.Errorf("validator is not an aggregator for slot %d", data.Slot)
+		return nil, fmt.Errorf("validator is not an aggregator for slot %d", data.Slot)
 	}
 
 	domain := helpers.Domain(s.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainBeaconAttester)
 	slotMsg, err := ssz.HashTreeRoot(data.Slot)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pubKey, err := bls.PublicKeyFromBytes(s.Validators[validatorIndex].PublicKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	slotSig, err := bls.SignatureFromBytes(proof)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if !slotSig.Verify(slotMsg[:], pubKey, domain) {
-		return errors.New("could not validate slot signature")
-	}
-
-	return nil
+	return bls.NewSet().Add(slotSig, pubKey, slotMsg, domain), nil
 }
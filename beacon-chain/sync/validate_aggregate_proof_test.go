@@ -145,8 +145,8 @@ func TestValidateAggregateAndProof_NoBlock(t *testing.T) {
 		},
 	}
 
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
-		t.Error("Expected validate to fail")
+	if res := r.validateAggregateAndProof(context.Background(), "", msg); res != validationIgnore {
+		t.Errorf("Expected aggregate to be ignored, received %v", res)
 	}
 }
 
@@ -202,7 +202,7 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 		},
 	}
 
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) == validationAccept {
 		t.Error("Expected validate to fail")
 	}
 
@@ -221,7 +221,7 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 			},
 		},
 	}
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) == validationAccept {
 		t.Error("Expected validate to fail")
 	}
 }
@@ -281,8 +281,8 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 	if err := r.attPool.SaveBlockAttestation(att); err != nil {
 		t.Fatal(err)
 	}
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
-		t.Error("Expected validate to fail")
+	if res := r.validateAggregateAndProof(context.Background(), "", msg); res != validationIgnore {
+		t.Errorf("Expected aggregate to be ignored, received %v", res)
 	}
 }
 
@@ -368,7 +368,7 @@ func TestValidateAggregateAndProof_CanValidate(t *testing.T) {
 		},
 	}
 
-	if !r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) != validationAccept {
 		t.Fatal("Validated status is false")
 	}
 
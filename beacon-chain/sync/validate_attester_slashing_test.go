@@ -103,7 +103,7 @@ func TestValidateAttesterSlashing_ValidSlashing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateAttesterSlashing(ctx, "foobar", msg)
+	valid := r.validateAttesterSlashing(ctx, "foobar", msg) == validationAccept
 
 	if !valid {
 		t.Error("Failed Validation")
@@ -141,7 +141,7 @@ func TestValidateAttesterSlashing_ContextTimeout(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateAttesterSlashing(ctx, "", msg)
+	valid := r.validateAttesterSlashing(ctx, "", msg) == validationAccept
 
 	if valid {
 		t.Error("slashing from the far distant future should have timed out and returned false")
@@ -172,7 +172,7 @@ func TestValidateAttesterSlashing_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateAttesterSlashing(ctx, "", msg)
+	valid := r.validateAttesterSlashing(ctx, "", msg) == validationAccept
 	if valid {
 		t.Error("Passed validation")
 	}
@@ -4,11 +4,18 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -18,17 +25,17 @@ var errPointsToBlockNotInDatabase = errors.New("attestation points to a block wh
 
 // validateBeaconAttestation validates that the block being voted for passes validation before forwarding to the
 // network.
-func (r *Service) validateBeaconAttestation(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateBeaconAttestation(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
 	// ourselves.
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	// Attestation processing requires the target block to be present in the database, so we'll skip
 	// validating or processing attestations until fully synced.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnore
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateBeaconAttestation")
@@ -41,13 +48,13 @@ func (r *Service) validateBeaconAttestation(ctx context.Context, pid peer.ID, ms
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 	att, ok := m.(*ethpb.Attestation)
 	if !ok {
 		traceutil.AnnotateError(span, errors.New("wrong proto message type"))
 		log.Error("Wrong proto message type")
-		return false
+		return validationReject
 	}
 
 	span.AddAttributes(
@@ -61,7 +68,7 @@ func (r *Service) validateBeaconAttestation(ctx context.Context, pid peer.ID, ms
 			fmt.Sprintf("%#x", att.Data.BeaconBlockRoot),
 		).WithError(errPointsToBlockNotInDatabase).Debug("Ignored incoming attestation that points to a block which is not in the database")
 		traceutil.AnnotateError(span, errPointsToBlockNotInDatabase)
-		return false
+		return validationReject
 	}
 
 	finalizedEpoch := r.chain.FinalizedCheckpt().Epoch
@@ -72,10 +79,88 @@ func (r *Service) validateBeaconAttestation(ctx context.Context, pid peer.ID, ms
 			"TargetEpoch": att.Data.Target.Epoch,
 			"SourceEpoch": att.Data.Source.Epoch,
 		}).Debug("Rejecting old attestation")
-		return false
+		return validationReject
+	}
+
+	// Verifying the signature of every gossiped attestation one by one is too costly, so it is
+	// only done when the signatures can be verified in batches.
+	if featureconfig.Get().EnableBatchVerification && !r.validateAttestationSignature(ctx, att) {
+		return validationReject
 	}
 
 	msg.ValidatorData = att // Used in downstream subscriber
 
-	return true
+	return validationAccept
+}
+
+// validateAttestationSignature verifies the aggregate signature of an attestation against the state
+// of its target checkpoint.
+func (r *Service) validateAttestationSignature(ctx context.Context, att *ethpb.Attestation) bool {
+	ctx, span := trace.StartSpan(ctx, "sync.validateAttestationSignature")
+	defer span.End()
+
+	s, err := r.attTargetState(ctx, att.Data.Target)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return false
+	}
+	set, err := blocks.AttestationSignatureSet(ctx, s, att)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return false
+	}
+	return r.validateWithBatchVerifier(ctx, "attestation", set)
+}
+
+// attTargetStateSize is the number of target checkpoint states kept to verify attestation
+// signatures, covering the targets of the current and previous epochs across a few forks.
+const attTargetStateSize = 8
+
+// attTargetKey is the key of the state of a target checkpoint.
+type attTargetKey struct {
+	epoch uint64
+	root  [32]byte
+}
+
+func newAttTargetStateCache() *lru.Cache {
+	cache, err := lru.New(attTargetStateSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// attTargetState returns the head state, advanced to the start of the target epoch if needed, used
+// to verify the signatures of the attestations of the given target. The committees and the fork
+// are the same for all the attestations of a target, so the state is computed once per target and
+// shared read-only, instead of copying and advancing the head state for every attestation.
+func (r *Service) attTargetState(ctx context.Context, target *ethpb.Checkpoint) (*pb.BeaconState, error) {
+	key := attTargetKey{epoch: target.Epoch, root: bytesutil.ToBytes32(target.Root)}
+	if r.attTargetStates != nil {
+		if s, ok := r.attTargetStates.Get(key); ok {
+			return s.(*pb.BeaconState), nil
+		}
+		// Attestations of a new target arrive all at once, the state is only computed by one of them.
+		r.attTargetStateLock.Lock()
+		defer r.attTargetStateLock.Unlock()
+		if s, ok := r.attTargetStates.Get(key); ok {
+			return s.(*pb.BeaconState), nil
+		}
+	}
+
+	s, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Only advance state if different epoch as the committee can only change on an epoch transition.
+	if target.Epoch > helpers.SlotToEpoch(s.Slot) {
+		s, err = state.ProcessSlots(ctx, proto.Clone(s).(*pb.BeaconState), helpers.StartSlot(target.Epoch))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.attTargetStates != nil {
+		r.attTargetStates.Add(key, s)
+	}
+	return s, nil
 }
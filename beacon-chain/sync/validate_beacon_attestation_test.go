@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
@@ -74,7 +75,7 @@ func TestValidateBeaconAttestation_ValidBlock(t *testing.T) {
 			},
 		},
 	}
-	valid := rs.validateBeaconAttestation(ctx, "", m)
+	valid := rs.validateBeaconAttestation(ctx, "", m) == validationAccept
 
 	if !valid {
 		t.Error("Beacon attestation failed validation")
@@ -115,7 +116,7 @@ func TestValidateBeaconAttestation_InvalidBlock(t *testing.T) {
 			},
 		},
 	}
-	valid := rs.validateBeaconAttestation(ctx, "", m)
+	valid := rs.validateBeaconAttestation(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("Invalid beacon attestation passed validation when it should not have")
 	}
@@ -163,7 +164,7 @@ func TestValidateBeaconAttestation_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := rs.validateBeaconAttestation(ctx, "", m)
+	valid := rs.validateBeaconAttestation(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("Beacon attestation passed validation")
 	}
@@ -224,7 +225,7 @@ func TestValidateBeaconAttestation_OldAttestation(t *testing.T) {
 			},
 		},
 	}
-	valid := rs.validateBeaconAttestation(ctx, "", m)
+	valid := rs.validateBeaconAttestation(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("Beacon attestation passed validation when it should have failed")
 	}
@@ -254,7 +255,7 @@ func TestValidateBeaconAttestation_OldAttestation(t *testing.T) {
 			},
 		},
 	}
-	valid = rs.validateBeaconAttestation(ctx, "", m)
+	valid = rs.validateBeaconAttestation(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("Beacon attestation passed validation when it should have failed")
 	}
@@ -316,8 +317,43 @@ func TestValidateBeaconAttestation_FirstEpoch(t *testing.T) {
 			},
 		},
 	}
-	valid := rs.validateBeaconAttestation(ctx, "", m)
+	valid := rs.validateBeaconAttestation(ctx, "", m) == validationAccept
 	if !valid {
 		t.Error("Beacon attestation did not pass validation")
 	}
 }
+
+func TestAttTargetState_ComputedOncePerTarget(t *testing.T) {
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	rs := &Service{
+		chain:           &mockChain.ChainService{State: headState},
+		attTargetStates: newAttTargetStateCache(),
+	}
+	target := &ethpb.Checkpoint{Epoch: 1, Root: []byte("target")}
+
+	s, err := rs.attTargetState(context.Background(), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Slot != params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Wanted state advanced to slot %d, received %d", params.BeaconConfig().SlotsPerEpoch, s.Slot)
+	}
+	if headState.Slot != 0 {
+		t.Error("Expected the head state not to be advanced")
+	}
+	// The attestations of the same target share the state.
+	again, err := rs.attTargetState(context.Background(), &ethpb.Checkpoint{Epoch: 1, Root: []byte("target")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != s {
+		t.Error("Expected the state of the target to be computed once")
+	}
+	other, err := rs.attTargetState(context.Background(), &ethpb.Checkpoint{Epoch: 1, Root: []byte("other")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == s {
+		t.Error("Expected a state per target")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -16,16 +17,17 @@ import (
 // validateBeaconBlockPubSub checks that the incoming block has a valid BLS signature.
 // Blocks that have already been seen are ignored. If the BLS signature is any valid signature,
 // this method rebroadcasts the message.
-func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
 	// ourselves.
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
+	receivedTime := roughtime.Now()
 
 	// We should not attempt to process blocks until fully synced, but propagation is OK.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnore
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateBeaconBlockPubSub")
@@ -35,43 +37,59 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 
 	r.validateBlockLock.Lock()
 	defer r.validateBlockLock.Unlock()
 
 	blk, ok := m.(*ethpb.SignedBeaconBlock)
-	if !ok {
-		return false
+	if !ok || blk.Block == nil {
+		return validationReject
+	}
+
+	// Only the first valid block of a proposer for a given slot is relayed. The proposer can only
+	// be determined for blocks in the epoch of the head, other blocks are not deduplicated.
+	proposerIndex, headState, hasProposer := r.blockProposer(ctx, blk.Block.Slot)
+	seenKey := seenBlockKey{slot: blk.Block.Slot, proposerIndex: proposerIndex}
+	if hasProposer && r.seenBlockCache.seen(seenKey) {
+		return validationIgnore
 	}
 
 	blockRoot, err := ssz.HashTreeRoot(blk.Block)
 	if err != nil {
-		return false
+		return validationReject
 	}
 
 	r.pendingQueueLock.RLock()
 	if r.seenPendingBlocks[blockRoot] {
 		r.pendingQueueLock.RUnlock()
-		return false
+		return validationIgnore
 	}
 	r.pendingQueueLock.RUnlock()
 
 	if err := helpers.VerifySlotTime(uint64(r.chain.GenesisTime().Unix()), blk.Block.Slot); err != nil {
 		log.WithError(err).WithField("blockSlot", blk.Block.Slot).Warn("Rejecting incoming block.")
-		return false
+		return validationReject
 	}
 
 	if r.chain.FinalizedCheckpt().Epoch > helpers.SlotToEpoch(blk.Block.Slot) {
 		log.Debug("Block older than finalized checkpoint received,rejecting it")
-		return false
+		return validationReject
 	}
 
 	if _, err = bls.SignatureFromBytes(blk.Signature); err != nil {
-		return false
+		return validationReject
 	}
 
+	if hasProposer {
+		if err := verifyProposerSignature(headState, proposerIndex, blk); err != nil {
+			log.WithError(err).WithField("blockSlot", blk.Block.Slot).Debug("Rejecting incoming block")
+			return validationReject
+		}
+		r.seenBlockCache.add(seenKey)
+	}
+	r.recordBlockArrival(blockRoot, receivedTime)
 	msg.ValidatorData = blk // Used in downstream subscriber
-	return true
+	return validationAccept
 }
@@ -10,13 +10,16 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
@@ -60,7 +63,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 
 	if result {
 		t.Error("Expected false result, got true")
@@ -103,7 +106,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 
 	if result {
 		t.Error("Expected false result, got true")
@@ -150,7 +153,7 @@ func TestValidateBeaconBlockPubSub_ValidSignature(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 	if !result {
 		t.Error("Expected true result, got false")
 	}
@@ -201,7 +204,7 @@ func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 	if result {
 		t.Error("Expected false result, got true")
 	}
@@ -245,7 +248,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 	if result {
 		t.Error("Expected false result, got true")
 	}
@@ -294,9 +297,74 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 
 	if result {
 		t.Error("Expected false result, got true")
 	}
 }
+
+func TestValidateBeaconBlockPubSub_ForgedBlockDoesNotSuppressProposerBlock(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+
+	slot := uint64(1)
+	st := *beaconState
+	st.Slot = slot
+	proposerIdx, err := helpers.BeaconProposerIndex(&st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Service{
+		db:             db,
+		p2p:            p,
+		initialSync:    &mockSync.Sync{IsSyncing: false},
+		seenBlockCache: newSeenCache(seenBlockSize),
+		chain: &mock.ChainService{
+			State:               beaconState,
+			Genesis:             time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
+			FinalizedCheckPoint: &ethpb.Checkpoint{},
+		},
+	}
+	domain := helpers.Domain(beaconState.Fork, 0, params.BeaconConfig().DomainBeaconProposer)
+	gossip := func(sk *bls.SecretKey) validationResult {
+		blk := &ethpb.SignedBeaconBlock{
+			Block: &ethpb.BeaconBlock{
+				Slot:       slot,
+				ParentRoot: testutil.Random32Bytes(t),
+			},
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blk.Signature = sk.Sign(root[:], domain).Marshal()
+		buf := new(bytes.Buffer)
+		if _, err := p.Encoding().Encode(buf, blk); err != nil {
+			t.Fatal(err)
+		}
+		m := &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data: buf.Bytes(),
+				TopicIDs: []string{
+					p2p.GossipTypeMapping[reflect.TypeOf(blk)],
+				},
+			},
+		}
+		return r.validateBeaconBlockPubSub(ctx, "", m)
+	}
+
+	forger := privKeys[(proposerIdx+1)%uint64(len(privKeys))]
+	if res := gossip(forger); res != validationReject {
+		t.Errorf("Wanted forged block to be rejected, received %v", res)
+	}
+	if res := gossip(privKeys[proposerIdx]); res != validationAccept {
+		t.Errorf("Wanted block of the proposer to be accepted, received %v", res)
+	}
+	if res := gossip(privKeys[proposerIdx]); res != validationIgnore {
+		t.Errorf("Wanted second block of the proposer to be ignored, received %v", res)
+	}
+}
@@ -24,14 +24,14 @@ import (
 // - The block being voted for (attestation.data.beacon_block_root) passes validation.
 // - attestation.data.slot is within the last ATTESTATION_PROPAGATION_SLOT_RANGE slots (attestation.data.slot + ATTESTATION_PROPAGATION_SLOT_RANGE >= current_slot >= attestation.data.slot).
 // - The signature of attestation is valid.
-func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	if pid == s.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 	// Attestation processing requires the target block to be present in the database, so we'll skip
 	// validating or processing attestations until fully synced.
 	if s.initialSync.Syncing() {
-		return false
+		return validationIgnore
 	}
 	ctx, span := trace.StartSpan(ctx, "sync.validateCommitteeIndexBeaconAttestation")
 	defer span.End()
@@ -45,24 +45,34 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 	// Restore topic.
 	msg.TopicIDs[0] = originalTopic
 
 	att, ok := m.(*eth.Attestation)
 	if !ok {
-		return false
+		return validationReject
 	}
 
 	// The attestation's committee index (attestation.data.index) is for the correct subnet.
 	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, att.Data.CommitteeIndex)) {
-		return false
+		return validationReject
 	}
 
 	// Attestation must be unaggregated.
 	if att.AggregationBits == nil || att.AggregationBits.Count() != 1 {
-		return false
+		return validationReject
+	}
+
+	// Only the first valid attestation of a validator for a given target is relayed.
+	seenKey := seenAttestationKey{
+		slot:           att.Data.Slot,
+		committeeIndex: att.Data.CommitteeIndex,
+		bit:            attestingBitPosition(att),
+	}
+	if s.seenAttestationCache.seen(seenKey) {
+		return validationIgnore
 	}
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE.
@@ -70,7 +80,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	upper := att.Data.Slot + params.BeaconConfig().AttestationPropagationSlotRange
 	lower := att.Data.Slot
 	if currentSlot > upper || currentSlot < lower {
-		return false
+		return validationReject
 	}
 
 	// Attestation's block must exist in database (only valid blocks are stored).
@@ -80,15 +90,30 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 			fmt.Sprintf("%#x", att.Data.BeaconBlockRoot),
 		).WithError(errPointsToBlockNotInDatabase).Debug("Ignored incoming attestation that points to a block which is not in the database")
 		traceutil.AnnotateError(span, errPointsToBlockNotInDatabase)
-		return false
+		return validationReject
 	}
 
 	// Attestation's signature is a valid BLS signature.
 	if _, err := bls.SignatureFromBytes(att.Signature); err != nil {
-		return false
+		return validationReject
 	}
-
+	// Only attestations with a verified signature are marked as seen, otherwise a forged attestation
+	// would prevent the attestation of the validator from being relayed.
+	if !s.validateAttestationSignature(ctx, att) {
+		return validationReject
+	}
+	s.seenAttestationCache.add(seenKey)
 	msg.ValidatorData = att
 
-	return true
+	return validationAccept
+}
+
+// attestingBitPosition returns the position of the first set aggregation bit of an attestation.
+func attestingBitPosition(att *eth.Attestation) uint64 {
+	for i := uint64(0); i < att.AggregationBits.Len(); i++ {
+		if att.AggregationBits.BitAt(i) {
+			return i
+		}
+	}
+	return 0
 }
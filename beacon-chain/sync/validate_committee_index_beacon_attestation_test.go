@@ -6,17 +6,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
@@ -24,18 +28,20 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	p := p2ptest.NewTestP2P(t)
 	db := dbtest.SetupDB(t)
 	defer dbtest.TeardownDB(t, db)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
 	s := &Service{
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		p2p:         p,
 		db:          db,
 		chain: &mockChain.ChainService{
-			Genesis: time.Now().Add(time.Duration(-64*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second), // 64 slots ago
+			Genesis: time.Now().Add(time.Duration(-16*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second), // 16 slots ago
+			State:   beaconState,
 		},
 	}
 
 	blk := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
-			Slot: 55,
+			Slot: 10,
 		},
 	}
 	if err := db.SaveBlock(ctx, blk); err != nil {
@@ -48,6 +54,12 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	}
 
 	validSig := bls.RandKey().Sign([]byte("foo"), 0).Marshal()
+	validAtt := signedCommitteeAttestation(t, beaconState, privKeys, &ethpb.AttestationData{
+		BeaconBlockRoot: validBlockRoot[:],
+		Slot:            15,
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: validBlockRoot[:]},
+	})
 
 	tests := []struct {
 		name  string
@@ -56,17 +68,9 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 		want  bool
 	}{
 		{
-			name: "valid",
-			msg: &ethpb.Attestation{
-				AggregationBits: bitfield.Bitlist{0b1010},
-				Data: &ethpb.AttestationData{
-					BeaconBlockRoot: validBlockRoot[:],
-					CommitteeIndex:  1,
-					Slot:            63,
-				},
-				Signature: validSig,
-			},
-			topic: "/eth2/committee_index1_beacon_attestation",
+			name:  "valid",
+			msg:   validAtt,
+			topic: "/eth2/committee_index0_beacon_attestation",
 			want:  true,
 		},
 		{
@@ -76,7 +80,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				Data: &ethpb.AttestationData{
 					BeaconBlockRoot: validBlockRoot[:],
 					CommitteeIndex:  2,
-					Slot:            63,
+					Slot:            15,
 				},
 				Signature: validSig,
 			},
@@ -90,7 +94,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				Data: &ethpb.AttestationData{
 					BeaconBlockRoot: validBlockRoot[:],
 					CommitteeIndex:  1,
-					Slot:            63,
+					Slot:            15,
 				},
 				Signature: validSig,
 			},
@@ -104,7 +108,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				Data: &ethpb.AttestationData{
 					BeaconBlockRoot: []byte("missing"),
 					CommitteeIndex:  1,
-					Slot:            63,
+					Slot:            15,
 				},
 				Signature: validSig,
 			},
@@ -118,7 +122,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				Data: &ethpb.AttestationData{
 					BeaconBlockRoot: validBlockRoot[:],
 					CommitteeIndex:  1,
-					Slot:            63,
+					Slot:            15,
 				},
 				Signature: []byte("bad"),
 			},
@@ -140,7 +144,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					TopicIDs: []string{tt.topic},
 				},
 			}
-			if s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, m) != tt.want {
+			if (s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, m) == validationAccept) != tt.want {
 				t.Errorf("Did not received wanted validation. Got %v, wanted %v", !tt.want, tt.want)
 			}
 			if tt.want && m.ValidatorData == nil {
@@ -149,3 +153,87 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 		})
 	}
 }
+
+func TestService_validateCommitteeIndexBeaconAttestation_DuplicateIgnored(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	s := &Service{
+		initialSync:          &mockSync.Sync{IsSyncing: false},
+		p2p:                  p,
+		db:                   db,
+		seenAttestationCache: newSeenCache(seenAttestationSize),
+		chain: &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Duration(-16*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second), // 16 slots ago
+			State:   beaconState,
+		},
+	}
+	blk := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot: 11,
+		},
+	}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	att := signedCommitteeAttestation(t, beaconState, privKeys, &ethpb.AttestationData{
+		BeaconBlockRoot: blockRoot[:],
+		Slot:            15,
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: blockRoot[:]},
+	})
+	forged := proto.Clone(att).(*ethpb.Attestation)
+	forged.Signature = bls.RandKey().Sign([]byte("foo"), 0).Marshal()
+
+	validate := func(att *ethpb.Attestation) validationResult {
+		buf := new(bytes.Buffer)
+		if _, err := p.Encoding().Encode(buf, att); err != nil {
+			t.Fatal(err)
+		}
+		m := &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data:     buf.Bytes(),
+				TopicIDs: []string{"/eth2/committee_index0_beacon_attestation"},
+			},
+		}
+		return s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, m)
+	}
+
+	// A forged attestation must not prevent the attestation of the validator from being relayed.
+	if res := validate(forged); res != validationReject {
+		t.Errorf("Wanted forged attestation to be rejected, received %v", res)
+	}
+	if res := validate(att); res != validationAccept {
+		t.Errorf("Wanted first attestation to be accepted, received %v", res)
+	}
+	if res := validate(att); res != validationIgnore {
+		t.Errorf("Wanted duplicate attestation to be ignored, received %v", res)
+	}
+}
+
+// signedCommitteeAttestation returns an attestation of the first member of committee 0, signed
+// with its key.
+func signedCommitteeAttestation(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, data *ethpb.AttestationData) *ethpb.Attestation {
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(0, true)
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState.Fork, data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	return &ethpb.Attestation{
+		AggregationBits: aggBits,
+		Data:            data,
+		Signature:       privKeys[committee[0]].Sign(root[:], domain).Marshal(),
+	}
+}
@@ -134,7 +134,7 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 		},
 	}
 
-	valid := r.validateProposerSlashing(ctx, "", m)
+	valid := r.validateProposerSlashing(ctx, "", m) == validationAccept
 	if !valid {
 		t.Error("Failed validation")
 	}
@@ -170,7 +170,7 @@ func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateProposerSlashing(ctx, "", m)
+	valid := r.validateProposerSlashing(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("slashing from the far distant future should have timed out and returned false")
 	}
@@ -200,7 +200,7 @@ func TestValidateProposerSlashing_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateProposerSlashing(ctx, "", m)
+	valid := r.validateProposerSlashing(ctx, "", m) == validationAccept
 
 	if valid {
 		t.Error("Did not fail validation")
@@ -88,7 +88,7 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateVoluntaryExit(ctx, "", m)
+	valid := r.validateVoluntaryExit(ctx, "", m) == validationAccept
 	if !valid {
 		t.Error("Failed validation")
 	}
@@ -123,8 +123,45 @@ func TestValidateVoluntaryExit_ValidExit_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateVoluntaryExit(ctx, "", m)
+	valid := r.validateVoluntaryExit(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("Validation should have failed")
 	}
 }
+
+func TestValidateVoluntaryExit_AlreadySeen(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+
+	exit, s := setupValidExit(t)
+
+	r := &Service{
+		p2p: p,
+		chain: &mock.ChainService{
+			State: s,
+		},
+		initialSync:   &mockSync.Sync{IsSyncing: false},
+		seenExitCache: newSeenCache(seenExitSize),
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().Encode(buf, exit); err != nil {
+		t.Fatal(err)
+	}
+	newMessage := func() *pubsub.Message {
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data: buf.Bytes(),
+				TopicIDs: []string{
+					p2p.GossipTypeMapping[reflect.TypeOf(exit)],
+				},
+			},
+		}
+	}
+	if res := r.validateVoluntaryExit(ctx, "", newMessage()); res != validationAccept {
+		t.Fatalf("Expected first exit to be accepted, received %v", res)
+	}
+	if res := r.validateVoluntaryExit(ctx, "", newMessage()); res != validationIgnore {
+		t.Errorf("Expected duplicate exit to be ignored, received %v", res)
+	}
+}
@@ -85,10 +85,17 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCHost,
 			flags.RPCPort,
 			flags.RPCMaxPageSize,
+			flags.HistoricalStateCacheSize,
+			flags.HistoricalStateMaxReplaySlots,
 			flags.CertFlag,
 			flags.KeyFlag,
 			flags.GRPCGatewayPort,
 			flags.HTTPWeb3ProviderFlag,
+			flags.FallbackWeb3ProviderFlag,
+			flags.FallbackHTTPWeb3ProviderFlag,
+			flags.PollWeb3ProviderFlag,
+			flags.Eth1SimulatedFlag,
+			flags.Eth1SimulatedDepositsFlag,
 		},
 	},
 	{
@@ -132,6 +139,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ArchiveValidatorSetChangesFlag,
 			flags.ArchiveBlocksFlag,
 			flags.ArchiveAttestationsFlag,
+			flags.ArchiveValidatorRewardsFlag,
+			flags.ArchiveValidatorRewardsIndexFlag,
 		},
 	},
 }
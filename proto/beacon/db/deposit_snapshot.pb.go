@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/deposit_snapshot.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DepositSnapshot is a compact representation of the deposit tree up to the deposits processed by
+// the finalized state, from which the tree can be rebuilt to process the following deposits.
+type DepositSnapshot struct {
+	// Roots of the complete subtrees of the finalized deposits, from the highest to the lowest
+	// layer of the tree. There is one root for each bit set in the deposit count.
+	Finalized [][]byte `protobuf:"bytes,1,rep,name=finalized,proto3" json:"finalized,omitempty"`
+	// Root of the deposit tree as returned by the deposit contract.
+	DepositRoot  []byte `protobuf:"bytes,2,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	DepositCount uint64 `protobuf:"varint,3,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	// Eth1 block of the last finalized deposit.
+	Eth1BlockHash   []byte `protobuf:"bytes,4,opt,name=eth1_block_hash,json=eth1BlockHash,proto3" json:"eth1_block_hash,omitempty"`
+	Eth1BlockHeight uint64 `protobuf:"varint,5,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	// Finalized epoch at which the snapshot was taken.
+	Epoch                uint64   `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositSnapshot) Reset()         { *m = DepositSnapshot{} }
+func (m *DepositSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DepositSnapshot) ProtoMessage()    {}
+func (*DepositSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a96fde3f971bd53, []int{0}
+}
+func (m *DepositSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositSnapshot.Merge(m, src)
+}
+func (m *DepositSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositSnapshot proto.InternalMessageInfo
+
+func (m *DepositSnapshot) GetFinalized() [][]byte {
+	if m != nil {
+		return m.Finalized
+	}
+	return nil
+}
+
+func (m *DepositSnapshot) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+func (m *DepositSnapshot) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *DepositSnapshot) GetEth1BlockHash() []byte {
+	if m != nil {
+		return m.Eth1BlockHash
+	}
+	return nil
+}
+
+func (m *DepositSnapshot) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
+	}
+	return 0
+}
+
+func (m *DepositSnapshot) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DepositSnapshot)(nil), "prysm.beacon.db.DepositSnapshot")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/deposit_snapshot.proto", fileDescriptor_5a96fde3f971bd53)
+}
+
+var fileDescriptor_5a96fde3f971bd53 = []byte{
+	// 259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xe5, 0xaf, 0x7f, 0xa4, 0xcf, 0xa4, 0x8a, 0xb0, 0x18, 0x3c, 0xa0, 0x28, 0x80, 0x54,
+	0x45, 0x0c, 0x89, 0x10, 0x2b, 0x53, 0x61, 0x60, 0x0e, 0x1b, 0x4b, 0x64, 0x3b, 0xa6, 0xb6, 0x48,
+	0x73, 0xad, 0xf8, 0x76, 0x80, 0x27, 0x64, 0xe4, 0x11, 0x20, 0x4f, 0x82, 0xe2, 0x34, 0x2a, 0x62,
+	0x3c, 0xbf, 0xfb, 0xbb, 0x67, 0x38, 0x74, 0xed, 0x3a, 0x40, 0x28, 0xa4, 0x16, 0x0a, 0xda, 0xa2,
+	0x96, 0x45, 0xad, 0x1d, 0x78, 0x8b, 0x95, 0x6f, 0x85, 0xf3, 0x06, 0x30, 0x0f, 0x02, 0x8b, 0x5d,
+	0xf7, 0xe6, 0x77, 0xf9, 0xe8, 0xe5, 0xb5, 0xbc, 0xfc, 0x26, 0x34, 0x7e, 0x18, 0xdd, 0xa7, 0x83,
+	0xca, 0xce, 0xe9, 0xff, 0x17, 0xdb, 0x8a, 0xc6, 0xbe, 0xeb, 0x9a, 0x93, 0x74, 0x96, 0x45, 0xe5,
+	0x11, 0xb0, 0x0b, 0x1a, 0x4d, 0xe5, 0x1d, 0x00, 0xf2, 0x7f, 0x29, 0xc9, 0xa2, 0xf2, 0xe4, 0xc0,
+	0x4a, 0x00, 0x64, 0x57, 0x74, 0x35, 0x29, 0x0a, 0xf6, 0x2d, 0xf2, 0x59, 0x4a, 0xb2, 0x79, 0x39,
+	0xfd, 0xdd, 0x0f, 0x8c, 0xad, 0x69, 0xac, 0xd1, 0xdc, 0x54, 0xb2, 0x01, 0xf5, 0x5a, 0x19, 0xe1,
+	0x0d, 0x9f, 0x87, 0xaa, 0xd5, 0x80, 0x37, 0x03, 0x7d, 0x14, 0xde, 0xb0, 0x6b, 0x7a, 0xfa, 0xdb,
+	0xd3, 0x76, 0x6b, 0x90, 0x2f, 0x42, 0x61, 0x7c, 0x34, 0x03, 0x66, 0x67, 0x74, 0xa1, 0x1d, 0x28,
+	0xc3, 0x97, 0xe1, 0x3e, 0x86, 0xcd, 0xdd, 0x47, 0x9f, 0x90, 0xcf, 0x3e, 0x21, 0x5f, 0x7d, 0x42,
+	0x9e, 0xf3, 0xad, 0x45, 0xb3, 0x97, 0xb9, 0x82, 0x5d, 0x11, 0xd6, 0x10, 0x68, 0x55, 0x23, 0xa4,
+	0x1f, 0x53, 0xf1, 0x67, 0x49, 0xb9, 0x0c, 0xe0, 0xf6, 0x67, 0x00, 0x4f, 0x2b, 0x83, 0x2f, 0x63,
+	0x01, 0x00, 0x00,
+}
+
+func (m *DepositSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDepositSnapshot(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Eth1BlockHeight != 0 {
+		i = encodeVarintDepositSnapshot(dAtA, i, uint64(m.Eth1BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Eth1BlockHash) > 0 {
+		i -= len(m.Eth1BlockHash)
+		copy(dAtA[i:], m.Eth1BlockHash)
+		i = encodeVarintDepositSnapshot(dAtA, i, uint64(len(m.Eth1BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintDepositSnapshot(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DepositRoot) > 0 {
+		i -= len(m.DepositRoot)
+		copy(dAtA[i:], m.DepositRoot)
+		i = encodeVarintDepositSnapshot(dAtA, i, uint64(len(m.DepositRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Finalized) > 0 {
+		for iNdEx := len(m.Finalized) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalized[iNdEx])
+			copy(dAtA[i:], m.Finalized[iNdEx])
+			i = encodeVarintDepositSnapshot(dAtA, i, uint64(len(m.Finalized[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDepositSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDepositSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalized) > 0 {
+		for _, b := range m.Finalized {
+			l = len(b)
+			n += 1 + l + sovDepositSnapshot(uint64(l))
+		}
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovDepositSnapshot(uint64(l))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovDepositSnapshot(uint64(m.DepositCount))
+	}
+	l = len(m.Eth1BlockHash)
+	if l > 0 {
+		n += 1 + l + sovDepositSnapshot(uint64(l))
+	}
+	if m.Eth1BlockHeight != 0 {
+		n += 1 + sovDepositSnapshot(uint64(m.Eth1BlockHeight))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDepositSnapshot(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDepositSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDepositSnapshot(x uint64) (n int) {
+	return sovDepositSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDepositSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalized = append(m.Finalized, make([]byte, postIndex-iNdEx))
+			copy(m.Finalized[len(m.Finalized)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1BlockHash = append(m.Eth1BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1BlockHash == nil {
+				m.Eth1BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHeight", wireType)
+			}
+			m.Eth1BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDepositSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDepositSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDepositSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDepositSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDepositSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDepositSnapshot
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthDepositSnapshot
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDepositSnapshot
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDepositSnapshot(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthDepositSnapshot
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDepositSnapshot = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDepositSnapshot   = fmt.Errorf("proto: integer overflow")
+)
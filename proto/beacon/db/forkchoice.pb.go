@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/forkchoice.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForkChoiceStore is a snapshot of the proto-array fork choice store, persisted
+// so that the fork choice can be restored on restart.
+type ForkChoiceStore struct {
+	JustifiedEpoch       uint64            `protobuf:"varint,1,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64            `protobuf:"varint,2,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	FinalizedRoot        []byte            `protobuf:"bytes,3,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+	PruneThreshold       uint64            `protobuf:"varint,4,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
+	Nodes                []*ForkChoiceNode `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Votes                []*ForkChoiceVote `protobuf:"bytes,6,rep,name=votes,proto3" json:"votes,omitempty"`
+	Balances             []uint64          `protobuf:"varint,7,rep,packed,name=balances,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ForkChoiceStore) Reset()         { *m = ForkChoiceStore{} }
+func (m *ForkChoiceStore) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceStore) ProtoMessage()    {}
+func (*ForkChoiceStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875cee35c0df88cd, []int{0}
+}
+func (m *ForkChoiceStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceStore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceStore.Merge(m, src)
+}
+func (m *ForkChoiceStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceStore proto.InternalMessageInfo
+
+func (m *ForkChoiceStore) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceStore) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceStore) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetPruneThreshold() uint64 {
+	if m != nil {
+		return m.PruneThreshold
+	}
+	return 0
+}
+
+func (m *ForkChoiceStore) GetNodes() []*ForkChoiceNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetVotes() []*ForkChoiceVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetBalances() []uint64 {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// ForkChoiceNode is a block node of the proto-array fork choice store. Parent,
+// best child and best descendant are indices into the list of nodes of the store.
+type ForkChoiceNode struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Parent               uint64   `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	JustifiedEpoch       uint64   `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	Weight               uint64   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	BestChild            uint64   `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
+	BestDescendant       uint64   `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceNode) Reset()         { *m = ForkChoiceNode{} }
+func (m *ForkChoiceNode) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceNode) ProtoMessage()    {}
+func (*ForkChoiceNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875cee35c0df88cd, []int{1}
+}
+func (m *ForkChoiceNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceNode.Merge(m, src)
+}
+func (m *ForkChoiceNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceNode proto.InternalMessageInfo
+
+func (m *ForkChoiceNode) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ForkChoiceNode) GetParent() uint64 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetBestChild() uint64 {
+	if m != nil {
+		return m.BestChild
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetBestDescendant() uint64 {
+	if m != nil {
+		return m.BestDescendant
+	}
+	return 0
+}
+
+// ForkChoiceVote is the latest vote of a validator in the fork choice store.
+type ForkChoiceVote struct {
+	CurrentRoot          []byte   `protobuf:"bytes,1,opt,name=current_root,json=currentRoot,proto3" json:"current_root,omitempty"`
+	NextRoot             []byte   `protobuf:"bytes,2,opt,name=next_root,json=nextRoot,proto3" json:"next_root,omitempty"`
+	NextEpoch            uint64   `protobuf:"varint,3,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceVote) Reset()         { *m = ForkChoiceVote{} }
+func (m *ForkChoiceVote) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceVote) ProtoMessage()    {}
+func (*ForkChoiceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875cee35c0df88cd, []int{2}
+}
+func (m *ForkChoiceVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceVote.Merge(m, src)
+}
+func (m *ForkChoiceVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceVote proto.InternalMessageInfo
+
+func (m *ForkChoiceVote) GetCurrentRoot() []byte {
+	if m != nil {
+		return m.CurrentRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceVote) GetNextRoot() []byte {
+	if m != nil {
+		return m.NextRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceVote) GetNextEpoch() uint64 {
+	if m != nil {
+		return m.NextEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ForkChoiceStore)(nil), "prysm.beacon.db.ForkChoiceStore")
+	proto.RegisterType((*ForkChoiceNode)(nil), "prysm.beacon.db.ForkChoiceNode")
+	proto.RegisterType((*ForkChoiceVote)(nil), "prysm.beacon.db.ForkChoiceVote")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/forkchoice.proto", fileDescriptor_875cee35c0df88cd) }
+
+var fileDescriptor_875cee35c0df88cd = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb5, 0xc9, 0x26, 0x4d, 0xdd, 0x92, 0x48, 0x3e, 0x54, 0x16, 0xa8, 0x61, 0x89, 0x84,
+	0xc8, 0x69, 0x57, 0x02, 0x71, 0xe3, 0x44, 0x81, 0x23, 0x87, 0x05, 0x71, 0xe0, 0xb2, 0xda, 0xb5,
+	0x27, 0x5d, 0xd3, 0xad, 0x67, 0x65, 0x3b, 0xfc, 0x3b, 0xf2, 0x5e, 0xdc, 0x39, 0xf2, 0x08, 0x28,
+	0x4f, 0x82, 0x3c, 0x0e, 0x5b, 0x5a, 0x55, 0x82, 0x9b, 0xe7, 0x37, 0x9f, 0xc7, 0xfa, 0xbe, 0x91,
+	0x59, 0xd6, 0x5b, 0xf4, 0x58, 0x34, 0x50, 0x4b, 0x34, 0x85, 0x6a, 0x8a, 0x0d, 0xda, 0x0b, 0xd9,
+	0xa2, 0x96, 0x90, 0x53, 0x8b, 0x2f, 0x7a, 0xfb, 0xc5, 0x5d, 0xe6, 0x51, 0x91, 0xab, 0x66, 0xf5,
+	0x7d, 0xc4, 0x16, 0xaf, 0xd0, 0x5e, 0x9c, 0x91, 0xea, 0x8d, 0x47, 0x0b, 0xfc, 0x11, 0x5b, 0x7c,
+	0xd8, 0x3a, 0xaf, 0x37, 0x1a, 0x54, 0x05, 0x3d, 0xca, 0x56, 0x24, 0x59, 0xb2, 0x4e, 0xcb, 0xf9,
+	0x80, 0x5f, 0x06, 0x1a, 0x84, 0x1b, 0x6d, 0xea, 0x4e, 0x7f, 0x1d, 0x84, 0xa3, 0x28, 0x1c, 0x70,
+	0x14, 0x3e, 0x64, 0x57, 0xa4, 0xb2, 0x88, 0x5e, 0x8c, 0xb3, 0x64, 0x7d, 0x5c, 0xde, 0x19, 0x68,
+	0x89, 0xe8, 0xc3, 0xbc, 0xde, 0x6e, 0x0d, 0x54, 0xbe, 0xb5, 0xe0, 0x5a, 0xec, 0x94, 0x48, 0xe3,
+	0x3c, 0xc2, 0x6f, 0xff, 0x50, 0xfe, 0x94, 0x4d, 0x0c, 0x2a, 0x70, 0x62, 0x92, 0x8d, 0xd7, 0x47,
+	0x8f, 0xef, 0xe7, 0x37, 0x6c, 0xe5, 0x57, 0x96, 0x5e, 0xa3, 0x82, 0x32, 0xaa, 0xc3, 0xb5, 0x8f,
+	0xe8, 0xc1, 0x89, 0xe9, 0x3f, 0xaf, 0xbd, 0x43, 0x0f, 0x65, 0x54, 0xf3, 0xbb, 0x6c, 0xd6, 0xd4,
+	0x5d, 0x6d, 0x24, 0x38, 0x71, 0x90, 0x8d, 0xd7, 0x69, 0x39, 0xd4, 0xab, 0x6f, 0x23, 0x36, 0xbf,
+	0xfe, 0x18, 0xe7, 0x2c, 0x75, 0x1d, 0xfa, 0x7d, 0x66, 0x74, 0x0e, 0x8c, 0x6c, 0x8f, 0xc8, 0x36,
+	0x9d, 0xf9, 0x09, 0x9b, 0xf6, 0xb5, 0x05, 0x13, 0xc3, 0x48, 0xcb, 0x7d, 0x75, 0x5b, 0xfc, 0xe9,
+	0xff, 0xc6, 0x3f, 0xb9, 0x35, 0xfe, 0x13, 0x36, 0xfd, 0x04, 0xfa, 0xbc, 0xf5, 0x62, 0x1a, 0x5f,
+	0x8a, 0x15, 0x3f, 0x65, 0xac, 0x01, 0xe7, 0x2b, 0xd9, 0xea, 0x4e, 0x89, 0x03, 0xea, 0x1d, 0x06,
+	0x72, 0x16, 0x40, 0x98, 0x4f, 0x6d, 0x05, 0x4e, 0x82, 0x51, 0xb5, 0xf1, 0x62, 0x16, 0xe7, 0x07,
+	0xfc, 0x62, 0xa0, 0x2b, 0x64, 0xf3, 0xeb, 0xc9, 0xf1, 0x07, 0xec, 0x58, 0x6e, 0x6d, 0xb0, 0x13,
+	0xd7, 0x9d, 0x90, 0xef, 0xa3, 0x3d, 0xa3, 0x65, 0xdf, 0x63, 0x87, 0x06, 0x3e, 0xfb, 0xea, 0xaf,
+	0x5c, 0x66, 0x01, 0x50, 0xf3, 0x94, 0x31, 0x6a, 0x46, 0x57, 0x31, 0x1f, 0x92, 0x93, 0xa1, 0xe7,
+	0xcf, 0x7e, 0xec, 0x96, 0xc9, 0xcf, 0xdd, 0x32, 0xf9, 0xb5, 0x5b, 0x26, 0xef, 0xf3, 0x73, 0xed,
+	0xdb, 0x6d, 0x93, 0x4b, 0xbc, 0x2c, 0x68, 0xa3, 0xb5, 0xd7, 0xb2, 0xab, 0x1b, 0x17, 0xab, 0xe2,
+	0xc6, 0xaf, 0x68, 0xa6, 0x04, 0x9e, 0xfc, 0x1e, 0x00, 0x8e, 0x8e, 0xcf, 0xb9, 0x2f, 0x03, 0x00,
+	0x00,
+}
+
+func (m *ForkChoiceStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Balances) > 0 {
+		dAtA2 := make([]byte, len(m.Balances)*10)
+		var j1 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintForkchoice(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintForkchoice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintForkchoice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PruneThreshold != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.PruneThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FinalizedRoot) > 0 {
+		i -= len(m.FinalizedRoot)
+		copy(dAtA[i:], m.FinalizedRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.NextEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextRoot) > 0 {
+		i -= len(m.NextRoot)
+		copy(dAtA[i:], m.NextRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.NextRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentRoot) > 0 {
+		i -= len(m.CurrentRoot)
+		copy(dAtA[i:], m.CurrentRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.CurrentRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForkchoice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForkchoice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForkChoiceStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.PruneThreshold != 0 {
+		n += 1 + sovForkchoice(uint64(m.PruneThreshold))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovForkchoice(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovForkchoice(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovForkchoice(uint64(e))
+		}
+		n += 1 + sovForkchoice(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovForkchoice(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovForkchoice(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovForkchoice(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovForkchoice(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovForkchoice(uint64(m.BestDescendant))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	l = len(m.NextRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.NextEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.NextEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovForkchoice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForkchoice(x uint64) (n int) {
+	return sovForkchoice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForkChoiceStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			}
+			m.PruneThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &ForkChoiceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &ForkChoiceVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowForkchoice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Balances = append(m.Balances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowForkchoice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthForkchoice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthForkchoice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Balances) == 0 {
+					m.Balances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowForkchoice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Balances = append(m.Balances, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForkchoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			}
+			m.BestChild = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestChild |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			}
+			m.BestDescendant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestDescendant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForkchoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRoot = append(m.CurrentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentRoot == nil {
+				m.CurrentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRoot = append(m.NextRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextRoot == nil {
+				m.NextRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpoch", wireType)
+			}
+			m.NextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForkchoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForkchoice(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForkchoice
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthForkchoice
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowForkchoice
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipForkchoice(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthForkchoice
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthForkchoice = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForkchoice   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/validator_rewards.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ArchivedValidatorRewards is the breakdown of the rewards and penalties applied
+// to validator balances when processing an epoch, ordered by validator index.
+type ArchivedValidatorRewards struct {
+	Rewards              []*ValidatorRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ArchivedValidatorRewards) Reset()         { *m = ArchivedValidatorRewards{} }
+func (m *ArchivedValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ArchivedValidatorRewards) ProtoMessage()    {}
+func (*ArchivedValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3081155675cdfc, []int{0}
+}
+func (m *ArchivedValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedValidatorRewards.Merge(m, src)
+}
+func (m *ArchivedValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedValidatorRewards proto.InternalMessageInfo
+
+func (m *ArchivedValidatorRewards) GetRewards() []*ValidatorRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// ValidatorRewards is the breakdown of the rewards and penalties of a validator
+// for an epoch, in Gwei.
+type ValidatorRewards struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,2,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,3,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,4,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,5,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,6,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,7,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,8,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,9,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,10,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	SlashingPenalty      uint64   `protobuf:"varint,11,opt,name=slashing_penalty,json=slashingPenalty,proto3" json:"slashing_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3081155675cdfc, []int{1}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSlashingPenalty() uint64 {
+	if m != nil {
+		return m.SlashingPenalty
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ArchivedValidatorRewards)(nil), "prysm.beacon.db.ArchivedValidatorRewards")
+	proto.RegisterType((*ValidatorRewards)(nil), "prysm.beacon.db.ValidatorRewards")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/validator_rewards.proto", fileDescriptor_6b3081155675cdfc)
+}
+
+var fileDescriptor_6b3081155675cdfc = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x53, 0x41, 0xd0, 0x0b, 0x52, 0x6c, 0x8c, 0xe9, 0x0a, 0x01, 0x63, 0xc0, 0x85, 0x6d,
+	0xa2, 0xee, 0x74, 0xa3, 0x71, 0xe3, 0xce, 0xb0, 0xd0, 0xc4, 0x0d, 0x99, 0x76, 0x26, 0x74, 0x92,
+	0x32, 0xd3, 0xcc, 0x0c, 0x68, 0xdf, 0xd0, 0x25, 0x8f, 0x60, 0x78, 0x12, 0xd3, 0xf9, 0x29, 0x09,
+	0x2e, 0x7b, 0xee, 0x77, 0xbe, 0xdc, 0x74, 0x2e, 0x4c, 0x0a, 0xc1, 0x15, 0x8f, 0x13, 0x82, 0x52,
+	0xce, 0x62, 0x9c, 0xc4, 0x6b, 0x94, 0x53, 0x8c, 0x14, 0x17, 0x73, 0x41, 0xbe, 0x90, 0xc0, 0x32,
+	0xd2, 0x44, 0xe0, 0x17, 0xa2, 0x94, 0xcb, 0xc8, 0x80, 0x11, 0x4e, 0xc6, 0x1f, 0x10, 0x3e, 0x89,
+	0x34, 0xa3, 0x6b, 0x82, 0xdf, 0x5d, 0x67, 0x66, 0x2a, 0xc1, 0x03, 0xb4, 0x6d, 0x3b, 0xf4, 0x86,
+	0x8d, 0x69, 0xe7, 0x76, 0x14, 0xed, 0xd5, 0xa3, 0xfd, 0xce, 0xcc, 0x35, 0xc6, 0x9b, 0x06, 0xf4,
+	0xff, 0x19, 0x27, 0xe0, 0xef, 0x36, 0xa3, 0x0c, 0x93, 0xef, 0xd0, 0x1b, 0x7a, 0xd3, 0xe6, 0xac,
+	0x57, 0xc7, 0xaf, 0x55, 0x1a, 0x5c, 0xc2, 0x89, 0xe4, 0x2b, 0x91, 0x12, 0xbb, 0x7f, 0x78, 0xa0,
+	0xb1, 0xae, 0x09, 0x8d, 0x2e, 0xb8, 0x82, 0x9e, 0x85, 0x0a, 0xc2, 0x50, 0xae, 0xca, 0xb0, 0xa1,
+	0x29, 0x5b, 0x7d, 0x33, 0x61, 0xe5, 0x52, 0x48, 0x2c, 0x88, 0x72, 0xae, 0xa6, 0x71, 0x99, 0x70,
+	0xe7, 0xb2, 0x90, 0x73, 0x1d, 0x1a, 0x97, 0x49, 0x9d, 0xeb, 0x02, 0x3a, 0x19, 0x41, 0xd8, 0x99,
+	0x5a, 0x9a, 0x81, 0x2a, 0xb2, 0x9e, 0x11, 0x74, 0x35, 0xe0, 0x2c, 0x6d, 0x4d, 0xe8, 0x92, 0x73,
+	0xdc, 0xc3, 0x39, 0x65, 0x69, 0xbe, 0x92, 0x94, 0xb3, 0x39, 0x26, 0x39, 0x2a, 0x9d, 0xee, 0x48,
+	0xc3, 0x67, 0xf5, 0xf4, 0xa5, 0x1a, 0x5a, 0xf1, 0x04, 0xfc, 0x42, 0xf0, 0x82, 0x4b, 0xe2, 0xde,
+	0x34, 0x3c, 0x36, 0xbf, 0xce, 0xc5, 0x16, 0xbc, 0x81, 0x80, 0x32, 0x94, 0x2a, 0xba, 0xa6, 0xaa,
+	0xac, 0xf7, 0x00, 0xcd, 0x9e, 0xee, 0x26, 0x6e, 0x9b, 0x6b, 0xe8, 0xcb, 0x1c, 0xc9, 0x8c, 0xb2,
+	0x45, 0x0d, 0x77, 0x34, 0xec, 0xbb, 0xdc, 0xa2, 0xcf, 0x8f, 0x3f, 0xdb, 0x81, 0xb7, 0xd9, 0x0e,
+	0xbc, 0xdf, 0xed, 0xc0, 0xfb, 0x8c, 0x16, 0x54, 0x65, 0xab, 0x24, 0x4a, 0xf9, 0x32, 0xd6, 0x67,
+	0x81, 0x14, 0x4d, 0x73, 0x94, 0x48, 0xf3, 0x15, 0xef, 0x9d, 0x64, 0xd2, 0xd2, 0xc1, 0xdd, 0xdf,
+	0x00, 0x82, 0x5d, 0xa4, 0x0a, 0xac, 0x02, 0x00, 0x00,
+}
+
+func (m *ArchivedValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlashingPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.SlashingPenalty))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovValidatorRewards(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.ValidatorIndex))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.SourceReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.SourcePenalty))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.TargetReward))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.TargetPenalty))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.HeadReward))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.HeadPenalty))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.InclusionDelayReward))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.ProposerReward))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.InactivityPenalty))
+	}
+	if m.SlashingPenalty != 0 {
+		n += 1 + sovValidatorRewards(uint64(m.SlashingPenalty))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovValidatorRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorRewards(x uint64) (n int) {
+	return sovValidatorRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchivedValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ValidatorRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
+			}
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
+			}
+			m.TargetPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
+			}
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			m.ProposerReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+			}
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPenalty", wireType)
+			}
+			m.SlashingPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorRewards
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthValidatorRewards
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowValidatorRewards
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipValidatorRewards(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthValidatorRewards
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthValidatorRewards = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorRewards   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/node.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SyncProgress struct {
+	// Whether or not the node is currently syncing.
+	Syncing bool `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// Head slot of the node at the moment the sync has started.
+	StartSlot uint64 `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	// Current head slot of the node.
+	HeadSlot uint64 `protobuf:"varint,3,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	// Current slot of the chain, as derived from the genesis time.
+	TargetSlot uint64 `protobuf:"varint,4,opt,name=target_slot,json=targetSlot,proto3" json:"target_slot,omitempty"`
+	// Start slot of the epoch after the highest finalized epoch reported by peers.
+	HighestFinalizedSlot uint64 `protobuf:"varint,5,opt,name=highest_finalized_slot,json=highestFinalizedSlot,proto3" json:"highest_finalized_slot,omitempty"`
+	// Highest head slot reported by connected peers.
+	HighestPeerSlot uint64 `protobuf:"varint,6,opt,name=highest_peer_slot,json=highestPeerSlot,proto3" json:"highest_peer_slot,omitempty"`
+	// Recent block processing rate.
+	BlocksPerSecond float64 `protobuf:"fixed64,7,opt,name=blocks_per_second,json=blocksPerSecond,proto3" json:"blocks_per_second,omitempty"`
+	// Estimated number of seconds left to reach the target slot.
+	SecondsRemaining uint64 `protobuf:"varint,8,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`
+	// Number of peers blocks are being requested from.
+	SyncingPeers uint64 `protobuf:"varint,9,opt,name=syncing_peers,json=syncingPeers,proto3" json:"syncing_peers,omitempty"`
+	// Number of connected peers.
+	ConnectedPeers       uint64   `protobuf:"varint,10,opt,name=connected_peers,json=connectedPeers,proto3" json:"connected_peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncProgress) Reset()         { *m = SyncProgress{} }
+func (m *SyncProgress) String() string { return proto.CompactTextString(m) }
+func (*SyncProgress) ProtoMessage()    {}
+func (*SyncProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95a1128d682b92e7, []int{0}
+}
+func (m *SyncProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncProgress.Merge(m, src)
+}
+func (m *SyncProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncProgress proto.InternalMessageInfo
+
+func (m *SyncProgress) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncProgress) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetTargetSlot() uint64 {
+	if m != nil {
+		return m.TargetSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetHighestFinalizedSlot() uint64 {
+	if m != nil {
+		return m.HighestFinalizedSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetHighestPeerSlot() uint64 {
+	if m != nil {
+		return m.HighestPeerSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetBlocksPerSecond() float64 {
+	if m != nil {
+		return m.BlocksPerSecond
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetSecondsRemaining() uint64 {
+	if m != nil {
+		return m.SecondsRemaining
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetSyncingPeers() uint64 {
+	if m != nil {
+		return m.SyncingPeers
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetConnectedPeers() uint64 {
+	if m != nil {
+		return m.ConnectedPeers
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SyncProgress)(nil), "ethereum.beacon.rpc.v1.SyncProgress")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/node.proto", fileDescriptor_95a1128d682b92e7) }
+
+var fileDescriptor_95a1128d682b92e7 = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xd1, 0x8a, 0x13, 0x31,
+	0x14, 0x86, 0x99, 0xdd, 0xba, 0xdb, 0x1e, 0xab, 0x75, 0x83, 0x94, 0xb1, 0xd5, 0x5a, 0x57, 0xc1,
+	0xa2, 0x90, 0xb0, 0xea, 0x13, 0x08, 0xea, 0x9d, 0x94, 0xf6, 0x01, 0x86, 0x34, 0x73, 0x76, 0x3a,
+	0x38, 0x4d, 0x42, 0x92, 0x5d, 0xe8, 0x5e, 0xfa, 0x00, 0xde, 0xf8, 0x52, 0x5e, 0x0a, 0xbe, 0x80,
+	0x14, 0x1f, 0x44, 0xe6, 0x24, 0x23, 0x0a, 0x5e, 0xce, 0xff, 0x7d, 0x7f, 0xe6, 0x90, 0x13, 0x98,
+	0x59, 0x67, 0x82, 0x11, 0x1b, 0x94, 0xca, 0x68, 0xe1, 0xac, 0x12, 0xd7, 0x17, 0x42, 0x9b, 0x12,
+	0x39, 0x01, 0x36, 0xc6, 0xb0, 0x45, 0x87, 0x57, 0x3b, 0x1e, 0x15, 0xee, 0xac, 0xe2, 0xd7, 0x17,
+	0x93, 0x87, 0x95, 0x31, 0x55, 0x83, 0x42, 0xda, 0x5a, 0x48, 0xad, 0x4d, 0x90, 0xa1, 0x36, 0xda,
+	0xc7, 0xd6, 0x64, 0x9a, 0x28, 0x7d, 0x6d, 0xae, 0x2e, 0x05, 0xee, 0x6c, 0xd8, 0x47, 0x78, 0xfe,
+	0xe5, 0x18, 0x86, 0xeb, 0xbd, 0x56, 0x4b, 0x67, 0x2a, 0x87, 0xde, 0xb3, 0x1c, 0x4e, 0xfd, 0x5e,
+	0xab, 0x5a, 0x57, 0x79, 0x36, 0xcf, 0x16, 0xfd, 0x55, 0xf7, 0xc9, 0x1e, 0x01, 0xf8, 0x20, 0x5d,
+	0x28, 0x7c, 0x63, 0x42, 0x7e, 0x34, 0xcf, 0x16, 0xbd, 0xd5, 0x80, 0x92, 0x75, 0x63, 0x02, 0x9b,
+	0xc2, 0x60, 0x8b, 0xb2, 0x8c, 0xf4, 0x98, 0x68, 0xbf, 0x0d, 0x08, 0x3e, 0x86, 0xdb, 0x41, 0xba,
+	0x0a, 0x53, 0xb9, 0x47, 0x18, 0x62, 0x44, 0xc2, 0x1b, 0x18, 0x6f, 0xeb, 0x6a, 0x8b, 0x3e, 0x14,
+	0x97, 0xb5, 0x96, 0x4d, 0x7d, 0x83, 0xe9, 0xa8, 0x5b, 0xe4, 0xde, 0x4f, 0xf4, 0x7d, 0x07, 0xa9,
+	0xf5, 0x02, 0xce, 0xba, 0x96, 0x45, 0x74, 0xb1, 0x70, 0x42, 0x85, 0x51, 0x02, 0x4b, 0x44, 0xd7,
+	0xb9, 0x9b, 0xc6, 0xa8, 0x4f, 0xbe, 0xb0, 0xad, 0x89, 0xca, 0xe8, 0x32, 0x3f, 0x9d, 0x67, 0x8b,
+	0x6c, 0x35, 0x8a, 0x60, 0x89, 0x6e, 0x4d, 0x31, 0x7b, 0x09, 0x67, 0x51, 0xf0, 0x85, 0xc3, 0x9d,
+	0xac, 0x75, 0x7b, 0x1d, 0x7d, 0x3a, 0xf7, 0x5e, 0x02, 0xab, 0x2e, 0x67, 0x4f, 0xe1, 0x4e, 0xba,
+	0x22, 0x1a, 0xc2, 0xe7, 0x03, 0x12, 0x87, 0x29, 0x6c, 0x07, 0xf0, 0xec, 0x39, 0x8c, 0x94, 0xd1,
+	0x1a, 0x55, 0xc0, 0x32, 0x69, 0x40, 0xda, 0xdd, 0x3f, 0x31, 0x89, 0xaf, 0x6e, 0xa0, 0xf7, 0xd1,
+	0x94, 0xc8, 0x1c, 0x8c, 0x3e, 0x60, 0xf8, 0x67, 0x35, 0x63, 0x1e, 0x37, 0xc9, 0xbb, 0x4d, 0xf2,
+	0x77, 0xed, 0x26, 0x27, 0xcf, 0xf8, 0xff, 0xdf, 0x05, 0xff, 0xbb, 0x7d, 0xfe, 0xe4, 0xf3, 0x8f,
+	0x5f, 0x5f, 0x8f, 0xa6, 0xec, 0x81, 0xb0, 0x6e, 0xef, 0x77, 0xf4, 0xae, 0x44, 0x3b, 0x63, 0x61,
+	0x93, 0xf2, 0x76, 0xf8, 0xed, 0x30, 0xcb, 0xbe, 0x1f, 0x66, 0xd9, 0xcf, 0xc3, 0x2c, 0xdb, 0x9c,
+	0xd0, 0x6f, 0x5e, 0xff, 0x1e, 0x00, 0x35, 0x1b, 0x8b, 0xd0, 0x96, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	// Retrieve the progress of the ongoing initial sync of the node.
+	GetSyncProgress(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncProgress, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) GetSyncProgress(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncProgress, error) {
+	out := new(SyncProgress)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Node/GetSyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	// Retrieve the progress of the ongoing initial sync of the node.
+	GetSyncProgress(context.Context, *types.Empty) (*SyncProgress, error)
+}
+
+// UnimplementedNodeServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeServer struct {
+}
+
+func (*UnimplementedNodeServer) GetSyncProgress(ctx context.Context, req *types.Empty) (*SyncProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncProgress not implemented")
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_GetSyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetSyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Node/GetSyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetSyncProgress(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSyncProgress",
+			Handler:    _Node_GetSyncProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/node.proto",
+}
+
+func (m *SyncProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ConnectedPeers != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.ConnectedPeers))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SyncingPeers != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.SyncingPeers))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SecondsRemaining != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.SecondsRemaining))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlocksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BlocksPerSecond))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.HighestPeerSlot != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.HighestPeerSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HighestFinalizedSlot != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.HighestFinalizedSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetSlot != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.TargetSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNode(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SyncProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.StartSlot != 0 {
+		n += 1 + sovNode(uint64(m.StartSlot))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovNode(uint64(m.HeadSlot))
+	}
+	if m.TargetSlot != 0 {
+		n += 1 + sovNode(uint64(m.TargetSlot))
+	}
+	if m.HighestFinalizedSlot != 0 {
+		n += 1 + sovNode(uint64(m.HighestFinalizedSlot))
+	}
+	if m.HighestPeerSlot != 0 {
+		n += 1 + sovNode(uint64(m.HighestPeerSlot))
+	}
+	if m.BlocksPerSecond != 0 {
+		n += 9
+	}
+	if m.SecondsRemaining != 0 {
+		n += 1 + sovNode(uint64(m.SecondsRemaining))
+	}
+	if m.SyncingPeers != 0 {
+		n += 1 + sovNode(uint64(m.SyncingPeers))
+	}
+	if m.ConnectedPeers != 0 {
+		n += 1 + sovNode(uint64(m.ConnectedPeers))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovNode(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNode(x uint64) (n int) {
+	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SyncProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSlot", wireType)
+			}
+			m.TargetSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestFinalizedSlot", wireType)
+			}
+			m.HighestFinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestFinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestPeerSlot", wireType)
+			}
+			m.HighestPeerSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestPeerSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BlocksPerSecond = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsRemaining", wireType)
+			}
+			m.SecondsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncingPeers", wireType)
+			}
+			m.SyncingPeers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncingPeers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedPeers", wireType)
+			}
+			m.ConnectedPeers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectedPeers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNode(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNode
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthNode
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowNode
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipNode(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthNode
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthNode = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNode   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,171 @@
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math/bits"
+
+	bls12 "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// SignatureSet is a collection of signatures, together with the public keys, messages and domains
+// they have to be verified against. The i-th signature is expected to be a signature of the i-th
+// message, under the i-th domain, by the i-th public key.
+type SignatureSet struct {
+	Signatures []*Signature
+	PublicKeys []*PublicKey
+	Messages   [][32]byte
+	Domains    []uint64
+}
+
+// NewSet creates an empty signature set.
+func NewSet() *SignatureSet {
+	return &SignatureSet{}
+}
+
+// Add appends a single signature, with its public key, message and domain, to the set.
+func (s *SignatureSet) Add(sig *Signature, pub *PublicKey, msg [32]byte, domain uint64) *SignatureSet {
+	s.Signatures = append(s.Signatures, sig)
+	s.PublicKeys = append(s.PublicKeys, pub)
+	s.Messages = append(s.Messages, msg)
+	s.Domains = append(s.Domains, domain)
+	return s
+}
+
+// Join appends all the entries of another set to the set.
+func (s *SignatureSet) Join(set *SignatureSet) *SignatureSet {
+	s.Signatures = append(s.Signatures, set.Signatures...)
+	s.PublicKeys = append(s.PublicKeys, set.PublicKeys...)
+	s.Messages = append(s.Messages, set.Messages...)
+	s.Domains = append(s.Domains, set.Domains...)
+	return s
+}
+
+// Len returns the number of signatures in the set.
+func (s *SignatureSet) Len() int {
+	return len(s.Signatures)
+}
+
+// Verify verifies all the signatures of the set at once, and returns true only if every single
+// one of them is valid. An empty set is trivially valid.
+//
+// Rather than checking e(pk_i, H(m_i)) == e(g1, sig_i) for each entry, a random 64-bit scalar r_i
+// is drawn per entry, and the single aggregate check
+//   e(g1, sum(r_i * sig_i)) == prod(e(r_i * pk_i, H(m_i)))
+// is performed. Entries sharing a message and domain are merged into a single pairing, by summing
+// their scaled public keys. The randomization prevents invalid signatures from cancelling each
+// other out, so the batch passes with an invalid entry with probability of at most 2^-64.
+func (s *SignatureSet) Verify() bool {
+	if featureconfig.Get().SkipBLSVerify {
+		return true
+	}
+	size := len(s.Signatures)
+	if size != len(s.PublicKeys) || size != len(s.Messages) || size != len(s.Domains) {
+		return false
+	}
+	switch size {
+	case 0:
+		return true
+	case 1:
+		return s.Signatures[0].Verify(s.Messages[0][:], s.PublicKeys[0], s.Domains[0])
+	}
+
+	type signingData struct {
+		msg    [32]byte
+		domain uint64
+	}
+	indices := make(map[signingData]int, size)
+	rawKeys := make([]bls12.PublicKey, 0, size)
+	hashWithDomains := make([]byte, 0, size*concatMsgDomainSize)
+	var aggregated *bls12.Sign
+	for i := 0; i < size; i++ {
+		if s.Signatures[i] == nil || s.Signatures[i].s == nil || s.PublicKeys[i] == nil || s.PublicKeys[i].p == nil {
+			return false
+		}
+		r, err := randomScalar()
+		if err != nil {
+			return false
+		}
+		sig := mulSignature(s.Signatures[i].s, r)
+		if aggregated == nil {
+			aggregated = sig
+		} else {
+			aggregated.Add(sig)
+		}
+
+		pub := mulPublicKey(s.PublicKeys[i].p, r)
+		key := signingData{msg: s.Messages[i], domain: s.Domains[i]}
+		if idx, ok := indices[key]; ok {
+			rawKeys[idx].Add(pub)
+			continue
+		}
+		indices[key] = len(rawKeys)
+		rawKeys = append(rawKeys, *pub)
+		hashWithDomains = append(hashWithDomains, concatMsgAndDomain(key.msg[:], key.domain)...)
+	}
+	if len(rawKeys) == 1 {
+		return aggregated.VerifyHashWithDomain(&rawKeys[0], hashWithDomains)
+	}
+	return aggregated.VerifyAggregateHashWithDomain(rawKeys, hashWithDomains)
+}
+
+// VerifyBatch verifies multiple signature sets, and reports the validity of each of them. All the
+// sets are verified in a single batch first, and only if the batch fails, each set is verified
+// on its own in order to find the invalid ones.
+func VerifyBatch(sets []*SignatureSet) []bool {
+	results := make([]bool, len(sets))
+	batch := NewSet()
+	for _, set := range sets {
+		batch.Join(set)
+	}
+	if batch.Verify() {
+		for i := range results {
+			results[i] = true
+		}
+		return results
+	}
+	for i, set := range sets {
+		results[i] = set.Verify()
+	}
+	return results
+}
+
+// randomScalar returns a non-zero random 64-bit scalar.
+func randomScalar() (uint64, error) {
+	b := [8]byte{}
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			return 0, err
+		}
+		if r := binary.LittleEndian.Uint64(b[:]); r != 0 {
+			return r, nil
+		}
+	}
+}
+
+// mulPublicKey returns r*p, computed by double-and-add. The input key is not modified.
+func mulPublicKey(p *bls12.PublicKey, r uint64) *bls12.PublicKey {
+	res := *p
+	for i := bits.Len64(r) - 2; i >= 0; i-- {
+		double := res
+		res.Add(&double)
+		if (r>>uint(i))&1 == 1 {
+			res.Add(p)
+		}
+	}
+	return &res
+}
+
+// mulSignature returns r*s, computed by double-and-add. The input signature is not modified.
+func mulSignature(s *bls12.Sign, r uint64) *bls12.Sign {
+	res := *s
+	for i := bits.Len64(r) - 2; i >= 0; i-- {
+		double := res
+		res.Add(&double)
+		if (r>>uint(i))&1 == 1 {
+			res.Add(s)
+		}
+	}
+	return &res
+}
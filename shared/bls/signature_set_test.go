@@ -0,0 +1,102 @@
+package bls_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func signedSet(n int, msg [32]byte, domain uint64) *bls.SignatureSet {
+	set := bls.NewSet()
+	for i := 0; i < n; i++ {
+		priv := bls.RandKey()
+		set.Add(priv.Sign(msg[:], domain), priv.PublicKey(), msg, domain)
+	}
+	return set
+}
+
+func TestSignatureSet_Verify(t *testing.T) {
+	set := bls.NewSet()
+	for i := 0; i < 10; i++ {
+		set.Join(signedSet(1, [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}, uint64(i%3)))
+	}
+	// Entries with a common message and domain.
+	set.Join(signedSet(5, [32]byte{'c', 'o', 'm', 'm', 'o', 'n'}, 1))
+	if set.Len() != 15 {
+		t.Fatalf("Expected 15 signatures in set, received %d", set.Len())
+	}
+	if !set.Verify() {
+		t.Error("Signature set did not verify")
+	}
+}
+
+func TestSignatureSet_Verify_Empty(t *testing.T) {
+	if !bls.NewSet().Verify() {
+		t.Error("Empty signature set did not verify")
+	}
+}
+
+func TestSignatureSet_Verify_InvalidSignature(t *testing.T) {
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	set := signedSet(4, msg, 0)
+	// Signature of a different message.
+	priv := bls.RandKey()
+	set.Add(priv.Sign([]byte("world"), 0), priv.PublicKey(), msg, 0)
+	if set.Verify() {
+		t.Error("Signature set with an invalid signature verified")
+	}
+}
+
+func TestSignatureSet_Verify_SwappedSignatures(t *testing.T) {
+	msg1 := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	msg2 := [32]byte{'w', 'o', 'r', 'l', 'd'}
+	set1 := signedSet(1, msg1, 0)
+	set2 := signedSet(1, msg2, 0)
+	// Swapping the signatures keeps the plain sum of signatures intact, which must not be
+	// sufficient for the batch to pass.
+	set1.Signatures[0], set2.Signatures[0] = set2.Signatures[0], set1.Signatures[0]
+	if set1.Join(set2).Verify() {
+		t.Error("Signature set with swapped signatures verified")
+	}
+}
+
+func TestVerifyBatch(t *testing.T) {
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	invalid := signedSet(2, msg, 0)
+	invalid.Domains[1] = 1
+	sets := []*bls.SignatureSet{
+		signedSet(2, msg, 0),
+		invalid,
+		signedSet(3, [32]byte{'w', 'o', 'r', 'l', 'd'}, 0),
+	}
+
+	tests := []struct {
+		name string
+		sets []*bls.SignatureSet
+		want []bool
+	}{
+		{
+			name: "all valid",
+			sets: []*bls.SignatureSet{sets[0], sets[2]},
+			want: []bool{true, true},
+		},
+		{
+			name: "falls back to individual checks",
+			sets: sets,
+			want: []bool{true, false, true},
+		},
+		{
+			name: "no sets",
+			sets: []*bls.SignatureSet{},
+			want: []bool{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bls.VerifyBatch(tt.sets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("VerifyBatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -39,7 +39,9 @@ type Flags struct {
 	KafkaBootstrapServers     string // KafkaBootstrapServers to find kafka servers to stream blocks, attestations, etc.
 	ProtectProposer           bool   // ProtectProposer prevents the validator client from signing any proposals that would be considered a slashable offense.
 	ProtectAttester           bool   // ProtectAttester prevents the validator client from signing any attestations that would be considered a slashable offense.
-	ProtoArrayForkChoice      bool   // ProtoArrayForkChoice enables proto array fork choice. Significant improvements over the spec version.
+	EnableInitSyncQueue       bool   // EnableInitSyncQueue enables the pipelined blocks queue in initial sync.
+	EnableBatchVerification   bool   // EnableBatchVerification verifies BLS signatures of blocks in initial sync and of gossiped attestations in batches.
+	EnableTimelyTieBreak      bool   // EnableTimelyTieBreak prefers timely blocks over late blocks of equal weight in fork choice.
 
 	// DisableForkChoice disables using LMD-GHOST fork choice to update
 	// the head of the chain based on attestations and instead accepts any valid received block
@@ -52,7 +54,6 @@ type Flags struct {
 	EnableEth1DataVoteCache  bool // EnableEth1DataVoteCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
 	EnableSkipSlotsCache     bool // EnableSkipSlotsCache caches the state in skipped slots.
 	EnableSlasherConnection  bool // EnableSlasher enable retrieval of slashing events from a slasher instance.
-	EnableBlockTreeCache     bool // EnableBlockTreeCache enable fork choice service to maintain latest filtered block tree.
 	EnableProposerIndexCache bool // EnableProposerIndexCache enable caching of proposer index.
 }
 
@@ -134,17 +135,21 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enable slasher connection.")
 		cfg.EnableSlasherConnection = true
 	}
-	if ctx.GlobalBool(cacheFilteredBlockTreeFlag.Name) {
-		log.Warn("Enabled filtered block tree cache for fork choice.")
-		cfg.EnableBlockTreeCache = true
-	}
 	if ctx.GlobalBool(cacheProposerIndicesFlag.Name) {
 		log.Warn("Enabled proposer index caching.")
 		cfg.EnableProposerIndexCache = true
 	}
-	if ctx.GlobalBool(protoArrayForkChoice.Name) {
-		log.Warn("Enabled using proto array fork choice over spec fork choice.")
-		cfg.ProtoArrayForkChoice = true
+	if ctx.GlobalBool(enableInitSyncQueue.Name) {
+		log.Warn("Enabled blocks queue in initial sync.")
+		cfg.EnableInitSyncQueue = true
+	}
+	if ctx.GlobalBool(enableBatchVerification.Name) {
+		log.Warn("Enabled batch verification of BLS signatures.")
+		cfg.EnableBatchVerification = true
+	}
+	if ctx.GlobalBool(enableTimelyTieBreak.Name) {
+		log.Warn("Enabled timely block tie-break in fork choice.")
+		cfg.EnableTimelyTieBreak = true
 	}
 	Init(cfg)
 }
@@ -74,11 +74,6 @@ var (
 			"triggered the genesis as the genesis time. This flag should be used for local " +
 			"development and testing only.",
 	}
-	cacheFilteredBlockTreeFlag = cli.BoolFlag{
-		Name: "cache-filtered-block-tree",
-		Usage: "Cache filtered block tree by maintaining it rather than continually recalculating on the fly, " +
-			"this is used for fork choice.",
-	}
 	cacheProposerIndicesFlag = cli.BoolFlag{
 		Name:  "cache-proposer-indices",
 		Usage: "Cache proposer indices on per epoch basis.",
@@ -93,9 +88,20 @@ var (
 		Usage: "Prevent the validator client from signing and broadcasting 2 any slashable attestations. " +
 			"Protects from slashing.",
 	}
-	protoArrayForkChoice = cli.BoolFlag{
-		Name:  "proto-array-forkchoice",
-		Usage: "Uses proto array fork choice over the naive spec fork choice. Better implementation in terms of mem usage and speed. ",
+	enableInitSyncQueue = cli.BoolFlag{
+		Name: "enable-initial-sync-queue",
+		Usage: "Enables concurrent fetching and processing of blocks on initial sync. Several batch requests are " +
+			"kept in flight per peer, and failed ranges are redistributed to other peers.",
+	}
+	enableBatchVerification = cli.BoolFlag{
+		Name: "enable-batch-bls-verification",
+		Usage: "Enables verification of BLS signatures in batches. All the signatures of a block are verified at once " +
+			"during initial sync, and gossiped attestation signatures are buffered and verified together.",
+	}
+	enableTimelyTieBreak = cli.BoolFlag{
+		Name: "enable-timely-block-tie-break",
+		Usage: "Enables a fork choice tie-break which prefers blocks that arrived early in their slot over late " +
+			"blocks when both have the same weight.",
 	}
 )
 
@@ -184,6 +190,16 @@ var (
 		Usage:  deprecatedUsage,
 		Hidden: true,
 	}
+	deprecatedCacheFilteredBlockTreeFlag = cli.BoolFlag{
+		Name:   "cache-filtered-block-tree",
+		Usage:  deprecatedUsage,
+		Hidden: true,
+	}
+	deprecatedProtoArrayForkChoiceFlag = cli.BoolFlag{
+		Name:   "proto-array-forkchoice",
+		Usage:  deprecatedUsage,
+		Hidden: true,
+	}
 )
 
 var deprecatedFlags = []cli.Flag{
@@ -203,6 +219,8 @@ var deprecatedFlags = []cli.Flag{
 	deprecatedNewCacheFlag,
 	deprecatedEnableShuffledIndexCacheFlag,
 	deprecatedSaveDepositDataFlag,
+	deprecatedCacheFilteredBlockTreeFlag,
+	deprecatedProtoArrayForkChoiceFlag,
 }
 
 // ValidatorFlags contains a list of all the feature flags that apply to the validator client.
@@ -234,9 +252,10 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableBackupWebhookFlag,
 	enableSkipSlotsCacheFlag,
 	enableSlasherFlag,
-	cacheFilteredBlockTreeFlag,
 	cacheProposerIndicesFlag,
-	protoArrayForkChoice,
+	enableInitSyncQueue,
+	enableBatchVerification,
+	enableTimelyTieBreak,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -244,8 +263,9 @@ var E2EBeaconChainFlags = []string{
 	"--enable-ssz-cache",
 	"--enable-attestation-cache",
 	"--cache-proposer-indices",
-	"--cache-filtered-block-tree",
 	"--enable-skip-slots-cache",
 	"--enable-eth1-data-vote-cache",
-	"--proto-array-forkchoice",
+	"--enable-initial-sync-queue",
+	"--enable-batch-bls-verification",
+	"--enable-timely-block-tie-break",
 }
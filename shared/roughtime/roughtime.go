@@ -2,6 +2,7 @@
 package roughtime
 
 import (
+	"sync/atomic"
 	"time"
 
 	rt "github.com/cloudflare/roughtime"
@@ -9,8 +10,9 @@ import (
 )
 
 // offset is the difference between the system time and the time returned by
-// the roughtime server
-var offset time.Duration
+// the roughtime server, in nanoseconds. It is accessed atomically, as it can be
+// overridden while the clock is in use.
+var offset int64
 
 var log = logrus.WithField("prefix", "roughtime")
 
@@ -22,11 +24,11 @@ func init() {
 	// Compute the average difference between the system's time and the
 	// Roughtime responses from the servers, rejecting responses whose radii
 	// are larger than 2 seconds.
-	var err error
-	offset, err = rt.AvgDeltaWithRadiusThresh(results, t0, 2*time.Second)
+	delta, err := rt.AvgDeltaWithRadiusThresh(results, t0, 2*time.Second)
 	if err != nil {
 		log.WithError(err).Error("Failed to calculate roughtime offset")
 	}
+	SetOffset(delta)
 }
 
 // Since returns the duration since t, based on the roughtime response
@@ -41,5 +43,11 @@ func Until(t time.Time) time.Duration {
 
 // Now returns the current local time given the roughtime offset.
 func Now() time.Time {
-	return time.Now().Add(offset)
+	return time.Now().Add(time.Duration(atomic.LoadInt64(&offset)))
+}
+
+// SetOffset overrides the difference between the system time and the time returned
+// by Now. This allows simulations to move the clock forward without waiting.
+func SetOffset(d time.Duration) {
+	atomic.StoreInt64(&offset, int64(d))
 }
@@ -0,0 +1,191 @@
+package stateutil
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/protolambda/zssz/merkle"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// StateFieldNames lists the fields of the beacon state in the order of its SSZ container
+// definition, the position of a field being its index among the leaves of the state root.
+var StateFieldNames = []string{
+	"genesis_time",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"historical_roots",
+	"eth1_data",
+	"eth1_data_votes",
+	"eth1_deposit_index",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"slashings",
+	"previous_epoch_attestations",
+	"current_epoch_attestations",
+	"justification_bits",
+	"previous_justified_checkpoint",
+	"current_justified_checkpoint",
+	"finalized_checkpoint",
+}
+
+const (
+	validatorsFieldIndex = 10
+	balancesFieldIndex   = 11
+	// stateFieldsDepth is the depth of the tree of the state fields, which are padded to 32 leaves.
+	stateFieldsDepth = 5
+)
+
+// zeroHashes[i] is the root of a tree of depth i with all its leaves set to zero.
+var zeroHashes = func() [][]byte {
+	hashes := make([][]byte, 64)
+	hashes[0] = make([]byte, 32)
+	for i := 1; i < len(hashes); i++ {
+		h := hashutil.Hash(append(append([]byte{}, hashes[i-1]...), hashes[i-1]...))
+		hashes[i] = h[:]
+	}
+	return hashes
+}()
+
+// MerkleProof of a leaf of the hash tree of a beacon state. The branch is ordered from the
+// sibling of the leaf up to the children of the state root, and the generalized index locates
+// the leaf as defined in the SSZ specification.
+type MerkleProof struct {
+	Leaf             [32]byte
+	GeneralizedIndex uint64
+	Branch           [][]byte
+}
+
+// StateFieldProof returns the proof of the hash tree root of a field of the beacon state, the
+// field being named as in the beacon state SSZ container (e.g. "finalized_checkpoint").
+func StateFieldProof(state *pb.BeaconState, field string) (*MerkleProof, error) {
+	index := -1
+	for i, name := range StateFieldNames {
+		if name == field {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("unknown beacon state field %q", field)
+	}
+	fieldRoots, err := ComputeFieldRoots(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state field roots")
+	}
+	branch := merkleBranch(fieldRoots, stateFieldsDepth, uint64(index))
+	return &MerkleProof{
+		Leaf:             bytesutil.ToBytes32(fieldRoots[index]),
+		GeneralizedIndex: 1<<stateFieldsDepth + uint64(index),
+		Branch:           branch,
+	}, nil
+}
+
+// ValidatorProof returns the proof of the hash tree root of the validator record at the given
+// index in the registry of the beacon state.
+func ValidatorProof(state *pb.BeaconState, index uint64) (*MerkleProof, error) {
+	if index >= uint64(len(state.Validators)) {
+		return nil, fmt.Errorf("validator index %d out of range, registry size %d", index, len(state.Validators))
+	}
+	roots := make([][]byte, len(state.Validators))
+	for i, v := range state.Validators {
+		root, err := nocachedHasher.validatorRoot(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute validator root")
+		}
+		roots[i] = root[:]
+	}
+	depth := uint64(merkle.GetDepth(params.BeaconConfig().ValidatorRegistryLimit))
+	proof, err := listElementProof(state, validatorsFieldIndex, roots, uint64(len(state.Validators)), depth, index)
+	if err != nil {
+		return nil, err
+	}
+	proof.Leaf = bytesutil.ToBytes32(roots[index])
+	return proof, nil
+}
+
+// BalanceProof returns the proof of the chunk holding the balance of the validator at the given
+// index. Balances are packed four to a chunk, the balance of the validator being the
+// little-endian uint64 at offset 8*(index%4) of the leaf.
+func BalanceProof(state *pb.BeaconState, index uint64) (*MerkleProof, error) {
+	if index >= uint64(len(state.Balances)) {
+		return nil, fmt.Errorf("balance index %d out of range, balances size %d", index, len(state.Balances))
+	}
+	serialized := make([][]byte, len(state.Balances))
+	for i, b := range state.Balances {
+		buf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, b)
+		serialized[i] = buf
+	}
+	chunks, err := pack(serialized)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not pack balances into chunks")
+	}
+	balLimit := (params.BeaconConfig().ValidatorRegistryLimit*8 + 31) / 32
+	depth := uint64(merkle.GetDepth(balLimit))
+	chunkIndex := index / 4
+	proof, err := listElementProof(state, balancesFieldIndex, chunks, uint64(len(state.Balances)), depth, chunkIndex)
+	if err != nil {
+		return nil, err
+	}
+	proof.Leaf = bytesutil.ToBytes32(chunks[chunkIndex])
+	return proof, nil
+}
+
+// listElementProof returns the proof of the chunk at the given index of a list field of the state,
+// made of the branch in the tree of the list chunks, the length mixed in the list root and the
+// branch of the field in the state tree.
+func listElementProof(state *pb.BeaconState, field int, chunks [][]byte, length uint64, depth uint64, index uint64) (*MerkleProof, error) {
+	fieldRoots, err := ComputeFieldRoots(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state field roots")
+	}
+	lengthChunk := make([]byte, 32)
+	binary.LittleEndian.PutUint64(lengthChunk, length)
+
+	branch := merkleBranch(chunks, depth, index)
+	branch = append(branch, lengthChunk)
+	branch = append(branch, merkleBranch(fieldRoots, stateFieldsDepth, uint64(field))...)
+	// The chunks of a list are the left child of the list root, its length being the right child.
+	listGeneralizedIndex := 2 * (1<<stateFieldsDepth + uint64(field))
+	return &MerkleProof{
+		GeneralizedIndex: listGeneralizedIndex<<depth + index,
+		Branch:           branch,
+	}, nil
+}
+
+// merkleBranch returns the siblings of the chunk at the given index in a tree of the given depth,
+// padding the chunks with zero chunks up to the 2**depth leaves of the tree.
+func merkleBranch(chunks [][]byte, depth uint64, index uint64) [][]byte {
+	layer := make([][]byte, len(chunks))
+	copy(layer, chunks)
+	branch := make([][]byte, depth)
+	for d := uint64(0); d < depth; d++ {
+		sibling := index ^ 1
+		if sibling < uint64(len(layer)) {
+			branch[d] = layer[sibling]
+		} else {
+			branch[d] = zeroHashes[d]
+		}
+		next := make([][]byte, (len(layer)+1)/2)
+		for i := range next {
+			left, right := layer[2*i], zeroHashes[d]
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			h := hashutil.Hash(append(append([]byte{}, left...), right...))
+			next[i] = h[:]
+		}
+		layer = next
+		index /= 2
+	}
+	return branch
+}
@@ -0,0 +1,77 @@
+package stateutil_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestStateFieldProof(t *testing.T) {
+	state := setupGenesisState(t, 16)
+	root, err := stateutil.HashTreeRootState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, field := range stateutil.StateFieldNames {
+		proof, err := stateutil.StateFieldProof(state, field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proof.GeneralizedIndex != uint64(32+i) {
+			t.Errorf("Wanted generalized index %d for %s, received %d", 32+i, field, proof.GeneralizedIndex)
+		}
+		if !trieutil.VerifyGeneralizedMerkleProof(root[:], proof.Leaf[:], proof.GeneralizedIndex, proof.Branch) {
+			t.Errorf("Could not verify proof of field %s", field)
+		}
+	}
+	if _, err := stateutil.StateFieldProof(state, "foo"); err == nil {
+		t.Error("Expected error for unknown field")
+	}
+}
+
+func TestValidatorProof(t *testing.T) {
+	state := setupGenesisState(t, 13)
+	root, err := stateutil.HashTreeRootState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, index := range []uint64{0, 5, 12} {
+		proof, err := stateutil.ValidatorProof(state, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !trieutil.VerifyGeneralizedMerkleProof(root[:], proof.Leaf[:], proof.GeneralizedIndex, proof.Branch) {
+			t.Errorf("Could not verify proof of validator %d", index)
+		}
+	}
+	if _, err := stateutil.ValidatorProof(state, 13); err == nil {
+		t.Error("Expected error for out of range validator index")
+	}
+}
+
+func TestBalanceProof(t *testing.T) {
+	state := setupGenesisState(t, 13)
+	state.Balances[6] = 123456789
+	root, err := stateutil.HashTreeRootState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, index := range []uint64{0, 6, 12} {
+		proof, err := stateutil.BalanceProof(state, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !trieutil.VerifyGeneralizedMerkleProof(root[:], proof.Leaf[:], proof.GeneralizedIndex, proof.Branch) {
+			t.Errorf("Could not verify proof of balance %d", index)
+		}
+		offset := 8 * (index % 4)
+		if b := binary.LittleEndian.Uint64(proof.Leaf[offset : offset+8]); b != state.Balances[index] {
+			t.Errorf("Wanted balance %d in proof leaf, received %d", state.Balances[index], b)
+		}
+	}
+	if _, err := stateutil.BalanceProof(state, 13); err == nil {
+		t.Error("Expected error for out of range balance index")
+	}
+}
@@ -0,0 +1,124 @@
+package trieutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// FinalizedBranch returns the roots of the complete subtrees holding the first count leaves of
+// the trie, from the highest to the lowest layer. There is one root for each bit set in count, and
+// together with count they are enough to compute the root of the trie once the following leaves
+// are inserted, which is how deposit snapshots keep the finalized deposits.
+func (m *SparseMerkleTrie) FinalizedBranch(count int) ([][]byte, error) {
+	if count < 0 || count > len(m.originalItems) {
+		return nil, fmt.Errorf("count %d out of range in trie of %d items", count, len(m.originalItems))
+	}
+	branch := make([][]byte, 0, bits.OnesCount64(uint64(count)))
+	for i := int(m.depth) - 1; i >= 0; i-- {
+		if count&(1<<uint(i)) == 0 {
+			continue
+		}
+		node := bytesutil.ToBytes32(m.branches[i][(count>>uint(i))-1])
+		branch = append(branch, node[:])
+	}
+	return branch, nil
+}
+
+// FinalizedBranchRoot computes the root of a trie of the given depth, as returned by HashTreeRoot,
+// from the finalized branch of its first count leaves, all the following leaves being empty.
+func FinalizedBranchRoot(branch [][]byte, count uint64, depth uint) ([32]byte, error) {
+	if err := validateFinalizedBranch(branch, count, depth); err != nil {
+		return [32]byte{}, err
+	}
+	node := bytesutil.ToBytes32(zeroHashes[0])
+	next := len(branch) - 1
+	for i := uint(0); i < depth; i++ {
+		if count&(1<<i) != 0 {
+			node = hashutil.Hash(concat(branch[next], node[:]))
+			next--
+		} else {
+			node = hashutil.Hash(concat(node[:], zeroHashes[i]))
+		}
+	}
+	enc := [32]byte{}
+	binary.LittleEndian.PutUint64(enc[:], count)
+	return hashutil.Hash(concat(node[:], enc[:])), nil
+}
+
+// GenerateTrieFromFinalizedBranch rebuilds a trie of the given depth holding count leaves from
+// their finalized branch. The leaves and nodes inside the complete subtrees are unknown and left
+// as zero hashes, so the trie only provides valid proofs for the leaves inserted afterwards.
+func GenerateTrieFromFinalizedBranch(branch [][]byte, count int, depth int) (*SparseMerkleTrie, error) {
+	if count < 0 || depth < 0 {
+		return nil, fmt.Errorf("invalid count %d or depth %d", count, depth)
+	}
+	if err := validateFinalizedBranch(branch, uint64(count), uint(depth)); err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return NewTrie(depth)
+	}
+
+	layers := make([][][]byte, depth+1)
+	for i := range layers {
+		layers[i] = make([][]byte, ((count-1)>>uint(i))+1)
+		for j := range layers[i] {
+			layers[i][j] = zeroHashes[i]
+		}
+	}
+	next := 0
+	for i := depth - 1; i >= 0; i-- {
+		if count&(1<<uint(i)) == 0 {
+			continue
+		}
+		node := bytesutil.ToBytes32(branch[next])
+		layers[i][(count>>uint(i))-1] = node[:]
+		next++
+	}
+	// Compute the nodes on the right edge of the trie that mix finalized leaves and empty leaves.
+	for i := 0; i < depth; i++ {
+		parent := len(layers[i+1]) - 1
+		if (parent+1)<<uint(i+1) <= count {
+			continue
+		}
+		right := zeroHashes[i]
+		if 2*parent+1 < len(layers[i]) {
+			right = layers[i][2*parent+1]
+		}
+		node := hashutil.Hash(concat(layers[i][2*parent], right))
+		layers[i+1][parent] = node[:]
+	}
+
+	items := make([][]byte, count)
+	copy(items, layers[0])
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: items,
+		depth:         uint(depth),
+	}, nil
+}
+
+func validateFinalizedBranch(branch [][]byte, count uint64, depth uint) error {
+	if depth < 64 && count >= 1<<depth {
+		return fmt.Errorf("count %d too large for a trie of depth %d", count, depth)
+	}
+	if len(branch) != bits.OnesCount64(count) {
+		return fmt.Errorf("finalized branch has %d nodes, wanted %d for count %d", len(branch), bits.OnesCount64(count), count)
+	}
+	for _, node := range branch {
+		if len(node) != 32 {
+			return fmt.Errorf("finalized branch node has length %d, wanted 32", len(node))
+		}
+	}
+	return nil
+}
+
+func concat(a []byte, b []byte) []byte {
+	c := make([]byte, 0, len(a)+len(b))
+	c = append(c, a...)
+	return append(c, b...)
+}
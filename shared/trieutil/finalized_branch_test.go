@@ -0,0 +1,121 @@
+package trieutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestFinalizedBranch_RebuildsTrie(t *testing.T) {
+	const inserted = 9
+	for count := 1; count <= 70; count++ {
+		items := make([][]byte, count+inserted)
+		for i := range items {
+			h := hashutil.Hash([]byte{byte(i)})
+			items[i] = h[:]
+		}
+		full, err := GenerateTrieFromItems(items[:count], 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		branch, err := full.FinalizedBranch(count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := FinalizedBranchRoot(branch, uint64(count), 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != full.HashTreeRoot() {
+			t.Fatalf("Wanted root %#x from the finalized branch of %d items, received %#x", full.HashTreeRoot(), count, root)
+		}
+
+		rebuilt, err := GenerateTrieFromFinalizedBranch(branch, count, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rebuilt.Root() != full.Root() {
+			t.Fatalf("Wanted root %#x for trie of %d items rebuilt from its finalized branch, received %#x", full.Root(), count, rebuilt.Root())
+		}
+		for i := count; i < len(items); i++ {
+			full.Insert(items[i], i)
+			rebuilt.Insert(items[i], i)
+			if rebuilt.Root() != full.Root() {
+				t.Fatalf("Wanted root %#x after inserting item %d in rebuilt trie of %d items, received %#x", full.Root(), i, count, rebuilt.Root())
+			}
+			wanted, err := full.MerkleProof(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proof, err := rebuilt.MerkleProof(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(wanted, proof) {
+				t.Fatalf("Wanted proof %#x of item %d in rebuilt trie of %d items, received %#x", wanted, i, count, proof)
+			}
+		}
+	}
+}
+
+func TestFinalizedBranch_Empty(t *testing.T) {
+	trie, err := GenerateTrieFromFinalizedBranch(nil, 0, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty, err := NewTrie(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trie.HashTreeRoot() != empty.HashTreeRoot() {
+		t.Errorf("Wanted root %#x of empty trie, received %#x", empty.HashTreeRoot(), trie.HashTreeRoot())
+	}
+	root, err := FinalizedBranchRoot(nil, 0, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != empty.HashTreeRoot() {
+		t.Errorf("Wanted root %#x of empty trie, received %#x", empty.HashTreeRoot(), root)
+	}
+}
+
+func TestFinalizedBranch_Invalid(t *testing.T) {
+	node := make([]byte, 32)
+	tests := []struct {
+		name   string
+		branch [][]byte
+		count  uint64
+	}{
+		{
+			name:   "missing node",
+			branch: [][]byte{node},
+			count:  3,
+		},
+		{
+			name:   "extra node",
+			branch: [][]byte{node, node},
+			count:  4,
+		},
+		{
+			name:   "short node",
+			branch: [][]byte{node[:31]},
+			count:  1,
+		},
+		{
+			name:   "count too large",
+			branch: [][]byte{node},
+			count:  1 << 32,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FinalizedBranchRoot(tt.branch, tt.count, 32); err == nil {
+				t.Error("Expected an invalid finalized branch error")
+			}
+			if _, err := GenerateTrieFromFinalizedBranch(tt.branch, int(tt.count), 32); err == nil {
+				t.Error("Expected an invalid finalized branch error")
+			}
+		})
+	}
+}
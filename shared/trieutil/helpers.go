@@ -1,8 +1,12 @@
 package trieutil
 
 import (
+	"bytes"
+	"fmt"
 	"math"
+	"math/bits"
 
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -153,3 +157,44 @@ func GeneralizedIndexChild(index int, rightSide bool) int {
 func GeneralizedIndexParent(index int) int {
 	return index / 2
 }
+
+// CalculateMerkleRoot returns the root of a Merkle tree from one of its leaves, the branch proving
+// the leaf and the generalized index of the leaf.
+//
+// Spec pseudocode definition:
+//   def calculate_merkle_root(leaf: Bytes32, proof: Sequence[Bytes32], index: GeneralizedIndex) -> Root:
+//    assert len(proof) == get_generalized_index_length(index)
+//    for i, h in enumerate(proof):
+//        if get_generalized_index_bit(index, i):
+//            leaf = hash(h + leaf)
+//        else:
+//            leaf = hash(leaf + h)
+//    return leaf
+func CalculateMerkleRoot(leaf []byte, proof [][]byte, index uint64) ([32]byte, error) {
+	if index == 0 || len(proof) != bits.Len64(index)-1 {
+		return [32]byte{}, fmt.Errorf("proof length %d does not match generalized index %d", len(proof), index)
+	}
+	node := bytesutil.ToBytes32(leaf)
+	for i, h := range proof {
+		if GeneralizedIndexBit(index, uint64(i)) {
+			node = hashutil.Hash(append(append([]byte{}, h...), node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], h...))
+		}
+	}
+	return node, nil
+}
+
+// VerifyGeneralizedMerkleProof verifies a Merkle branch proving a leaf at a generalized index
+// against the root of a tree, such as the SSZ hash tree root of a container.
+//
+// Spec pseudocode definition:
+//   def verify_merkle_proof(leaf: Bytes32, proof: Sequence[Bytes32], index: GeneralizedIndex, root: Root) -> bool:
+//    return calculate_merkle_root(leaf, proof, index) == root
+func VerifyGeneralizedMerkleProof(root []byte, leaf []byte, index uint64, proof [][]byte) bool {
+	calculated, err := CalculateMerkleRoot(leaf, proof, index)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(root, calculated[:])
+}
@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
@@ -186,3 +188,33 @@ func BenchmarkMerkleTree_Generate(b *testing.B) {
 		trieutil.MerkleTree(leaves)
 	}
 }
+
+func TestVerifyGeneralizedMerkleProof(t *testing.T) {
+	leaves := [][]byte{{'a'}, {'b'}, {'c'}, {'d'}}
+	for i, l := range leaves {
+		leaf := bytesutil.ToBytes32(l)
+		leaves[i] = leaf[:]
+	}
+	left := hashutil.Hash(append(append([]byte{}, leaves[0]...), leaves[1]...))
+	right := hashutil.Hash(append(append([]byte{}, leaves[2]...), leaves[3]...))
+	root := hashutil.Hash(append(left[:], right[:]...))
+
+	// The leaf c has generalized index 6 in a tree of 4 leaves.
+	proof := [][]byte{leaves[3], left[:]}
+	if !trieutil.VerifyGeneralizedMerkleProof(root[:], leaves[2], 6, proof) {
+		t.Error("Expected proof of leaf at generalized index 6 to be valid")
+	}
+	if trieutil.VerifyGeneralizedMerkleProof(root[:], leaves[2], 7, proof) {
+		t.Error("Expected proof of leaf at wrong generalized index to be invalid")
+	}
+	if trieutil.VerifyGeneralizedMerkleProof(root[:], leaves[3], 6, proof) {
+		t.Error("Expected proof of wrong leaf to be invalid")
+	}
+	if trieutil.VerifyGeneralizedMerkleProof(root[:], leaves[2], 12, proof) {
+		t.Error("Expected proof of wrong length to be invalid")
+	}
+	// The left subtree root has generalized index 2.
+	if !trieutil.VerifyGeneralizedMerkleProof(root[:], left[:], 2, [][]byte{right[:]}) {
+		t.Error("Expected proof of subtree root at generalized index 2 to be valid")
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbdb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// A basic tool to export the deposit snapshot of a beaconchain.db, or import one into the database
+// of a new node so that it processes the deposit logs from the snapshot instead of from the deposit
+// contract deployment.
+// ex:
+//   bazel run //tools/deposit-snapshot -- export /tmp/data/beaconchaindata /tmp/deposit_snapshot.pb
+//   bazel run //tools/deposit-snapshot -- import /tmp/data/beaconchaindata /tmp/deposit_snapshot.pb
+func main() {
+	if len(os.Args) < 4 || (os.Args[1] != "export" && os.Args[1] != "import") {
+		fmt.Println("Usage: ./main [export|import] /path/to/datadir /path/to/deposit_snapshot.pb")
+		os.Exit(1)
+	}
+
+	d, err := db.NewDB(os.Args[2])
+	if err != nil {
+		panic(err)
+	}
+	defer d.Close()
+	if os.Args[1] == "export" {
+		fmt.Printf("Reading db at %s and writing deposit snapshot to %s.\n", os.Args[2], os.Args[3])
+		exportSnapshot(d, os.Args[3])
+	} else {
+		fmt.Printf("Reading deposit snapshot at %s and writing it to db at %s.\n", os.Args[3], os.Args[2])
+		importSnapshot(d, os.Args[3])
+	}
+	fmt.Println("done")
+}
+
+func exportSnapshot(d db.Database, path string) {
+	snapshot, err := d.DepositSnapshot(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	if snapshot == nil {
+		panic("no deposit snapshot in db, the node needs to finalize an epoch with processed deposits first")
+	}
+	b, err := proto.Marshal(snapshot)
+	if err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		panic(err)
+	}
+	fmt.Printf("Exported snapshot of %d deposits up to eth1 block %d.\n", snapshot.DepositCount, snapshot.Eth1BlockHeight)
+}
+
+func importSnapshot(d db.Database, path string) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	snapshot := &pbdb.DepositSnapshot{}
+	if err := proto.Unmarshal(b, snapshot); err != nil {
+		panic(err)
+	}
+	root, err := trieutil.FinalizedBranchRoot(snapshot.Finalized, snapshot.DepositCount, uint(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		panic(err)
+	}
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		panic(fmt.Sprintf("deposit snapshot root %#x does not match its finalized branch root %#x", snapshot.DepositRoot, root))
+	}
+	genesisState, err := d.GenesisState(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	if genesisState == nil {
+		panic("no genesis state in db, the node needs the genesis state to resume from a deposit snapshot")
+	}
+	eth1Data, err := d.PowchainData(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	if eth1Data != nil {
+		fmt.Println("Warning: the db already holds eth1 data, the node resumes from it instead of the snapshot.")
+	}
+	if err := d.SaveDepositSnapshot(context.Background(), snapshot); err != nil {
+		panic(err)
+	}
+	fmt.Printf("Imported snapshot of %d deposits up to eth1 block %d.\n", snapshot.DepositCount, snapshot.Eth1BlockHeight)
+}
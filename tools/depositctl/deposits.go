@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/sirupsen/logrus"
+)
+
+// depositJSON is the deposit data of a validator as written to and read from deposit data files,
+// with hex encoded fields.
+type depositJSON struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+}
+
+// deposit is the deposit data of a validator with the root sent to the deposit contract.
+type deposit struct {
+	data *ethpb.Deposit_Data
+	root [32]byte
+}
+
+// generateDeposits builds the deposit data of the given validator keys, each key being its own
+// withdrawal key. Deposits are ordered by key name, so that their order, and the nonces of their
+// transactions, are deterministic.
+func generateDeposits(keys map[string]*keystore.Key, amount uint64) ([]*deposit, error) {
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	deposits := make([]*deposit, 0, len(keys))
+	for _, name := range names {
+		key := keys[name]
+		data, root, err := keystore.DepositInput(key, key, amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate deposit input data for %#x", key.PublicKey.Marshal())
+		}
+		deposits = append(deposits, &deposit{data: data, root: root})
+	}
+	return deposits, nil
+}
+
+// verifyDeposit checks the proof of possession of the deposit data and its root. The deposit
+// contract accepts deposits with an invalid signature, which do not create a validator.
+func verifyDeposit(d *deposit) error {
+	if err := blocks.VerifyDepositSignature(d.data); err != nil {
+		return errors.Wrapf(err, "invalid proof of possession for %#x", d.data.PublicKey)
+	}
+	root, err := ssz.HashTreeRoot(d.data)
+	if err != nil {
+		return errors.Wrap(err, "could not hash deposit data")
+	}
+	if root != d.root {
+		return errors.Errorf("deposit data root %#x of %#x does not match its data root %#x", d.root, d.data.PublicKey, root)
+	}
+	return nil
+}
+
+// writeDeposits writes the deposit data to a JSON file.
+func writeDeposits(path string, deposits []*deposit) error {
+	encoded := make([]*depositJSON, len(deposits))
+	for i, d := range deposits {
+		encoded[i] = &depositJSON{
+			PublicKey:             hexutil.Encode(d.data.PublicKey),
+			WithdrawalCredentials: hexutil.Encode(d.data.WithdrawalCredentials),
+			Amount:                d.data.Amount,
+			Signature:             hexutil.Encode(d.data.Signature),
+			DepositDataRoot:       hexutil.Encode(d.root[:]),
+		}
+	}
+	b, err := json.MarshalIndent(encoded, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode deposit data")
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// readDeposits reads the deposit data of a JSON file written by writeDeposits.
+func readDeposits(path string) ([]*deposit, error) {
+	// #nosec - Inclusion of file via variable is OK for this tool.
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var encoded []*depositJSON
+	if err := json.Unmarshal(b, &encoded); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit data")
+	}
+	deposits := make([]*deposit, len(encoded))
+	for i, e := range encoded {
+		d := &deposit{data: &ethpb.Deposit_Data{Amount: e.Amount}}
+		fields := []struct {
+			name string
+			hex  string
+			dst  *[]byte
+		}{
+			{"pubkey", e.PublicKey, &d.data.PublicKey},
+			{"withdrawal_credentials", e.WithdrawalCredentials, &d.data.WithdrawalCredentials},
+			{"signature", e.Signature, &d.data.Signature},
+		}
+		for _, f := range fields {
+			if *f.dst, err = hexutil.Decode(f.hex); err != nil {
+				return nil, errors.Wrapf(err, "invalid %s of deposit %d", f.name, i)
+			}
+		}
+		root, err := hexutil.Decode(e.DepositDataRoot)
+		if err != nil || len(root) != 32 {
+			return nil, errors.Errorf("invalid deposit_data_root of deposit %d", i)
+		}
+		copy(d.root[:], root)
+		deposits[i] = d
+	}
+	return deposits, nil
+}
+
+// sendDeposits verifies and sends the deposits to the deposit contract, waiting the given delay
+// between deposits. Deposits failing verification are not sent, as their funds would be locked in
+// the contract without creating a validator. It returns the number of deposits sent, and an error
+// if any deposit was not sent.
+func sendDeposits(
+	contract *contracts.DepositContract,
+	txOps *bind.TransactOpts,
+	deposits []*deposit,
+	delay time.Duration,
+) (int, error) {
+	sent := 0
+	for _, d := range deposits {
+		if err := verifyDeposit(d); err != nil {
+			log.WithError(err).Error("Skipping invalid deposit")
+			continue
+		}
+		txOps.Value = gweiToWei(d.data.Amount)
+		tx, err := contract.Deposit(txOps, d.data.PublicKey, d.data.WithdrawalCredentials, d.data.Signature, d.root)
+		if err != nil {
+			log.WithError(err).Errorf("Could not send deposit for validator with public key %#x", d.data.PublicKey)
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"txHash":    fmt.Sprintf("%#x", tx.Hash()),
+			"publicKey": fmt.Sprintf("%#x", d.data.PublicKey),
+			"amount":    d.data.Amount,
+		}).Info("Deposit sent")
+		sent++
+		time.Sleep(delay)
+	}
+	if sent < len(deposits) {
+		return sent, errors.Errorf("could not send %d of %d deposits", len(deposits)-sent, len(deposits))
+	}
+	return sent, nil
+}
+
+// rawDepositTransactions builds the unsigned deposit transactions of the verified deposits for
+// offline signing, with consecutive nonces starting at the given nonce.
+func rawDepositTransactions(
+	deposits []*deposit,
+	contract common.Address,
+	nonce uint64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) ([]*rawTransaction, error) {
+	txs := make([]*rawTransaction, 0, len(deposits))
+	for _, d := range deposits {
+		if err := verifyDeposit(d); err != nil {
+			log.WithError(err).Error("Skipping invalid deposit")
+			continue
+		}
+		data, err := depositTransactionData(d)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not pack deposit transaction")
+		}
+		value := gweiToWei(d.data.Amount)
+		description := fmt.Sprintf("deposit of %d gwei for %#x", d.data.Amount, d.data.PublicKey)
+		tx, err := newRawTransaction(description, nonce, &contract, value, gasLimit, gasPrice, data)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+		nonce++
+	}
+	return txs, nil
+}
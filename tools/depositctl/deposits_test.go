@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+	logrus.SetOutput(ioutil.Discard)
+}
+
+// testDeposits returns valid deposits of deterministic validator keys.
+func testDeposits(t *testing.T, n uint64) []*deposit {
+	privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(0, n)
+	if err != nil {
+		t.Fatalf("Unable to generate keys: %v", err)
+	}
+	depositData, depositDataRoots, err := interop.DepositDataFromKeys(privKeys, pubKeys)
+	if err != nil {
+		t.Fatalf("Unable to generate deposit data from keys: %v", err)
+	}
+	deposits := make([]*deposit, n)
+	for i, data := range depositData {
+		d := &deposit{data: data}
+		copy(d.root[:], depositDataRoots[i])
+		deposits[i] = d
+	}
+	return deposits
+}
+
+func TestGenerateDeposits_WriteAndRead(t *testing.T) {
+	key, err := keystore.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := map[string]*keystore.Key{hexutil.Encode(key.PublicKey.Marshal()): key}
+	amount := params.BeaconConfig().MaxEffectiveBalance
+	deposits, err := generateDeposits(keys, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 1 {
+		t.Fatalf("Wanted 1 deposit, received %d", len(deposits))
+	}
+	if err := verifyDeposit(deposits[0]); err != nil {
+		t.Errorf("Generated deposit is invalid: %v", err)
+	}
+	if deposits[0].data.Amount != amount {
+		t.Errorf("Wanted amount %d, received %d", amount, deposits[0].data.Amount)
+	}
+
+	dir, err := ioutil.TempDir("", "depositctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "deposits.json")
+	if err := writeDeposits(path, deposits); err != nil {
+		t.Fatal(err)
+	}
+	read, err := readDeposits(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != 1 || !proto.Equal(read[0].data, deposits[0].data) || read[0].root != deposits[0].root {
+		t.Errorf("Wanted deposits %v, read %v", deposits, read)
+	}
+}
+
+func TestVerifyDeposit(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(d *deposit)
+		wantErr bool
+	}{
+		{
+			name:    "valid deposit",
+			corrupt: func(d *deposit) {},
+		},
+		{
+			name: "invalid proof of possession",
+			corrupt: func(d *deposit) {
+				d.data.Amount--
+				d.root, _ = ssz.HashTreeRoot(d.data)
+			},
+			wantErr: true,
+		},
+		{
+			name: "mismatched deposit data root",
+			corrupt: func(d *deposit) {
+				d.root = [32]byte{'a'}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testDeposits(t, 1)[0]
+			tt.corrupt(d)
+			if err := verifyDeposit(d); (err != nil) != tt.wantErr {
+				t.Errorf("Wanted error %t, received %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGenerateDeposits_OrderedByKeyName(t *testing.T) {
+	keys := make(map[string]*keystore.Key)
+	for _, name := range []string{"c", "a", "b"} {
+		key, err := keystore.NewKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[name] = key
+	}
+	deposits, err := generateDeposits(keys, params.BeaconConfig().MaxEffectiveBalance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"a", "b", "c"} {
+		if !bytes.Equal(deposits[i].data.PublicKey, keys[name].PublicKey.Marshal()) {
+			t.Errorf("Wanted deposit %d of key %s", i, name)
+		}
+	}
+}
+
+func TestSendDeposits_ListsIndexedDeposits(t *testing.T) {
+	testutil.ResetCache()
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	testAcc.TxOpts.GasLimit = 1000000
+
+	deposits := testDeposits(t, 3)
+	// The last deposit has an invalid signature and must not be sent.
+	deposits[2].data.Signature = deposits[1].data.Signature
+	deposits[2].root, err = ssz.HashTreeRoot(deposits[2].data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent, err := sendDeposits(testAcc.Contract, testAcc.TxOpts, deposits, 0)
+	if sent != 2 {
+		t.Errorf("Wanted 2 deposits sent, received %d", sent)
+	}
+	if err == nil {
+		t.Error("Expected an error for the deposit which was not sent")
+	}
+	testAcc.Backend.Commit()
+
+	indexed, err := indexDeposits(context.Background(), testAcc.Backend, testAcc.ContractAddr, big.NewInt(0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indexed) != 2 {
+		t.Fatalf("Wanted 2 indexed deposits, received %d", len(indexed))
+	}
+	for i, d := range indexed {
+		if d.index != uint64(i) {
+			t.Errorf("Wanted index %d, received %d", i, d.index)
+		}
+		if !proto.Equal(d.data, deposits[i].data) {
+			t.Errorf("Wanted deposit data %v, received %v", deposits[i].data, d.data)
+		}
+		if !d.signatureValid {
+			t.Errorf("Wanted valid signature for deposit %d", i)
+		}
+	}
+	filtered := filterDeposits(indexed, deposits[1].data.PublicKey)
+	if len(filtered) != 1 || filtered[0].index != 1 {
+		t.Errorf("Wanted the deposit of index 1, received %v", filtered)
+	}
+}
+
+func TestRawDepositTransactions(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	ctx := context.Background()
+	nonce, err := testAcc.Backend.PendingNonceAt(ctx, testAcc.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposits := testDeposits(t, 2)
+	deposits[0].root = [32]byte{'a'}
+	gasPrice := gweiToWei(1)
+	txs, err := rawDepositTransactions(deposits, testAcc.ContractAddr, nonce, 500000, gasPrice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 1 {
+		t.Fatalf("Wanted 1 transaction for the valid deposit, received %d", len(txs))
+	}
+	raw, err := hexutil.Decode(txs[0].Raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := new(gethTypes.Transaction)
+	if err := rlp.DecodeBytes(raw, tx); err != nil {
+		t.Fatalf("Could not decode raw transaction: %v", err)
+	}
+	if tx.Nonce() != nonce || *tx.To() != testAcc.ContractAddr || tx.Gas() != 500000 || tx.GasPrice().Cmp(gasPrice) != 0 {
+		t.Errorf("Unexpected transaction fields %v", tx)
+	}
+	if tx.Value().Cmp(gweiToWei(deposits[1].data.Amount)) != 0 {
+		t.Errorf("Wanted value %d, received %d", gweiToWei(deposits[1].data.Amount), tx.Value())
+	}
+
+	// The transaction signed offline is a deposit of the valid deposit data.
+	signed, err := testAcc.TxOpts.Signer(gethTypes.HomesteadSigner{}, testAcc.Addr, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := testAcc.Backend.SendTransaction(ctx, signed); err != nil {
+		t.Fatalf("Could not send signed transaction: %v", err)
+	}
+	testAcc.Backend.Commit()
+	indexed, err := indexDeposits(ctx, testAcc.Backend, testAcc.ContractAddr, big.NewInt(0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indexed) != 1 || !proto.Equal(indexed[0].data, deposits[1].data) {
+		t.Errorf("Wanted the deposit %v, received %v", deposits[1].data, indexed)
+	}
+}
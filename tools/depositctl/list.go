@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// indexedDeposit is a deposit read from a deposit log of the deposit contract.
+type indexedDeposit struct {
+	index          uint64
+	data           *ethpb.Deposit_Data
+	blockNumber    uint64
+	txHash         common.Hash
+	signatureValid bool
+}
+
+// indexDeposits reads the deposits of the deposit logs emitted by the contract between the given
+// blocks, in order of merkle tree index. A nil block is the latest block, or the first block for
+// the start of the range.
+func indexDeposits(
+	ctx context.Context,
+	filterer ethereum.LogFilterer,
+	contract common.Address,
+	fromBlock *big.Int,
+	toBlock *big.Int,
+) ([]*indexedDeposit, error) {
+	logs, err := filterer.FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{contract},
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not filter deposit logs")
+	}
+	deposits := make([]*indexedDeposit, 0, len(logs))
+	for _, depositLog := range logs {
+		pubkey, withdrawalCredentials, amount, signature, index, err := contracts.UnpackDepositLogData(depositLog.Data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not unpack deposit log of transaction %#x", depositLog.TxHash)
+		}
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pubkey,
+			WithdrawalCredentials: withdrawalCredentials,
+			Amount:                bytesutil.FromBytes8(amount),
+			Signature:             signature,
+		}
+		deposits = append(deposits, &indexedDeposit{
+			index:          bytesutil.FromBytes8(index),
+			data:           data,
+			blockNumber:    depositLog.BlockNumber,
+			txHash:         depositLog.TxHash,
+			signatureValid: blocks.VerifyDepositSignature(data) == nil,
+		})
+	}
+	return deposits, nil
+}
+
+// filterDeposits returns the deposits of the validator with the given public key, or all the
+// deposits if no public key is given.
+func filterDeposits(deposits []*indexedDeposit, pubkey []byte) []*indexedDeposit {
+	if len(pubkey) == 0 {
+		return deposits
+	}
+	filtered := make([]*indexedDeposit, 0)
+	for _, d := range deposits {
+		if bytes.Equal(d.data.PublicKey, pubkey) {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
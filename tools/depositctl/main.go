@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+)
+
+var (
+	log = logrus.WithField("prefix", "main")
+)
+
+var (
+	httpPathFlag = cli.StringFlag{
+		Name:  "http-path",
+		Value: "http://localhost:8545/",
+		Usage: "HTTP-RPC server listening interface of the eth1 node",
+	}
+	ipcPathFlag = cli.StringFlag{
+		Name:  "ipc-path",
+		Usage: "Filename for IPC socket/pipe of the eth1 node, used instead of --http-path if set",
+	}
+	privKeyFlag = cli.StringFlag{
+		Name:  "private-key",
+		Usage: "Hex private key of the eth1 account sending transactions",
+	}
+	keystoreUTCPathFlag = cli.StringFlag{
+		Name:  "keystore-utc-path",
+		Usage: "Location of the keystore of the eth1 account sending transactions, if no private key is given",
+	}
+	passwordFileFlag = cli.StringFlag{
+		Name:  "password-file",
+		Value: "./password.txt",
+		Usage: "Password file to unlock the eth1 keystore and the prysm keystore",
+	}
+	depositContractFlag = cli.StringFlag{
+		Name:  "deposit-contract",
+		Usage: "Address of the deposit contract",
+	}
+	prysmKeystoreFlag = cli.StringFlag{
+		Name:  "prysm-keystore",
+		Usage: "Path to the prysm keystore of the validator keys",
+	}
+	amountFlag = cli.Uint64Flag{
+		Name:  "amount",
+		Value: params.BeaconConfig().MaxEffectiveBalance,
+		Usage: "Amount of each deposit (in gwei)",
+	}
+	dryRunFlag = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Output the unsigned transactions for offline signing instead of sending them",
+	}
+	outputFlag = cli.StringFlag{
+		Name:  "output",
+		Usage: "File to write the output to, instead of stdout",
+	}
+	nonceFlag = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "Nonce of the first unsigned transaction of a dry run",
+	}
+	gasPriceFlag = cli.Uint64Flag{
+		Name:  "gas-price",
+		Value: 10,
+		Usage: "Gas price of the transactions (in gwei)",
+	}
+)
+
+func main() {
+	customFormatter := new(prefixed.TextFormatter)
+	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
+	customFormatter.FullTimestamp = true
+	logrus.SetFormatter(customFormatter)
+
+	app := cli.NewApp()
+	app.Name = "depositctl"
+	app.Usage = "deploys the deposit contract, generates, sends and lists validator deposits"
+	app.Version = version.GetVersion()
+	app.Flags = []cli.Flag{
+		httpPathFlag,
+		ipcPathFlag,
+		privKeyFlag,
+		keystoreUTCPathFlag,
+		passwordFileFlag,
+		depositContractFlag,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "deploy",
+			Usage: "Deploys the deposit contract",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "drain-address",
+					Usage: "The drain address of the contract, which defaults to the sender of the transaction",
+				},
+				cli.Uint64Flag{
+					Name:  "gas-limit",
+					Value: 4000000,
+					Usage: "Gas limit of the deployment transaction",
+				},
+				gasPriceFlag,
+				dryRunFlag,
+				nonceFlag,
+				outputFlag,
+			},
+			Action: deploy,
+		},
+		{
+			Name:  "generate",
+			Usage: "Generates the deposit data of the validator keys of a prysm keystore",
+			Flags: []cli.Flag{
+				prysmKeystoreFlag,
+				amountFlag,
+				cli.StringFlag{
+					Name:  "output",
+					Value: "./deposits.json",
+					Usage: "File to write the deposit data to",
+				},
+			},
+			Action: generate,
+		},
+		{
+			Name:  "send",
+			Usage: "Verifies and sends deposits to the deposit contract",
+			Flags: []cli.Flag{
+				prysmKeystoreFlag,
+				cli.StringFlag{
+					Name:  "deposit-data",
+					Usage: "Deposit data file written by the generate command, used instead of --prysm-keystore",
+				},
+				amountFlag,
+				cli.DurationFlag{
+					Name:  "delay",
+					Value: 5 * time.Second,
+					Usage: "The time delay between sending the deposits to the contract",
+				},
+				cli.Uint64Flag{
+					Name:  "gas-limit",
+					Value: 500000,
+					Usage: "Gas limit of each deposit transaction",
+				},
+				gasPriceFlag,
+				dryRunFlag,
+				nonceFlag,
+				outputFlag,
+			},
+			Action: send,
+		},
+		{
+			Name:  "list",
+			Usage: "Lists the deposits indexed from the logs of the deposit contract",
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "from-block",
+					Usage: "First eth1 block of the logs",
+				},
+				cli.Int64Flag{
+					Name:  "to-block",
+					Value: -1,
+					Usage: "Last eth1 block of the logs, the latest block if negative",
+				},
+				cli.StringFlag{
+					Name:  "pubkey",
+					Usage: "Only list the deposits of the validator with this hex public key",
+				},
+			},
+			Action: list,
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func deploy(c *cli.Context) error {
+	gasPrice := gweiToWei(c.Uint64(gasPriceFlag.Name))
+	if c.Bool(dryRunFlag.Name) {
+		if c.String("drain-address") == "" {
+			return errors.New("a drain address is required for dry runs")
+		}
+		data, err := deployTransactionData(common.HexToAddress(c.String("drain-address")))
+		if err != nil {
+			return err
+		}
+		tx, err := newRawTransaction(
+			"deposit contract deployment",
+			c.Uint64(nonceFlag.Name),
+			nil, /* to */
+			big.NewInt(0),
+			c.Uint64("gas-limit"),
+			gasPrice,
+			data,
+		)
+		if err != nil {
+			return err
+		}
+		return writeRawTransactions(c.String(outputFlag.Name), []*rawTransaction{tx})
+	}
+
+	client, err := dialETH1(c.GlobalString(httpPathFlag.Name), c.GlobalString(ipcPathFlag.Name))
+	if err != nil {
+		return err
+	}
+	txOps, err := transactor(
+		c.GlobalString(privKeyFlag.Name),
+		c.GlobalString(keystoreUTCPathFlag.Name),
+		c.GlobalString(passwordFileFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	txOps.Value = big.NewInt(0)
+	txOps.GasLimit = c.Uint64("gas-limit")
+	txOps.GasPrice = gasPrice
+	drain := txOps.From
+	if c.String("drain-address") != "" {
+		drain = common.HexToAddress(c.String("drain-address"))
+	}
+
+	addr, tx, _, err := contracts.DeployDepositContract(txOps, client, drain)
+	if err != nil {
+		return errors.Wrap(err, "could not deploy deposit contract")
+	}
+	// Wait for contract to mine
+	for pending := true; pending; _, pending, err = client.TransactionByHash(context.Background(), tx.Hash()) {
+		if err != nil {
+			return err
+		}
+		time.Sleep(1 * time.Second)
+	}
+	log.WithFields(logrus.Fields{
+		"address": addr.Hex(),
+		"txHash":  fmt.Sprintf("%#x", tx.Hash()),
+	}).Info("New contract deployed")
+	return nil
+}
+
+func generate(c *cli.Context) error {
+	keys, err := validatorKeys(c)
+	if err != nil {
+		return err
+	}
+	deposits, err := generateDeposits(keys, c.Uint64(amountFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := writeDeposits(c.String("output"), deposits); err != nil {
+		return errors.Wrap(err, "could not write deposit data")
+	}
+	log.WithFields(logrus.Fields{
+		"deposits": len(deposits),
+		"output":   c.String("output"),
+	}).Info("Generated deposit data")
+	return nil
+}
+
+func send(c *cli.Context) error {
+	var deposits []*deposit
+	if c.String("deposit-data") != "" {
+		var err error
+		deposits, err = readDeposits(c.String("deposit-data"))
+		if err != nil {
+			return errors.Wrap(err, "could not read deposit data")
+		}
+	} else {
+		keys, err := validatorKeys(c)
+		if err != nil {
+			return err
+		}
+		deposits, err = generateDeposits(keys, c.Uint64(amountFlag.Name))
+		if err != nil {
+			return err
+		}
+	}
+	contractAddr, err := depositContractAddress(c)
+	if err != nil {
+		return err
+	}
+	gasPrice := gweiToWei(c.Uint64(gasPriceFlag.Name))
+
+	if c.Bool(dryRunFlag.Name) {
+		txs, err := rawDepositTransactions(deposits, contractAddr, c.Uint64(nonceFlag.Name), c.Uint64("gas-limit"), gasPrice)
+		if err != nil {
+			return err
+		}
+		return writeRawTransactions(c.String(outputFlag.Name), txs)
+	}
+
+	client, err := dialETH1(c.GlobalString(httpPathFlag.Name), c.GlobalString(ipcPathFlag.Name))
+	if err != nil {
+		return err
+	}
+	txOps, err := transactor(
+		c.GlobalString(privKeyFlag.Name),
+		c.GlobalString(keystoreUTCPathFlag.Name),
+		c.GlobalString(passwordFileFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	txOps.GasLimit = c.Uint64("gas-limit")
+	txOps.GasPrice = gasPrice
+	contract, err := contracts.NewDepositContract(contractAddr, client)
+	if err != nil {
+		return err
+	}
+	sent, err := sendDeposits(contract, txOps, deposits, c.Duration("delay"))
+	log.WithFields(logrus.Fields{
+		"sent":     sent,
+		"deposits": len(deposits),
+	}).Info("Finished sending deposits")
+	return err
+}
+
+func list(c *cli.Context) error {
+	contractAddr, err := depositContractAddress(c)
+	if err != nil {
+		return err
+	}
+	var pubkey []byte
+	if c.String("pubkey") != "" {
+		pubkey, err = hexutil.Decode(c.String("pubkey"))
+		if err != nil {
+			return errors.Wrap(err, "invalid public key")
+		}
+	}
+	var toBlock *big.Int
+	if c.Int64("to-block") >= 0 {
+		toBlock = big.NewInt(c.Int64("to-block"))
+	}
+	client, err := dialETH1(c.GlobalString(httpPathFlag.Name), c.GlobalString(ipcPathFlag.Name))
+	if err != nil {
+		return err
+	}
+	deposits, err := indexDeposits(context.Background(), client, contractAddr, big.NewInt(c.Int64("from-block")), toBlock)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tBLOCK\tTX HASH\tPUBLIC KEY\tAMOUNT (GWEI)\tSIGNATURE VALID")
+	for _, d := range filterDeposits(deposits, pubkey) {
+		fmt.Fprintf(w, "%d\t%d\t%#x\t%#x\t%d\t%t\n", d.index, d.blockNumber, d.txHash, d.data.PublicKey, d.data.Amount, d.signatureValid)
+	}
+	return w.Flush()
+}
+
+// validatorKeys loads the validator keys of the prysm keystore, unlocked by the password file.
+func validatorKeys(c *cli.Context) (map[string]*keystore.Key, error) {
+	path := c.String(prysmKeystoreFlag.Name)
+	if path == "" {
+		return nil, errors.New("a prysm keystore is required")
+	}
+	password, err := loadTextFromFile(c.GlobalString(passwordFileFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	store := keystore.NewKeystore(path)
+	keys, err := store.GetKeys(path, params.BeaconConfig().ValidatorPrivkeyFileName, password)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get keys from %s", path)
+	}
+	return keys, nil
+}
+
+func depositContractAddress(c *cli.Context) (common.Address, error) {
+	addr := c.GlobalString(depositContractFlag.Name)
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, errors.Errorf("invalid deposit contract address %q", addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+func gweiToWei(gwei uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(gwei), big.NewInt(1e9))
+}
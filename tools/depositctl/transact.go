@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+)
+
+// rawTransaction is an unsigned transaction output by dry runs for offline signing.
+type rawTransaction struct {
+	Description string `json:"description"`
+	To          string `json:"to,omitempty"`
+	Nonce       uint64 `json:"nonce"`
+	Value       string `json:"value"`
+	GasLimit    uint64 `json:"gas_limit"`
+	GasPrice    string `json:"gas_price"`
+	Data        string `json:"data"`
+	// RLP encoding of the unsigned transaction.
+	Raw string `json:"raw"`
+}
+
+// dialETH1 connects to the eth1 node over IPC if an IPC path is given, or HTTP otherwise.
+func dialETH1(httpPath string, ipcPath string) (*ethclient.Client, error) {
+	endpoint := httpPath
+	if ipcPath != "" {
+		endpoint = ipcPath
+	}
+	rpcClient, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial eth1 node at %s", endpoint)
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// transactor signs transactions with the given hex private key, or else with the key of the
+// given keystore file unlocked by the password file.
+func transactor(privKeyString string, keystoreUTCPath string, passwordFile string) (*bind.TransactOpts, error) {
+	if privKeyString != "" {
+		privKey, err := crypto.HexToECDSA(strings.TrimPrefix(privKeyString, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid private key")
+		}
+		return bind.NewKeyedTransactor(privKey), nil
+	}
+	if keystoreUTCPath == "" {
+		return nil, errors.New("a private key or keystore is required to send transactions")
+	}
+	password, err := loadTextFromFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec - Inclusion of file via variable is OK for this tool.
+	keyJSON, err := ioutil.ReadFile(keystoreUTCPath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	return bind.NewKeyedTransactor(key.PrivateKey), nil
+}
+
+// depositTransactionData packs the call of the deposit function of the deposit contract.
+func depositTransactionData(d *deposit) ([]byte, error) {
+	contractABI, err := abi.JSON(strings.NewReader(contracts.DepositContractABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse deposit contract abi")
+	}
+	return contractABI.Pack("deposit", d.data.PublicKey, d.data.WithdrawalCredentials, d.data.Signature, d.root)
+}
+
+// deployTransactionData packs the creation of the deposit contract with the given drain address.
+func deployTransactionData(drain common.Address) ([]byte, error) {
+	contractABI, err := abi.JSON(strings.NewReader(contracts.DepositContractABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse deposit contract abi")
+	}
+	args, err := contractABI.Pack("", drain)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.FromHex(contracts.DepositContractBin), args...), nil
+}
+
+// newRawTransaction builds the unsigned transaction of a dry run. Contracts are created when to is nil.
+func newRawTransaction(
+	description string,
+	nonce uint64,
+	to *common.Address,
+	value *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	data []byte,
+) (*rawTransaction, error) {
+	var tx *gethTypes.Transaction
+	if to == nil {
+		tx = gethTypes.NewContractCreation(nonce, value, gasLimit, gasPrice, data)
+	} else {
+		tx = gethTypes.NewTransaction(nonce, *to, value, gasLimit, gasPrice, data)
+	}
+	raw, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode transaction")
+	}
+	rawTx := &rawTransaction{
+		Description: description,
+		Nonce:       nonce,
+		Value:       value.String(),
+		GasLimit:    gasLimit,
+		GasPrice:    gasPrice.String(),
+		Data:        hexutil.Encode(data),
+		Raw:         hexutil.Encode(raw),
+	}
+	if to != nil {
+		rawTx.To = to.Hex()
+	}
+	return rawTx, nil
+}
+
+// writeRawTransactions writes the unsigned transactions of a dry run as JSON to the given file,
+// or to stdout if no file is given.
+func writeRawTransactions(path string, txs []*rawTransaction) error {
+	b, err := json.MarshalIndent(txs, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode transactions")
+	}
+	if path == "" {
+		_, err := os.Stdout.Write(append(b, '\n'))
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+func loadTextFromFile(filepath string) (string, error) {
+	// #nosec - Inclusion of file via variable is OK for this tool.
+	file, err := os.Open(filepath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanWords)
+	scanner.Scan()
+	return scanner.Text(), nil
+}
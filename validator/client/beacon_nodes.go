@@ -0,0 +1,313 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// beaconNode is a beacon node endpoint of the validator client, with its RPC clients and the
+// result of its last health check.
+type beaconNode struct {
+	endpoint         string
+	conn             *grpc.ClientConn
+	validatorClient  ethpb.BeaconNodeValidatorClient
+	beaconClient     ethpb.BeaconChainClient
+	aggregatorClient pb.AggregatorServiceClient
+	nodeClient       ethpb.NodeClient
+	healthy          bool
+	syncing          bool
+	headSlot         uint64
+	// consecutive RPC errors indicating the node is unavailable, since its last successful call.
+	rpcErrors int
+}
+
+func newBeaconNode(endpoint string, conn *grpc.ClientConn) *beaconNode {
+	return &beaconNode{
+		endpoint:         endpoint,
+		conn:             conn,
+		validatorClient:  ethpb.NewBeaconNodeValidatorClient(conn),
+		beaconClient:     ethpb.NewBeaconChainClient(conn),
+		aggregatorClient: pb.NewAggregatorServiceClient(conn),
+		nodeClient:       ethpb.NewNodeClient(conn),
+		healthy:          true,
+	}
+}
+
+// beaconNodes is the list of beacon nodes of the validator client in order of preference. The
+// RPC calls of the validator go to the active node, which is the first healthy node. Nodes are
+// health checked every slot, and the active node is switched as soon as it becomes unhealthy.
+type beaconNodes struct {
+	nodes          []*beaconNode
+	maxHeadSlotLag uint64
+	maxRPCErrors   int
+	broadcast      bool
+	lock           sync.RWMutex
+	active         int
+	// switched receives a value when the active node is switched.
+	switched chan struct{}
+}
+
+func newBeaconNodes(nodes []*beaconNode, maxHeadSlotLag uint64, maxRPCErrors int, broadcast bool) *beaconNodes {
+	return &beaconNodes{
+		nodes:          nodes,
+		maxHeadSlotLag: maxHeadSlotLag,
+		maxRPCErrors:   maxRPCErrors,
+		broadcast:      broadcast,
+		switched:       make(chan struct{}, 1),
+	}
+}
+
+// activeNode returns the beacon node receiving the RPC calls of the validator.
+func (b *beaconNodes) activeNode() *beaconNode {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.nodes[b.active]
+}
+
+// submissionNodes returns the beacon nodes receiving the submissions of the validator: the active
+// node first, followed by the other healthy nodes if submissions are broadcast.
+func (b *beaconNodes) submissionNodes() []*beaconNode {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	nodes := []*beaconNode{b.nodes[b.active]}
+	if !b.broadcast {
+		return nodes
+	}
+	for i, n := range b.nodes {
+		if i != b.active && n.healthy {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// call runs an RPC call of the validator on the active node. When the active node is unavailable,
+// the call is retried once on the next healthy node, which becomes the active node right away.
+func (b *beaconNodes) call(f func(n *beaconNode) (interface{}, error)) (interface{}, error) {
+	n := b.activeNode()
+	res, err := f(n)
+	b.recordResult(n, err)
+	if status.Code(err) != codes.Unavailable {
+		return res, err
+	}
+	next := b.failover(n)
+	if next == nil {
+		return res, err
+	}
+	res, err = f(next)
+	b.recordResult(next, err)
+	return res, err
+}
+
+// submit runs a submission of the validator on the submission nodes. It returns the response of
+// the first node accepting the submission, or the error of the active node if no node accepts it.
+// When the active node is unavailable and no node accepts the submission, it is retried once on
+// the next healthy node which was not submitted to.
+func (b *beaconNodes) submit(f func(n *beaconNode) (interface{}, error)) (interface{}, error) {
+	nodes := b.submissionNodes()
+	responses := make([]interface{}, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			responses[i], errs[i] = f(n)
+			b.recordResult(n, errs[i])
+		}(i, n)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err == nil {
+			return responses[i], nil
+		}
+	}
+	if status.Code(errs[0]) != codes.Unavailable {
+		return nil, errs[0]
+	}
+	next := b.failover(nodes[0])
+	if next == nil {
+		return nil, errs[0]
+	}
+	for _, n := range nodes {
+		if n == next {
+			return nil, errs[0]
+		}
+	}
+	res, err := f(next)
+	b.recordResult(next, err)
+	return res, err
+}
+
+// failover marks an unavailable node as unhealthy and switches the active node to the first healthy
+// node. It returns the new active node, or nil if no other node is healthy.
+func (b *beaconNodes) failover(n *beaconNode) *beaconNode {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if n.healthy {
+		log.WithField("endpoint", n.endpoint).Warn("Beacon node is unavailable, marking it as unhealthy")
+		n.healthy = false
+	}
+	b.selectActive()
+	if active := b.nodes[b.active]; active != n && active.healthy {
+		return active
+	}
+	return nil
+}
+
+// recordResult records the result of an RPC call to a beacon node. The node is marked as unhealthy
+// after too many consecutive errors showing it is unavailable, without waiting for the next
+// health check.
+func (b *beaconNodes) recordResult(n *beaconNode, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if err == nil {
+		n.rpcErrors = 0
+		return
+	}
+	if !isUnavailableError(err) {
+		return
+	}
+	n.rpcErrors++
+	if n.healthy && n.rpcErrors >= b.maxRPCErrors {
+		log.WithError(err).WithField("endpoint", n.endpoint).Warn("Too many RPC errors, marking beacon node as unhealthy")
+		n.healthy = false
+		b.selectActive()
+	}
+}
+
+// run health checks the beacon nodes every slot until the context is canceled.
+func (b *beaconNodes) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.checkHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth updates the health of the beacon nodes and switches the active node if needed. A
+// node is healthy if it is reachable, not syncing, and its head slot lags the highest head slot
+// of the nodes by at most the maximum head slot lag.
+func (b *beaconNodes) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+	defer cancel()
+
+	type nodeStatus struct {
+		reachable bool
+		syncing   bool
+		headSlot  uint64
+	}
+	statuses := make([]nodeStatus, len(b.nodes))
+	var wg sync.WaitGroup
+	for i, n := range b.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			syncStatus, err := n.nodeClient.GetSyncStatus(ctx, &ptypes.Empty{})
+			if err != nil {
+				log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get beacon node sync status")
+				return
+			}
+			head, err := n.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+			if err != nil {
+				log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get beacon node chain head")
+				return
+			}
+			statuses[i] = nodeStatus{reachable: true, syncing: syncStatus.Syncing, headSlot: head.HeadSlot}
+		}(i, n)
+	}
+	wg.Wait()
+
+	var highestHeadSlot uint64
+	for _, s := range statuses {
+		if s.reachable && !s.syncing && s.headSlot > highestHeadSlot {
+			highestHeadSlot = s.headSlot
+		}
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for i, n := range b.nodes {
+		s := statuses[i]
+		healthy := s.reachable && !s.syncing && s.headSlot+b.maxHeadSlotLag >= highestHeadSlot
+		if s.reachable {
+			n.rpcErrors = 0
+		}
+		if healthy != n.healthy {
+			log.WithFields(logrus.Fields{
+				"endpoint":        n.endpoint,
+				"healthy":         healthy,
+				"reachable":       s.reachable,
+				"syncing":         s.syncing,
+				"headSlot":        s.headSlot,
+				"highestHeadSlot": highestHeadSlot,
+			}).Info("Beacon node health changed")
+		}
+		n.healthy = healthy
+		n.syncing = s.syncing
+		n.headSlot = s.headSlot
+	}
+	b.selectActive()
+}
+
+// selectActive makes the first healthy node the active node. The active node is kept if no node
+// is healthy. The lock must be held by the caller.
+func (b *beaconNodes) selectActive() {
+	for i, n := range b.nodes {
+		if !n.healthy {
+			continue
+		}
+		if i != b.active {
+			log.WithFields(logrus.Fields{
+				"from": b.nodes[b.active].endpoint,
+				"to":   n.endpoint,
+			}).Warn("Switching beacon node")
+			b.active = i
+			select {
+			case b.switched <- struct{}{}:
+			default:
+			}
+		}
+		return
+	}
+}
+
+// close closes the connections to the beacon nodes.
+func (b *beaconNodes) close() error {
+	var firstErr error
+	for _, n := range b.nodes {
+		if n.conn == nil {
+			continue
+		}
+		if err := n.conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// isUnavailableError returns whether an RPC error shows that a beacon node cannot serve requests,
+// as opposed to an error of the request itself.
+func isUnavailableError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,377 @@
+package client
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+// failoverValidatorClient implements ethpb.BeaconNodeValidatorClient by sending the calls of the validator
+// to the active beacon node.
+type failoverValidatorClient struct {
+	nodes *beaconNodes
+}
+
+// DomainData --
+func (c *failoverValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest, opts ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.DomainData(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.DomainResponse), nil
+}
+
+// GetAttestationData --
+func (c *failoverValidatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, opts ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.GetAttestationData(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestationData), nil
+}
+
+// GetBlock --
+func (c *failoverValidatorClient) GetBlock(ctx context.Context, in *ethpb.BlockRequest, opts ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.GetBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.BeaconBlock), nil
+}
+
+// GetDuties --
+func (c *failoverValidatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.GetDuties(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.DutiesResponse), nil
+}
+
+// ProposeAttestation submits to the active beacon node, and to all the healthy beacon nodes if submissions
+// are broadcast.
+func (c *failoverValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	res, err := c.nodes.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeAttestation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestResponse), nil
+}
+
+// ProposeBlock submits to the active beacon node, and to all the healthy beacon nodes if submissions
+// are broadcast.
+func (c *failoverValidatorClient) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	res, err := c.nodes.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ProposeResponse), nil
+}
+
+// ProposeExit --
+func (c *failoverValidatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, opts ...grpc.CallOption) (*ptypes.Empty, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeExit(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ptypes.Empty), nil
+}
+
+// ValidatorIndex --
+func (c *failoverValidatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, opts ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ValidatorIndex(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorIndexResponse), nil
+}
+
+// ValidatorStatus --
+func (c *failoverValidatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ValidatorStatus(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorStatusResponse), nil
+}
+
+// WaitForActivation --
+func (c *failoverValidatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	n := c.nodes.activeNode()
+	res, err := n.validatorClient.WaitForActivation(ctx, in, opts...)
+	c.nodes.recordResult(n, err)
+	return res, err
+}
+
+// WaitForChainStart --
+func (c *failoverValidatorClient) WaitForChainStart(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	n := c.nodes.activeNode()
+	res, err := n.validatorClient.WaitForChainStart(ctx, in, opts...)
+	c.nodes.recordResult(n, err)
+	return res, err
+}
+
+// failoverBeaconChainClient implements ethpb.BeaconChainClient by sending the calls of the validator
+// to the active beacon node.
+type failoverBeaconChainClient struct {
+	nodes *beaconNodes
+}
+
+// AttestationPool --
+func (c *failoverBeaconChainClient) AttestationPool(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.AttestationPoolResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.AttestationPool(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestationPoolResponse), nil
+}
+
+// GetChainHead --
+func (c *failoverBeaconChainClient) GetChainHead(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetChainHead(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ChainHead), nil
+}
+
+// GetValidator --
+func (c *failoverBeaconChainClient) GetValidator(ctx context.Context, in *ethpb.GetValidatorRequest, opts ...grpc.CallOption) (*ethpb.Validator, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidator(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Validator), nil
+}
+
+// GetValidatorActiveSetChanges --
+func (c *failoverBeaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *ethpb.GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ethpb.ActiveSetChanges, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorActiveSetChanges(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ActiveSetChanges), nil
+}
+
+// GetValidatorParticipation --
+func (c *failoverBeaconChainClient) GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ethpb.ValidatorParticipationResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorParticipation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorParticipationResponse), nil
+}
+
+// GetValidatorPerformance --
+func (c *failoverBeaconChainClient) GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorPerformance(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorPerformanceResponse), nil
+}
+
+// GetValidatorQueue --
+func (c *failoverBeaconChainClient) GetValidatorQueue(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ValidatorQueue, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorQueue(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorQueue), nil
+}
+
+// ListAttestations --
+func (c *failoverBeaconChainClient) ListAttestations(ctx context.Context, in *ethpb.ListAttestationsRequest, opts ...grpc.CallOption) (*ethpb.ListAttestationsResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListAttestations(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ListAttestationsResponse), nil
+}
+
+// ListBeaconCommittees --
+func (c *failoverBeaconChainClient) ListBeaconCommittees(ctx context.Context, in *ethpb.ListCommitteesRequest, opts ...grpc.CallOption) (*ethpb.BeaconCommittees, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListBeaconCommittees(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.BeaconCommittees), nil
+}
+
+// ListBlocks --
+func (c *failoverBeaconChainClient) ListBlocks(ctx context.Context, in *ethpb.ListBlocksRequest, opts ...grpc.CallOption) (*ethpb.ListBlocksResponse, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListBlocks(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ListBlocksResponse), nil
+}
+
+// ListValidatorAssignments --
+func (c *failoverBeaconChainClient) ListValidatorAssignments(ctx context.Context, in *ethpb.ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ethpb.ValidatorAssignments, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListValidatorAssignments(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorAssignments), nil
+}
+
+// ListValidatorBalances --
+func (c *failoverBeaconChainClient) ListValidatorBalances(ctx context.Context, in *ethpb.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*ethpb.ValidatorBalances, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListValidatorBalances(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorBalances), nil
+}
+
+// ListValidators --
+func (c *failoverBeaconChainClient) ListValidators(ctx context.Context, in *ethpb.ListValidatorsRequest, opts ...grpc.CallOption) (*ethpb.Validators, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListValidators(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Validators), nil
+}
+
+// StreamAttestations --
+func (c *failoverBeaconChainClient) StreamAttestations(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamAttestationsClient, error) {
+	n := c.nodes.activeNode()
+	res, err := n.beaconClient.StreamAttestations(ctx, in, opts...)
+	c.nodes.recordResult(n, err)
+	return res, err
+}
+
+// StreamChainHead --
+func (c *failoverBeaconChainClient) StreamChainHead(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamChainHeadClient, error) {
+	n := c.nodes.activeNode()
+	res, err := n.beaconClient.StreamChainHead(ctx, in, opts...)
+	c.nodes.recordResult(n, err)
+	return res, err
+}
+
+// failoverNodeClient implements ethpb.NodeClient by sending the calls of the validator
+// to the active beacon node.
+type failoverNodeClient struct {
+	nodes *beaconNodes
+}
+
+// GetSyncStatus --
+func (c *failoverNodeClient) GetSyncStatus(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetSyncStatus(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SyncStatus), nil
+}
+
+// GetGenesis --
+func (c *failoverNodeClient) GetGenesis(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.Genesis, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetGenesis(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Genesis), nil
+}
+
+// GetVersion --
+func (c *failoverNodeClient) GetVersion(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.Version, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetVersion(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Version), nil
+}
+
+// ListImplementedServices --
+func (c *failoverNodeClient) ListImplementedServices(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ImplementedServices, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.ListImplementedServices(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ImplementedServices), nil
+}
+
+// ListPeers --
+func (c *failoverNodeClient) ListPeers(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.Peers, error) {
+	res, err := c.nodes.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.ListPeers(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Peers), nil
+}
+
+// failoverAggregatorClient implements pb.AggregatorServiceClient by sending the calls of the validator
+// to the active beacon node.
+type failoverAggregatorClient struct {
+	nodes *beaconNodes
+}
+
+// SubmitAggregateAndProof submits to the active beacon node, and to all the healthy beacon nodes if submissions
+// are broadcast.
+func (c *failoverAggregatorClient) SubmitAggregateAndProof(ctx context.Context, in *pb.AggregationRequest, opts ...grpc.CallOption) (*pb.AggregationResponse, error) {
+	res, err := c.nodes.submit(func(n *beaconNode) (interface{}, error) {
+		return n.aggregatorClient.SubmitAggregateAndProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*pb.AggregationResponse), nil
+}
@@ -0,0 +1,320 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nodeHealth is the response of a mock beacon node to health checks.
+type nodeHealth struct {
+	unreachable bool
+	syncing     bool
+	headSlot    uint64
+}
+
+func mockBeaconNode(ctrl *gomock.Controller, endpoint string, health nodeHealth) *beaconNode {
+	nodeClient := internal.NewMockNodeClient(ctrl)
+	beaconClient := internal.NewMockBeaconChainClient(ctrl)
+	if health.unreachable {
+		nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+	} else {
+		nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: health.syncing}, nil)
+		beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: health.headSlot}, nil)
+	}
+	return &beaconNode{
+		endpoint:     endpoint,
+		nodeClient:   nodeClient,
+		beaconClient: beaconClient,
+		healthy:      true,
+	}
+}
+
+func TestBeaconNodes_CheckHealth(t *testing.T) {
+	tests := []struct {
+		name         string
+		health       []nodeHealth
+		active       int
+		wantHealthy  []bool
+		wantActive   int
+		wantSwitched bool
+	}{
+		{
+			name:        "all nodes healthy",
+			health:      []nodeHealth{{headSlot: 10}, {headSlot: 10}},
+			wantHealthy: []bool{true, true},
+			wantActive:  0,
+		},
+		{
+			name:         "syncing node",
+			health:       []nodeHealth{{headSlot: 10, syncing: true}, {headSlot: 10}},
+			wantHealthy:  []bool{false, true},
+			wantActive:   1,
+			wantSwitched: true,
+		},
+		{
+			name:         "unreachable node",
+			health:       []nodeHealth{{unreachable: true}, {headSlot: 10}},
+			wantHealthy:  []bool{false, true},
+			wantActive:   1,
+			wantSwitched: true,
+		},
+		{
+			name:         "head slot lagging more than the maximum lag",
+			health:       []nodeHealth{{headSlot: 5}, {headSlot: 10}},
+			wantHealthy:  []bool{false, true},
+			wantActive:   1,
+			wantSwitched: true,
+		},
+		{
+			name:        "head slot lagging by the maximum lag",
+			health:      []nodeHealth{{headSlot: 6}, {headSlot: 10}},
+			wantHealthy: []bool{true, true},
+			wantActive:  0,
+		},
+		{
+			name:         "switches back to the preferred node once healthy",
+			health:       []nodeHealth{{headSlot: 10}, {headSlot: 10}},
+			active:       1,
+			wantHealthy:  []bool{true, true},
+			wantActive:   0,
+			wantSwitched: true,
+		},
+		{
+			name:        "keeps the active node if no node is healthy",
+			health:      []nodeHealth{{unreachable: true}, {syncing: true}},
+			active:      1,
+			wantHealthy: []bool{false, false},
+			wantActive:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			nodes := make([]*beaconNode, len(tt.health))
+			for i, h := range tt.health {
+				nodes[i] = mockBeaconNode(ctrl, fmt.Sprintf("node%d", i), h)
+			}
+			b := newBeaconNodes(nodes, 4 /* maxHeadSlotLag */, 3 /* maxRPCErrors */, false)
+			b.active = tt.active
+
+			b.checkHealth(context.Background())
+
+			for i, n := range nodes {
+				if n.healthy != tt.wantHealthy[i] {
+					t.Errorf("Wanted node %d healthy %t, received %t", i, tt.wantHealthy[i], n.healthy)
+				}
+			}
+			if b.active != tt.wantActive {
+				t.Errorf("Wanted active node %d, received %d", tt.wantActive, b.active)
+			}
+			select {
+			case <-b.switched:
+				if !tt.wantSwitched {
+					t.Error("Did not want a beacon node switch")
+				}
+			default:
+				if tt.wantSwitched {
+					t.Error("Wanted a beacon node switch")
+				}
+			}
+		})
+	}
+}
+
+func TestBeaconNodes_RecordResult_SwitchesAfterMaxRPCErrors(t *testing.T) {
+	nodes := []*beaconNode{{endpoint: "a", healthy: true}, {endpoint: "b", healthy: true}}
+	b := newBeaconNodes(nodes, 4 /* maxHeadSlotLag */, 3 /* maxRPCErrors */, false)
+
+	// Errors of the request itself do not make the node unhealthy.
+	for i := 0; i < 5; i++ {
+		b.recordResult(nodes[0], status.Error(codes.NotFound, "not assigned"))
+	}
+	// A successful call resets the count of consecutive errors.
+	b.recordResult(nodes[0], status.Error(codes.Unavailable, "down"))
+	b.recordResult(nodes[0], nil)
+	b.recordResult(nodes[0], status.Error(codes.Unavailable, "down"))
+	b.recordResult(nodes[0], status.Error(codes.Unavailable, "down"))
+	if b.activeNode() != nodes[0] {
+		t.Fatal("Switched beacon node before the maximum number of RPC errors")
+	}
+
+	b.recordResult(nodes[0], status.Error(codes.DeadlineExceeded, "timeout"))
+	if nodes[0].healthy {
+		t.Error("Wanted node to be unhealthy")
+	}
+	if b.activeNode() != nodes[1] {
+		t.Error("Wanted switch to the next healthy beacon node")
+	}
+}
+
+func TestBeaconNodes_Submit(t *testing.T) {
+	tests := []struct {
+		name      string
+		broadcast bool
+		errs      []error
+		wantCalls []int
+		wantErr   bool
+	}{
+		{
+			name:      "active node only",
+			errs:      []error{nil, nil, nil},
+			wantCalls: []int{1, 0, 0},
+		},
+		{
+			name:      "active node failure without broadcast",
+			errs:      []error{errors.New("bad"), nil, nil},
+			wantCalls: []int{1, 0, 0},
+			wantErr:   true,
+		},
+		{
+			name:      "unavailable active node without broadcast",
+			errs:      []error{status.Error(codes.Unavailable, "down"), nil, nil},
+			wantCalls: []int{1, 0, 1},
+		},
+		{
+			name:      "unavailable active node with broadcast",
+			broadcast: true,
+			errs:      []error{status.Error(codes.Unavailable, "down"), nil, errors.New("bad")},
+			wantCalls: []int{1, 0, 1},
+			wantErr:   true,
+		},
+		{
+			name:      "broadcast to all healthy nodes",
+			broadcast: true,
+			errs:      []error{nil, nil, nil},
+			wantCalls: []int{1, 0, 1},
+		},
+		{
+			name:      "broadcast accepted by another node",
+			broadcast: true,
+			errs:      []error{errors.New("bad"), nil, nil},
+			wantCalls: []int{1, 0, 1},
+		},
+		{
+			name:      "broadcast rejected by all nodes",
+			broadcast: true,
+			errs:      []error{errors.New("bad"), nil, errors.New("bad")},
+			wantCalls: []int{1, 0, 1},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			// The second node is unhealthy.
+			nodes := make([]*beaconNode, len(tt.errs))
+			for i, err := range tt.errs {
+				client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+				client.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).Return(
+					&ethpb.AttestResponse{Root: []byte{byte(i)}}, err,
+				).Times(tt.wantCalls[i])
+				nodes[i] = &beaconNode{endpoint: fmt.Sprintf("node%d", i), validatorClient: client, healthy: i != 1}
+			}
+			b := newBeaconNodes(nodes, 4 /* maxHeadSlotLag */, 3 /* maxRPCErrors */, tt.broadcast)
+			c := &failoverValidatorClient{nodes: b}
+
+			res, err := c.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Wanted error %t, received %v", tt.wantErr, err)
+			}
+			if err == nil && tt.errs[res.Root[0]] != nil {
+				t.Errorf("Received the response of node %d which failed", res.Root[0])
+			}
+		})
+	}
+}
+
+func TestBeaconNodes_ActiveNodeDiesMidSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	active := internal.NewMockBeaconNodeValidatorClient(ctrl)
+	backup := internal.NewMockBeaconNodeValidatorClient(ctrl)
+	nodes := []*beaconNode{
+		{endpoint: "active", validatorClient: active, healthy: true},
+		{endpoint: "backup", validatorClient: backup, healthy: true},
+	}
+	b := newBeaconNodes(nodes, 4 /* maxHeadSlotLag */, 3 /* maxRPCErrors */, false)
+	c := &failoverValidatorClient{nodes: b}
+
+	// The active node serves the start of the slot, then dies before the attestation data is requested.
+	gomock.InOrder(
+		active.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(&ethpb.DutiesResponse{}, nil),
+		active.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down")),
+	)
+	backup.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(&ethpb.AttestationData{Slot: 5}, nil)
+	backup.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).Return(&ethpb.AttestResponse{}, nil)
+
+	if _, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := c.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 5})
+	if err != nil {
+		t.Fatalf("Wanted the call to be retried on the backup node, received %v", err)
+	}
+	if data.Slot != 5 {
+		t.Errorf("Wanted attestation data of slot 5, received %d", data.Slot)
+	}
+	if nodes[0].healthy || b.activeNode() != nodes[1] {
+		t.Error("Wanted a switch to the backup node right after the active node died")
+	}
+	select {
+	case <-b.switched:
+	default:
+		t.Error("Wanted the switch to be notified")
+	}
+	// The rest of the slot goes to the backup node without retrying the dead node.
+	if _, err := c.ProposeAttestation(context.Background(), &ethpb.Attestation{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateDuties_RefetchesAfterNodeSwitch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	switched := make(chan struct{}, 1)
+	valDB := db.SetupDB(t, nil)
+	defer db.TeardownDB(t, valDB)
+	v := validator{
+		db:              valDB,
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		duties:          &ethpb.DutiesResponse{},
+		nodeSwitched:    switched,
+	}
+	resp := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot: 5,
+			},
+		},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+
+	// Not at the start of an epoch, assignments are only refetched after a switch.
+	switched <- struct{}{}
+	if err := v.UpdateDuties(context.Background(), 1); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	if v.duties != resp {
+		t.Error("Assignments were not refetched")
+	}
+	if err := v.UpdateDuties(context.Background(), 2); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+}
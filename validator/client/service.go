@@ -2,13 +2,12 @@ package client
 
 import (
 	"context"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
-	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
@@ -26,22 +25,30 @@ type ValidatorService struct {
 	cancel               context.CancelFunc
 	validator            Validator
 	graffiti             []byte
-	conn                 *grpc.ClientConn
-	endpoint             string
+	nodes                *beaconNodes
+	endpoints            []string
+	maxHeadSlotLag       uint64
+	maxRPCErrors         int
+	broadcast            bool
 	withCert             string
 	dataDir              string
 	keyManager           keymanager.KeyManager
+	keyReloadInterval    time.Duration
 	logValidatorBalances bool
 	maxCallRecvMsgSize   int
 }
 
 // Config for the validator service.
 type Config struct {
-	Endpoint                   string
+	Endpoints                  []string
+	MaxHeadSlotLag             uint64
+	MaxRPCErrors               int
+	BroadcastSubmissions       bool
 	DataDir                    string
 	CertFlag                   string
 	GraffitiFlag               string
 	KeyManager                 keymanager.KeyManager
+	KeyReloadInterval          time.Duration
 	LogValidatorBalances       bool
 	GrpcMaxCallRecvMsgSizeFlag int
 }
@@ -53,11 +60,15 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 	return &ValidatorService{
 		ctx:                  ctx,
 		cancel:               cancel,
-		endpoint:             cfg.Endpoint,
+		endpoints:            cfg.Endpoints,
+		maxHeadSlotLag:       cfg.MaxHeadSlotLag,
+		maxRPCErrors:         cfg.MaxRPCErrors,
+		broadcast:            cfg.BroadcastSubmissions,
 		withCert:             cfg.CertFlag,
 		dataDir:              cfg.DataDir,
 		graffiti:             []byte(cfg.GraffitiFlag),
 		keyManager:           cfg.KeyManager,
+		keyReloadInterval:    cfg.KeyReloadInterval,
 		logValidatorBalances: cfg.LogValidatorBalances,
 		maxCallRecvMsgSize:   cfg.GrpcMaxCallRecvMsgSizeFlag,
 	}, nil
@@ -102,12 +113,21 @@ func (v *ValidatorService) Start() {
 			grpc_prometheus.UnaryClientInterceptor,
 		)),
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, opts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+	nodes := make([]*beaconNode, 0, len(v.endpoints))
+	for _, endpoint := range v.endpoints {
+		conn, err := grpc.DialContext(v.ctx, endpoint, opts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", endpoint, err)
+			continue
+		}
+		nodes = append(nodes, newBeaconNode(endpoint, conn))
+	}
+	if len(nodes) == 0 {
+		log.Error("Could not dial any beacon node endpoint")
 		return
 	}
-	log.Info("Successfully started gRPC connection")
+	v.nodes = newBeaconNodes(nodes, v.maxHeadSlotLag, v.maxRPCErrors, v.broadcast)
+	log.WithField("endpoints", len(nodes)).Info("Successfully started gRPC connection")
 
 	pubkeys, err := v.keyManager.FetchValidatingKeys()
 	if err != nil {
@@ -121,28 +141,51 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	v.conn = conn
 	v.validator = &validator{
 		db:                   valDB,
-		validatorClient:      ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:         ethpb.NewBeaconChainClient(v.conn),
-		aggregatorClient:     pb.NewAggregatorServiceClient(v.conn),
-		node:                 ethpb.NewNodeClient(v.conn),
+		validatorClient:      &failoverValidatorClient{nodes: v.nodes},
+		beaconClient:         &failoverBeaconChainClient{nodes: v.nodes},
+		aggregatorClient:     &failoverAggregatorClient{nodes: v.nodes},
+		node:                 &failoverNodeClient{nodes: v.nodes},
 		keyManager:           v.keyManager,
 		graffiti:             v.graffiti,
 		logValidatorBalances: v.logValidatorBalances,
 		prevBalance:          make(map[[48]byte]uint64),
 		attLogs:              make(map[[32]byte]*attSubmitted),
+		nodeSwitched:         v.nodes.switched,
+	}
+	if len(nodes) > 1 {
+		go v.nodes.run(v.ctx)
+	}
+	if reloader, ok := v.keyManager.(keymanager.Reloader); ok && v.keyReloadInterval > 0 {
+		go reloadKeys(v.ctx, reloader, v.keyReloadInterval)
 	}
 	go run(v.ctx, v.validator)
 }
 
+// reloadKeys reloads the keys of the key manager at the given interval until the context is
+// canceled. The validator picks up the added and removed keys when updating its duties.
+func reloadKeys(ctx context.Context, reloader keymanager.Reloader, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := reloader.Reload(); err != nil {
+				log.WithError(err).Error("Could not reload validating keys")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if v.nodes != nil {
+		return v.nodes.close()
 	}
 	return nil
 }
@@ -151,7 +194,7 @@ func (v *ValidatorService) Stop() error {
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.nodes == nil {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -39,6 +39,12 @@ type validator struct {
 	logValidatorBalances bool
 	attLogs              map[[32]byte]*attSubmitted
 	attLogsLock          sync.Mutex
+	// nodeSwitched receives a value when the validator switches to another beacon node.
+	nodeSwitched <-chan struct{}
+	// validatingKeys maps the keys the validator performs duties for to the first epoch of their
+	// duties. Keys added at runtime start at the epoch following the loading of their slashing
+	// protection history.
+	validatingKeys map[[48]byte]uint64
 }
 
 // Done cleans up the validator.
@@ -221,8 +227,19 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
-// beginning of a new epoch.
+// beginning of a new epoch, or after switching to another beacon node.
 func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
+	if err := v.updateValidatingKeys(ctx, slot); err != nil {
+		// Keys which could not be added are retried at the next slot.
+		log.WithError(err).Error("Could not update validating keys")
+	}
+	select {
+	case <-v.nodeSwitched:
+		// Refetch the assignments from the new beacon node, whose view of the chain may differ.
+		log.Info("Beacon node switched, updating assignments")
+		v.duties = nil
+	default:
+	}
 	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
@@ -233,12 +250,19 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
 
-	validatingKeys, err := v.keyManager.FetchValidatingKeys()
+	keys, err := v.keyManager.FetchValidatingKeys()
 	if err != nil {
 		return err
 	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	validatingKeys := make([][48]byte, 0, len(keys))
+	for _, key := range keys {
+		if startEpoch, ok := v.validatingKeys[key]; ok && startEpoch <= epoch {
+			validatingKeys = append(validatingKeys, key)
+		}
+	}
 	req := &ethpb.DutiesRequest{
-		Epoch:      slot / params.BeaconConfig().SlotsPerEpoch,
+		Epoch:      epoch,
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
 	}
 
@@ -275,6 +299,73 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	return nil
 }
 
+// updateValidatingKeys updates the validating keys with the keys added to and removed from the key
+// manager. Removed keys stop performing their duties immediately. The slashing protection history
+// of added keys is loaded before they start performing duties at the next epoch.
+func (v *validator) updateValidatingKeys(ctx context.Context, slot uint64) error {
+	keys, err := v.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return err
+	}
+	if v.validatingKeys == nil {
+		// Keys may have been added since the database was opened with the keys at startup, so the
+		// history of every key is loaded.
+		if err := v.db.InitializeHistories(ctx, keys); err != nil {
+			return errors.Wrap(err, "could not load slashing protection history of validating keys")
+		}
+		v.validatingKeys = make(map[[48]byte]uint64, len(keys))
+		for _, key := range keys {
+			v.validatingKeys[key] = 0
+		}
+		return nil
+	}
+
+	current := make(map[[48]byte]bool, len(keys))
+	var added [][48]byte
+	for _, key := range keys {
+		current[key] = true
+		if _, ok := v.validatingKeys[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	removed := false
+	for key := range v.validatingKeys {
+		if !current[key] {
+			delete(v.validatingKeys, key)
+			removed = true
+			log.WithField("pubKey", fmt.Sprintf("%#x", key)).Info("Stopped validating for removed public key")
+		}
+	}
+	if removed && v.duties != nil {
+		duties := make([]*ethpb.DutiesResponse_Duty, 0, len(v.duties.Duties))
+		for _, duty := range v.duties.Duties {
+			if duty == nil {
+				continue
+			}
+			if _, ok := v.validatingKeys[bytesutil.ToBytes48(duty.PublicKey)]; ok {
+				duties = append(duties, duty)
+			}
+		}
+		v.duties = &ethpb.DutiesResponse{Duties: duties}
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+	if err := v.db.InitializeHistories(ctx, added); err != nil {
+		return errors.Wrap(err, "could not load slashing protection history of added keys")
+	}
+	startEpoch := helpers.SlotToEpoch(slot) + 1
+	for _, key := range added {
+		v.validatingKeys[key] = startEpoch
+		log.WithFields(logrus.Fields{
+			"pubKey":     fmt.Sprintf("%#x", key),
+			"startEpoch": startEpoch,
+		}).Info("Validating for added public key")
+	}
+	return nil
+}
+
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the at slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io/ioutil"
@@ -16,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
@@ -447,7 +449,10 @@ func TestUpdateDuties_DoesNothingWhenNotEpochStart_AlreadyExistingAssignments(t
 	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
 
 	slot := uint64(1)
+	valDB := db.SetupDB(t, nil)
+	defer db.TeardownDB(t, valDB)
 	v := validator{
+		db:              valDB,
 		keyManager:      testKeyManager,
 		validatorClient: client,
 		duties: &ethpb.DutiesResponse{
@@ -475,7 +480,10 @@ func TestUpdateDuties_ReturnsError(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
 
+	valDB := db.SetupDB(t, nil)
+	defer db.TeardownDB(t, valDB)
 	v := validator{
+		db:              valDB,
 		keyManager:      testKeyManager,
 		validatorClient: client,
 		duties: &ethpb.DutiesResponse{
@@ -502,6 +510,107 @@ func TestUpdateDuties_ReturnsError(t *testing.T) {
 	}
 }
 
+func TestUpdateDuties_AddedAndRemovedKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	removedKey := bls.RandKey()
+	km := keymanager.NewDirect([]*bls.SecretKey{removedKey})
+	removedPubKey := bytesutil.ToBytes48(removedKey.PublicKey().Marshal())
+	valDB := db.SetupDB(t, [][48]byte{removedPubKey})
+	defer db.TeardownDB(t, valDB)
+	v := validator{
+		db:              valDB,
+		keyManager:      km,
+		validatorClient: client,
+		validatingKeys:  map[[48]byte]uint64{removedPubKey: 0},
+		duties: &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{
+					AttesterSlot: 2,
+					PublicKey:    removedPubKey[:],
+				},
+			},
+		},
+	}
+	addedPubKey := km.AddKey(bls.RandKey())
+	if err := km.RemoveKey(removedPubKey); err != nil {
+		t.Fatal(err)
+	}
+
+	// The duties of the removed key are dropped without waiting for the next epoch.
+	if err := v.UpdateDuties(context.Background(), 1); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	if len(v.duties.Duties) != 0 {
+		t.Errorf("Wanted the assignments of the removed key to be dropped, received %v", v.duties.Duties)
+	}
+	// The added key starts at the next epoch, once its slashing protection history is loaded.
+	if startEpoch, ok := v.validatingKeys[addedPubKey]; !ok || startEpoch != 1 {
+		t.Errorf("Wanted added key to start at epoch 1, received %d", startEpoch)
+	}
+	history, err := valDB.ProposalHistory(context.Background(), addedPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history == nil {
+		t.Error("Wanted the proposal history of the added key to be initialized")
+	}
+
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Do(func(_ context.Context, req *ethpb.DutiesRequest) {
+		if len(req.PublicKeys) != 1 || !bytes.Equal(req.PublicKeys[0], addedPubKey[:]) {
+			t.Errorf("Wanted assignments of the added key only, requested %#x", req.PublicKeys)
+		}
+	}).Return(&ethpb.DutiesResponse{}, nil)
+	if err := v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+}
+
+func TestUpdateDuties_KeyAddedBeforeFirstUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	startKey := bls.RandKey()
+	km := keymanager.NewDirect([]*bls.SecretKey{startKey})
+	valDB := db.SetupDB(t, [][48]byte{bytesutil.ToBytes48(startKey.PublicKey().Marshal())})
+	defer db.TeardownDB(t, valDB)
+	v := validator{
+		db:              valDB,
+		keyManager:      km,
+		validatorClient: client,
+	}
+	// The key is added after the database was opened with the keys at startup.
+	addedPubKey := km.AddKey(bls.RandKey())
+
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DutiesResponse{}, nil)
+	if err := v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	if _, ok := v.validatingKeys[addedPubKey]; !ok {
+		t.Error("Wanted the added key to be validating")
+	}
+	proposals, err := valDB.ProposalHistory(context.Background(), addedPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestations, err := valDB.AttestationHistory(context.Background(), addedPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposals == nil || attestations == nil {
+		t.Error("Wanted the slashing protection history of the added key to be initialized")
+	}
+}
+
 func TestUpdateDuties_OK(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -520,7 +629,10 @@ func TestUpdateDuties_OK(t *testing.T) {
 			},
 		},
 	}
+	valDB := db.SetupDB(t, nil)
+	defer db.TeardownDB(t, valDB)
 	v := validator{
+		db:              valDB,
 		keyManager:      testKeyManager,
 		validatorClient: client,
 	}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -16,3 +17,41 @@ func TestClearDB(t *testing.T) {
 		t.Fatalf("DB was not cleared: %v", err)
 	}
 }
+
+func TestInitializeHistories(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+	ctx := context.Background()
+	pubkey := [48]byte{1}
+
+	if err := db.InitializeHistories(ctx, [][48]byte{pubkey}); err != nil {
+		t.Fatal(err)
+	}
+	proHistory, err := db.ProposalHistory(ctx, pubkey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	attHistory, err := db.AttestationHistory(ctx, pubkey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proHistory == nil || attHistory == nil {
+		t.Fatal("Expected histories to be initialized")
+	}
+
+	// An existing history is kept.
+	proHistory.LatestEpochWritten = 5
+	if err := db.SaveProposalHistory(ctx, pubkey[:], proHistory); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.InitializeHistories(ctx, [][48]byte{pubkey}); err != nil {
+		t.Fatal(err)
+	}
+	proHistory, err = db.ProposalHistory(ctx, pubkey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proHistory.LatestEpochWritten != 5 {
+		t.Errorf("Expected existing proposal history to be kept, latest epoch written %d", proHistory.LatestEpochWritten)
+	}
+}
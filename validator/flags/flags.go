@@ -11,12 +11,29 @@ var (
 		Name:  "no-custom-config",
 		Usage: "Run the beacon chain with the real parameters from phase 0.",
 	}
-	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	// BeaconRPCProviderFlag defines the beacon node RPC endpoints, comma separated in order of preference.
 	BeaconRPCProviderFlag = cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint. Several comma separated endpoints can be given in order of preference, the validator switching to the next healthy beacon node when the current one is unhealthy",
 		Value: "localhost:4000",
 	}
+	// BeaconMaxHeadSlotLagFlag defines how far behind the head of the other beacon nodes a beacon node can be while healthy.
+	BeaconMaxHeadSlotLagFlag = cli.Uint64Flag{
+		Name:  "beacon-max-head-slot-lag",
+		Usage: "Number of slots the head of a beacon node can lag the highest head of the beacon nodes before switching to another beacon node",
+		Value: 4,
+	}
+	// BeaconMaxRPCErrorsFlag defines the number of consecutive RPC errors after which a beacon node is unhealthy.
+	BeaconMaxRPCErrorsFlag = cli.IntFlag{
+		Name:  "beacon-max-rpc-errors",
+		Usage: "Number of consecutive unavailable RPC errors of a beacon node before switching to another beacon node",
+		Value: 3,
+	}
+	// BroadcastSubmissionsFlag defines whether blocks and attestations are submitted to all healthy beacon nodes.
+	BroadcastSubmissionsFlag = cli.BoolFlag{
+		Name:  "broadcast-submissions",
+		Usage: "Submit blocks, attestations and aggregates to all healthy beacon nodes instead of the current beacon node only",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = cli.StringFlag{
 		Name:  "tls-cert",
@@ -47,6 +64,32 @@ var (
 		Usage: "The options for the keymanger, either a JSON string or path to same",
 		Value: "",
 	}
+	// KeystoreReloadIntervalFlag defines how often the keystore directory is scanned for added and removed keys.
+	KeystoreReloadIntervalFlag = cli.IntFlag{
+		Name:  "keystore-reload-interval",
+		Usage: "Interval in seconds at which the keystore directory is scanned to validate with added keys and stop validating with removed keys, disabled if 0",
+	}
+	// KeyManagementHostFlag defines the host on which the key management API listens.
+	KeyManagementHostFlag = cli.StringFlag{
+		Name:  "keymanagement-host",
+		Usage: "Host on which the key management API listens",
+		Value: "127.0.0.1",
+	}
+	// KeyManagementPortFlag defines the gRPC port of the key management API.
+	KeyManagementPortFlag = cli.IntFlag{
+		Name:  "keymanagement-port",
+		Usage: "Port of the gRPC key management API to add and remove validator keys at runtime, disabled if 0",
+	}
+	// KeyManagementGatewayPortFlag defines the HTTP JSON port of the key management API.
+	KeyManagementGatewayPortFlag = cli.IntFlag{
+		Name:  "keymanagement-gateway-port",
+		Usage: "Port of the HTTP JSON gateway of the key management API, disabled if 0",
+	}
+	// KeyManagementTokenFileFlag defines the file holding the token authenticating key management API requests.
+	KeyManagementTokenFileFlag = cli.StringFlag{
+		Name:  "keymanagement-token-file",
+		Usage: "Path to a file holding the token which key management API requests must carry as bearer token in their authorization header",
+	}
 	// PasswordFlag defines the password value for storing and retrieving validator private keys from the keystore.
 	PasswordFlag = cli.StringFlag{
 		Name:  "password",
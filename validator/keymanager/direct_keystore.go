@@ -2,15 +2,21 @@ package keymanager
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"os/user"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
-	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -18,6 +24,21 @@ import (
 // Keystore is a key manager that loads keys from a standard keystore.
 type Keystore struct {
 	*Direct
+	path       string
+	passphrase string
+	// Key to the map is the name of the keystore file of the key.
+	files map[string]keystoreFile
+	// Number of keystore files holding a key, by public key.
+	fileCounts map[[48]byte]int
+	// Modification times of the keystore files that could not be decrypted, by file name.
+	failed map[string]time.Time
+	lock   sync.Mutex
+}
+
+// keystoreFile is a key loaded from a keystore file.
+type keystoreFile struct {
+	pubKey  [48]byte
+	modTime time.Time
 }
 
 type keystoreOpts struct {
@@ -72,23 +93,111 @@ func NewKeystore(input string) (KeyManager, string, error) {
 		}
 	}
 
-	keyMap, err := accounts.DecryptKeysFromKeystore(opts.Path, opts.Passphrase)
-	if err != nil {
+	km := &Keystore{
+		Direct:     NewDirect(nil),
+		path:       opts.Path,
+		passphrase: opts.Passphrase,
+		files:      make(map[string]keystoreFile),
+		fileCounts: make(map[[48]byte]int),
+		failed:     make(map[string]time.Time),
+	}
+	// Every keystore file must be decrypted at startup, as a wrong passphrase would otherwise
+	// start the validator without its keys.
+	if err := km.reload(true /* strict */); err != nil {
 		return nil, keystoreOptsHelp, err
 	}
+	return km, "", nil
+}
+
+// Reload loads the keys of the keystore files added to the keystore directory, or modified, since
+// the last reload, and removes the keys of the keystore files removed from it. Keys added to the
+// key manager by other means are kept. A keystore file that cannot be decrypted is skipped, keeping
+// the key previously loaded from it, until it is modified again.
+func (km *Keystore) Reload() error {
+	return km.reload(false /* strict */)
+}
 
-	km := &Unencrypted{
-		Direct: &Direct{
-			publicKeys: make(map[[48]byte]*bls.PublicKey),
-			secretKeys: make(map[[48]byte]*bls.SecretKey),
-		},
+// reload reloads the keystore files, failing on any keystore file that cannot be decrypted if
+// strict.
+func (km *Keystore) reload(strict bool) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+
+	files, err := ioutil.ReadDir(km.path)
+	if err != nil {
+		return errors.Wrap(err, "could not read keystore directory")
 	}
-	for _, key := range keyMap {
+	prefix := strings.TrimPrefix(params.BeaconConfig().ValidatorPrivkeyFileName, "/")
+	store := keystore.NewKeystore(km.path)
+	present := make(map[string]bool)
+	for _, f := range files {
+		name := f.Name()
+		if !f.Mode().IsRegular() || !strings.Contains(name, prefix) {
+			continue
+		}
+		present[name] = true
+		if loaded, ok := km.files[name]; ok && loaded.modTime.Equal(f.ModTime()) {
+			continue
+		}
+		if modTime, ok := km.failed[name]; ok && modTime.Equal(f.ModTime()) {
+			continue
+		}
+		key, err := store.GetKey(filepath.Join(km.path, name), km.passphrase)
+		if err != nil {
+			if strict {
+				return errors.Wrapf(err, "could not decrypt keystore file %s", name)
+			}
+			log.WithError(err).WithField("file", name).Warn("Could not decrypt keystore file, skipping it")
+			km.failed[name] = f.ModTime()
+			continue
+		}
+		delete(km.failed, name)
 		pubKey := bytesutil.ToBytes48(key.PublicKey.Marshal())
-		km.publicKeys[pubKey] = key.PublicKey
-		km.secretKeys[pubKey] = key.SecretKey
+		loaded, reloaded := km.files[name]
+		km.files[name] = keystoreFile{pubKey: pubKey, modTime: f.ModTime()}
+		if reloaded && loaded.pubKey == pubKey {
+			// The file was modified without changing its key.
+			continue
+		}
+		km.fileCounts[pubKey]++
+		if reloaded && km.releaseFile(loaded.pubKey) {
+			km.replaceKey(loaded.pubKey, key.SecretKey)
+		} else {
+			km.AddKey(key.SecretKey)
+		}
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Debug("Loaded key from keystore")
 	}
-	return km, "", nil
+	for name := range km.failed {
+		if !present[name] {
+			delete(km.failed, name)
+		}
+	}
+	for name, loaded := range km.files {
+		if present[name] {
+			continue
+		}
+		delete(km.files, name)
+		if !km.releaseFile(loaded.pubKey) {
+			// Another keystore file holds the key.
+			continue
+		}
+		if err := km.RemoveKey(loaded.pubKey); err != nil && err != ErrNoSuchKey {
+			return err
+		}
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(loaded.pubKey[:]))).Info("Removed key deleted from keystore")
+	}
+	return nil
+}
+
+// releaseFile decrements the number of keystore files holding a key, and returns true if no
+// keystore file holds it anymore.
+func (km *Keystore) releaseFile(pubKey [48]byte) bool {
+	km.fileCounts[pubKey]--
+	if km.fileCounts[pubKey] > 0 {
+		return false
+	}
+	delete(km.fileCounts, pubKey)
+	return true
 }
 
 func homeDir() string {
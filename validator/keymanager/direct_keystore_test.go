@@ -0,0 +1,207 @@
+package keymanager_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+func storeKey(t *testing.T, dir string, name string, passphrase string) [48]byte {
+	key, err := keystore.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyJSON, err := keystore.EncryptKey(key, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name), keyJSON, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return bytesutil.ToBytes48(key.PublicKey.Marshal())
+}
+
+func TestKeystoreReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	prefix := params.BeaconConfig().ValidatorPrivkeyFileName
+	passphrase := "secret"
+	first := storeKey(t, dir, prefix+"1", passphrase)
+
+	km, _, err := keymanager.NewKeystore(fmt.Sprintf(`{"path":%q,"passphrase":%q}`, dir, passphrase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, ok := km.(*keymanager.Keystore)
+	if !ok {
+		t.Fatalf("Incorrect key manager type %T", km)
+	}
+	// A key added by other means than the keystore is kept across reloads.
+	other := ks.AddKey(bls.RandKey())
+	second := storeKey(t, dir, prefix+"2", passphrase)
+
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, first, second, other)
+
+	if err := os.Remove(filepath.Join(dir, prefix+"1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, second, other)
+	if _, err := ks.Sign(first, [32]byte{}, 0); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Incorrect error: expected %v, received %v", keymanager.ErrNoSuchKey, err)
+	}
+}
+
+func TestNewKeystore_FailsOnCorruptFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	prefix := params.BeaconConfig().ValidatorPrivkeyFileName
+	storeKey(t, dir, prefix+"1", "secret")
+	storeKey(t, dir, prefix+"2", "wrong passphrase")
+
+	if _, _, err := keymanager.NewKeystore(fmt.Sprintf(`{"path":%q,"passphrase":%q}`, dir, "secret")); err == nil {
+		t.Error("Expected an error for a keystore file that cannot be decrypted")
+	}
+}
+
+func TestKeystoreReload_SkipsCorruptFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	prefix := params.BeaconConfig().ValidatorPrivkeyFileName
+	passphrase := "secret"
+	first := storeKey(t, dir, prefix+"1", passphrase)
+	second := storeKey(t, dir, prefix+"2", passphrase)
+
+	km, _, err := keymanager.NewKeystore(fmt.Sprintf(`{"path":%q,"passphrase":%q}`, dir, passphrase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, ok := km.(*keymanager.Keystore)
+	if !ok {
+		t.Fatalf("Incorrect key manager type %T", km)
+	}
+	assertKeys(t, ks, first, second)
+
+	// A corrupt file added after startup is skipped, and the key of a file removed next to it
+	// is removed.
+	if err := ioutil.WriteFile(filepath.Join(dir, prefix+"0"), []byte("corrupt"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, prefix+"1")); err != nil {
+		t.Fatal(err)
+	}
+	storeKey(t, dir, prefix+"3", "wrong passphrase")
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, second)
+
+	// A file is loaded once it can be decrypted.
+	third := storeKey(t, dir, prefix+"3", passphrase)
+	modTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filepath.Join(dir, prefix+"3"), modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, second, third)
+}
+
+func TestKeystoreReload_KeyHeldByTwoFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	prefix := params.BeaconConfig().ValidatorPrivkeyFileName
+	passphrase := "secret"
+	key := storeKey(t, dir, prefix+"1", passphrase)
+	keyJSON, err := ioutil.ReadFile(filepath.Join(dir, prefix+"1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, prefix+"2"), keyJSON, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	km, _, err := keymanager.NewKeystore(fmt.Sprintf(`{"path":%q,"passphrase":%q}`, dir, passphrase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, ok := km.(*keymanager.Keystore)
+	if !ok {
+		t.Fatalf("Incorrect key manager type %T", km)
+	}
+
+	// A file modified without changing its key keeps the key.
+	modTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filepath.Join(dir, prefix+"1"), modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, key)
+
+	// The key is kept while a file holds it.
+	if err := os.Remove(filepath.Join(dir, prefix+"1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, key)
+
+	// A file replaced with another key swaps the keys.
+	replaced := storeKey(t, dir, prefix+"2", passphrase)
+	if err := os.Chtimes(filepath.Join(dir, prefix+"2"), modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assertKeys(t, ks, replaced)
+}
+
+func assertKeys(t *testing.T, km keymanager.KeyManager, want ...[48]byte) {
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(keys) != len(want) {
+		t.Fatalf("Incorrect number of keys returned; expected %d, received %d", len(want), len(keys))
+	}
+	fetched := make(map[[48]byte]bool)
+	for _, key := range keys {
+		fetched[key] = true
+	}
+	for _, key := range want {
+		if !fetched[key] {
+			t.Errorf("Key %#x not returned", key)
+		}
+	}
+}
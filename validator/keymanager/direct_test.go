@@ -76,3 +76,29 @@ func TestSign(t *testing.T) {
 		t.Fatal("Failed to verify generated signature")
 	}
 }
+
+func TestAddAndRemoveKey(t *testing.T) {
+	direct := keymanager.NewDirect(nil)
+	sk := bls.RandKey()
+	pubKey := direct.AddKey(sk)
+	if pubKey != bytesutil.ToBytes48(sk.PublicKey().Marshal()) {
+		t.Fatal("Incorrect public key returned")
+	}
+	keys, err := direct.FetchValidatingKeys()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != pubKey {
+		t.Errorf("Incorrect keys returned; expected %#x, received %#x", pubKey, keys)
+	}
+
+	if err := direct.RemoveKey(pubKey); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := direct.Sign(pubKey, [32]byte{}, 0); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Incorrect error: expected %v, received %v", keymanager.ErrNoSuchKey, err)
+	}
+	if err := direct.RemoveKey(pubKey); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Incorrect error: expected %v, received %v", keymanager.ErrNoSuchKey, err)
+	}
+}
@@ -16,3 +16,18 @@ type KeyManager interface {
 	// Sign signs a message for the validator to broadcast.
 	Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error)
 }
+
+// DynamicKeyManager is a key manager whose keys can be added and removed while the validator runs.
+type DynamicKeyManager interface {
+	KeyManager
+	// AddKey adds a secret key to validate with, and returns its public key.
+	AddKey(sk *bls.SecretKey) [48]byte
+	// RemoveKey removes a key, returning ErrNoSuchKey if the key manager is unaware of it.
+	RemoveKey(pubKey [48]byte) error
+}
+
+// Reloader is a key manager loading its keys from a source which can change while the validator runs.
+type Reloader interface {
+	// Reload adds the keys added to the source of the key manager and removes the keys removed from it.
+	Reload() error
+}
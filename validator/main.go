@@ -36,12 +36,20 @@ func startNode(ctx *cli.Context) error {
 var appFlags = []cli.Flag{
 	flags.NoCustomConfigFlag,
 	flags.BeaconRPCProviderFlag,
+	flags.BeaconMaxHeadSlotLagFlag,
+	flags.BeaconMaxRPCErrorsFlag,
+	flags.BroadcastSubmissionsFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.KeystorePathFlag,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
 	flags.UnencryptedKeysFlag,
+	flags.KeystoreReloadIntervalFlag,
+	flags.KeyManagementHostFlag,
+	flags.KeyManagementPortFlag,
+	flags.KeyManagementGatewayPortFlag,
+	flags.KeyManagementTokenFileFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
 	flags.GrpcMaxCallRecvMsgSizeFlag,
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -24,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -114,6 +116,10 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		return nil, err
 	}
 
+	if err := ValidatorClient.registerKeyManagementService(ctx, keyManager); err != nil {
+		return nil, err
+	}
+
 	return ValidatorClient, nil
 }
 
@@ -172,16 +178,25 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 }
 
 func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager keymanager.KeyManager) error {
-	endpoint := ctx.GlobalString(flags.BeaconRPCProviderFlag.Name)
+	var endpoints []string
+	for _, endpoint := range strings.Split(ctx.GlobalString(flags.BeaconRPCProviderFlag.Name), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
 	dataDir := ctx.GlobalString(cmd.DataDirFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	graffiti := ctx.GlobalString(flags.GraffitiFlag.Name)
 	maxCallRecvMsgSize := ctx.GlobalInt(flags.GrpcMaxCallRecvMsgSizeFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:                   endpoint,
+		Endpoints:                  endpoints,
+		MaxHeadSlotLag:             ctx.GlobalUint64(flags.BeaconMaxHeadSlotLagFlag.Name),
+		MaxRPCErrors:               ctx.GlobalInt(flags.BeaconMaxRPCErrorsFlag.Name),
+		BroadcastSubmissions:       ctx.GlobalBool(flags.BroadcastSubmissionsFlag.Name),
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
+		KeyReloadInterval:          time.Duration(ctx.GlobalInt(flags.KeystoreReloadIntervalFlag.Name)) * time.Second,
 		LogValidatorBalances:       logValidatorBalances,
 		CertFlag:                   cert,
 		GraffitiFlag:               graffiti,
@@ -193,6 +208,25 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 	return s.services.RegisterService(v)
 }
 
+// registerKeyManagementService registers the key management API if its port is set.
+func (s *ValidatorClient) registerKeyManagementService(ctx *cli.Context, keyManager keymanager.KeyManager) error {
+	port := ctx.GlobalInt(flags.KeyManagementPortFlag.Name)
+	if port == 0 {
+		return nil
+	}
+	service, err := rpc.NewService(context.Background(), &rpc.Config{
+		Host:        ctx.GlobalString(flags.KeyManagementHostFlag.Name),
+		Port:        port,
+		GatewayPort: ctx.GlobalInt(flags.KeyManagementGatewayPortFlag.Name),
+		TokenFile:   ctx.GlobalString(flags.KeyManagementTokenFileFlag.Name),
+		KeyManager:  keyManager,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize key management service")
+	}
+	return s.services.RegisterService(service)
+}
+
 // selectKeyManager selects the key manager depending on the options provided by the user.
 func selectKeyManager(ctx *cli.Context) (keymanager.KeyManager, error) {
 	manager := strings.ToLower(ctx.String(flags.KeyManager.Name))
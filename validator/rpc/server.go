@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Keys service, providing
+// RPC endpoints to add and remove the validating keys of a running validator client.
+type Server struct {
+	KeyManager keymanager.KeyManager
+}
+
+// ListKeys lists the public keys the validator client validates with.
+func (s *Server) ListKeys(ctx context.Context, _ *ptypes.Empty) (*pb.ListKeysResponse, error) {
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch validating keys: %v", err)
+	}
+	return &pb.ListKeysResponse{PublicKeys: bytesutil.FromBytes48Array(keys)}, nil
+}
+
+// AddKey adds a secret key to the key manager. The validator client performs the
+// duties of the key from the next epoch, once its slashing protection history is loaded.
+func (s *Server) AddKey(ctx context.Context, req *pb.AddKeyRequest) (*pb.AddKeyResponse, error) {
+	km, err := s.dynamicKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	sk, err := bls.SecretKeyFromBytes(req.SecretKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid secret key: %v", err)
+	}
+	pubKey := km.AddKey(sk)
+	log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Added validating key")
+	return &pb.AddKeyResponse{PublicKey: pubKey[:]}, nil
+}
+
+// RemoveKey removes a key from the key manager. The validator client stops
+// performing the duties of the key immediately.
+func (s *Server) RemoveKey(ctx context.Context, req *pb.RemoveKeyRequest) (*ptypes.Empty, error) {
+	km, err := s.dynamicKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.PublicKey) != 48 {
+		return nil, status.Errorf(codes.InvalidArgument, "public key must be 48 bytes, received %d", len(req.PublicKey))
+	}
+	if err := km.RemoveKey(bytesutil.ToBytes48(req.PublicKey)); err != nil {
+		if err == keymanager.ErrNoSuchKey {
+			return nil, status.Errorf(codes.NotFound, "no key %#x", req.PublicKey)
+		}
+		return nil, status.Errorf(codes.Internal, "could not remove key: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(req.PublicKey))).Info("Removed validating key")
+	return &ptypes.Empty{}, nil
+}
+
+func (s *Server) dynamicKeyManager() (keymanager.DynamicKeyManager, error) {
+	km, ok := s.KeyManager.(keymanager.DynamicKeyManager)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the key manager does not support adding and removing keys")
+	}
+	return km, nil
+}
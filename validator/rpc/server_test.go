@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// staticKeyManager is a key manager whose keys cannot be added or removed.
+type staticKeyManager struct {
+	keymanager.KeyManager
+}
+
+func TestServer_AddListRemoveKey(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{KeyManager: keymanager.NewDirect(nil)}
+	sk := bls.RandKey()
+
+	added, err := s.AddKey(ctx, &pb.AddKeyRequest{SecretKey: sk.Marshal()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(added.PublicKey, sk.PublicKey().Marshal()) {
+		t.Errorf("Wanted public key %#x, received %#x", sk.PublicKey().Marshal(), added.PublicKey)
+	}
+	listed, err := s.ListKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.PublicKeys) != 1 || !bytes.Equal(listed.PublicKeys[0], added.PublicKey) {
+		t.Errorf("Wanted the added key only, received %#x", listed.PublicKeys)
+	}
+
+	if _, err := s.RemoveKey(ctx, &pb.RemoveKeyRequest{PublicKey: added.PublicKey}); err != nil {
+		t.Fatal(err)
+	}
+	listed, err = s.ListKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.PublicKeys) != 0 {
+		t.Errorf("Wanted no key, received %#x", listed.PublicKeys)
+	}
+	if _, err := s.RemoveKey(ctx, &pb.RemoveKeyRequest{PublicKey: added.PublicKey}); status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted NotFound removing an unknown key, received %v", err)
+	}
+}
+
+func TestServer_InvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{KeyManager: keymanager.NewDirect(nil)}
+	if _, err := s.AddKey(ctx, &pb.AddKeyRequest{SecretKey: []byte("bad")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted InvalidArgument adding an invalid key, received %v", err)
+	}
+	if _, err := s.RemoveKey(ctx, &pb.RemoveKeyRequest{PublicKey: []byte("bad")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Wanted InvalidArgument removing an invalid key, received %v", err)
+	}
+
+	s = &Server{KeyManager: &staticKeyManager{KeyManager: keymanager.NewDirect(nil)}}
+	if _, err := s.AddKey(ctx, &pb.AddKeyRequest{SecretKey: bls.RandKey().Marshal()}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Wanted Unimplemented adding a key to a static key manager, received %v", err)
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	s := &Service{token: "secret"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.beacon.rpc.v1.Keys/ListKeys"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want codes.Code
+	}{
+		{
+			name: "no metadata",
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "no authorization",
+			md:   metadata.Pairs("other", "secret"),
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "wrong token",
+			md:   metadata.Pairs("authorization", "Bearer wrong"),
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "bearer token",
+			md:   metadata.Pairs("authorization", "Bearer secret"),
+			want: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			_, err := s.authenticate(ctx, nil, info, handler)
+			if status.Code(err) != tt.want {
+				t.Errorf("Wanted code %v, received %v", tt.want, err)
+			}
+		})
+	}
+}
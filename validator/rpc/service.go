@@ -0,0 +1,191 @@
+// Package rpc defines the key management API of the validator client, serving
+// authenticated gRPC and optionally HTTP JSON requests to add and remove validating
+// keys without restarting the validator client.
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	pbgw "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "rpc")
+
+var _ = shared.Service(&Service{})
+
+// Service serves the key management API of the validator client. Requests must
+// carry the API token as a bearer token in their authorization header.
+type Service struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	host        string
+	port        int
+	gatewayPort int
+	token       string
+	keyManager  keymanager.KeyManager
+	listener    net.Listener
+	grpcServer  *grpc.Server
+	conn        *grpc.ClientConn
+	httpServer  *http.Server
+	failStatus  error
+}
+
+// Config options for the key management API.
+type Config struct {
+	Host        string
+	Port        int
+	GatewayPort int
+	// TokenFile is the path of the file holding the API token.
+	TokenFile  string
+	KeyManager keymanager.KeyManager
+}
+
+// NewService creates a new key management API service. It fails if the API token
+// cannot be read, as the API must not be served without authentication.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.TokenFile == "" {
+		return nil, errors.New("a token file is required to serve the key management API")
+	}
+	// #nosec - Inclusion of file via variable is OK here.
+	b, err := ioutil.ReadFile(cfg.TokenFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read key management API token file")
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return nil, errors.Errorf("key management API token file %s is empty", cfg.TokenFile)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:         ctx,
+		cancel:      cancel,
+		host:        cfg.Host,
+		port:        cfg.Port,
+		gatewayPort: cfg.GatewayPort,
+		token:       token,
+		keyManager:  cfg.KeyManager,
+	}, nil
+}
+
+// Start the gRPC server, and the HTTP JSON gateway if its port is set.
+func (s *Service) Start() {
+	address := fmt.Sprintf("%s:%d", s.host, s.port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+		s.failStatus = err
+		return
+	}
+	s.listener = lis
+	log.WithField("address", address).Info("Key management API listening")
+
+	s.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(s.authenticate))
+	pb.RegisterKeysServer(s.grpcServer, &Server{KeyManager: s.keyManager})
+	go func() {
+		if err := s.grpcServer.Serve(s.listener); err != nil {
+			log.Errorf("Could not serve gRPC: %v", err)
+		}
+	}()
+
+	if s.gatewayPort > 0 {
+		s.startGateway(address)
+	}
+}
+
+// startGateway serves the key management API as HTTP JSON, forwarding the requests
+// with their authorization header to the gRPC server.
+func (s *Service) startGateway(remoteAddress string) {
+	conn, err := grpc.DialContext(s.ctx, remoteAddress, grpc.WithInsecure())
+	if err != nil {
+		log.WithError(err).Error("Failed to connect to gRPC server")
+		s.failStatus = err
+		return
+	}
+	s.conn = conn
+	gwmux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}),
+	)
+	if err := pbgw.RegisterKeysHandler(s.ctx, gwmux, conn); err != nil {
+		log.WithError(err).Error("Failed to start gateway")
+		s.failStatus = err
+		return
+	}
+	address := fmt.Sprintf("%s:%d", s.host, s.gatewayPort)
+	s.httpServer = &http.Server{
+		Addr:    address,
+		Handler: gwmux,
+	}
+	log.WithField("address", address).Info("Key management API gateway listening")
+	go func() {
+		if err := s.httpServer.ListenAndServe(); err != http.ErrServerClosed {
+			log.WithError(err).Error("Failed to listen and serve")
+			s.failStatus = err
+		}
+	}()
+}
+
+// Stop the servers.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.httpServer != nil {
+		if err := s.httpServer.Shutdown(context.Background()); err != nil {
+			log.WithError(err).Error("Failed to shut down gateway")
+		}
+	}
+	if s.conn != nil {
+		if err := s.conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close gateway connection")
+		}
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of key management API server")
+	}
+	return nil
+}
+
+// Status returns an error if the servers could not be started.
+func (s *Service) Status() error {
+	return s.failStatus
+}
+
+// authenticate rejects the requests without the API token as bearer token.
+func (s *Service) authenticate(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	authorized := false
+	for _, value := range md.Get("authorization") {
+		token := strings.TrimPrefix(value, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			authorized = true
+		}
+	}
+	if !authorized {
+		log.WithField("method", info.FullMethod).Warn("Rejected unauthenticated key management request")
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+	return handler(ctx, req)
+}
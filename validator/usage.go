@@ -75,6 +75,9 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.NoCustomConfigFlag,
 			flags.BeaconRPCProviderFlag,
+			flags.BeaconMaxHeadSlotLagFlag,
+			flags.BeaconMaxRPCErrorsFlag,
+			flags.BroadcastSubmissionsFlag,
 			flags.CertFlag,
 			flags.KeyManager,
 			flags.KeyManagerOpts,
@@ -82,6 +85,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.PasswordFlag,
 			flags.DisablePenaltyRewardLogFlag,
 			flags.UnencryptedKeysFlag,
+			flags.KeystoreReloadIntervalFlag,
+			flags.KeyManagementHostFlag,
+			flags.KeyManagementPortFlag,
+			flags.KeyManagementGatewayPortFlag,
+			flags.KeyManagementTokenFileFlag,
 			flags.GraffitiFlag,
 			flags.GrpcMaxCallRecvMsgSizeFlag,
 		},